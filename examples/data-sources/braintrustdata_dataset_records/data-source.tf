@@ -0,0 +1,22 @@
+# Read every record of a dataset
+data "braintrustdata_dataset_records" "all" {
+  dataset_id = "dataset-123"
+}
+
+# Read golden records only, projecting the fields a fixture needs
+data "braintrustdata_dataset_records" "golden" {
+  dataset_id = "dataset-123"
+  filter     = "metadata.source = 'golden'"
+  fields     = ["input", "expected"]
+  limit      = 500
+}
+
+# Pin reads to a dataset version
+data "braintrustdata_dataset_records" "pinned" {
+  dataset_id = "dataset-123"
+  version    = "1000192656880881099"
+}
+
+output "golden_fixtures" {
+  value = [for record in data.braintrustdata_dataset_records.golden.records : jsondecode(record.data)]
+}
@@ -0,0 +1,26 @@
+# Resolve the latest version of a dataset
+data "braintrustdata_dataset_snapshot" "latest" {
+  dataset_id = "dataset-123" # replace with real ID or wire from data/resource
+}
+
+# Resolve the version of the latest write to records tagged for a release
+data "braintrustdata_dataset_snapshot" "release" {
+  dataset_id = "dataset-123" # replace with real ID or wire from data/resource
+  tag        = "release-2026.10"
+}
+
+# Resolve the dataset as of a point in time
+data "braintrustdata_dataset_snapshot" "quarter_start" {
+  dataset_id = "dataset-123" # replace with real ID or wire from data/resource
+  timestamp  = "2026-10-01T00:00:00Z"
+}
+
+# Read the records at the release version
+data "braintrustdata_dataset_records" "release" {
+  dataset_id = data.braintrustdata_dataset_snapshot.release.dataset_id
+  version    = data.braintrustdata_dataset_snapshot.release.version
+}
+
+output "release_version" {
+  value = data.braintrustdata_dataset_snapshot.release.version
+}
@@ -0,0 +1,33 @@
+# Resolve what a user can do on a dataset, including grants inherited from
+# organization- and project-level ACLs, nested groups, and inherited roles.
+data "braintrustdata_effective_permissions" "alice_dataset" {
+  user_id     = "user-123"
+  object_id   = "dataset-123"
+  object_type = "dataset"
+  project_id  = "project-123"
+}
+
+output "alice_dataset_permissions" {
+  value = data.braintrustdata_effective_permissions.alice_dataset.permissions
+}
+
+output "alice_dataset_grant_paths" {
+  value = [
+    for grant in data.braintrustdata_effective_permissions.alice_dataset.grants :
+    join(" -> ", grant.path)
+  ]
+}
+
+# Use the data source in a check block to audit access continuously.
+check "contractors_cannot_delete_project" {
+  data "braintrustdata_effective_permissions" "contractors" {
+    group_id    = "group-123"
+    object_id   = "project-123"
+    object_type = "project"
+  }
+
+  assert {
+    condition     = !contains(data.braintrustdata_effective_permissions.contractors.permissions, "delete")
+    error_message = "The contractors group can delete the project."
+  }
+}
@@ -0,0 +1,19 @@
+# Read the rows of an experiment that failed the accuracy scorer
+data "braintrustdata_experiment_events" "failing" {
+  experiment_id = "experiment-123" # replace with real ID or wire from data/resource
+  max_scores    = { accuracy = 0 }
+  fields        = ["input", "output", "expected", "scores"]
+  limit         = 50
+}
+
+# Narrow the rows with a BTQL filter and a score band
+data "braintrustdata_experiment_events" "borderline_test_split" {
+  experiment_id = "experiment-123" # replace with real ID or wire from data/resource
+  filter        = "metadata.split = 'test'"
+  min_scores    = { factuality = 0.4 }
+  max_scores    = { factuality = 0.6 }
+}
+
+output "failing_rows" {
+  value = [for event in data.braintrustdata_experiment_events.failing.events : jsondecode(event.data)]
+}
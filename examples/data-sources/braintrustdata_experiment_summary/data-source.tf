@@ -0,0 +1,23 @@
+# Summarize a candidate experiment against its baseline
+data "braintrustdata_experiment_summary" "candidate" {
+  experiment_id            = "experiment-123" # replace with real ID or wire from data/resource
+  comparison_experiment_id = "experiment-456" # replace with real ID or wire from data/resource
+}
+
+# Warn when the candidate's accuracy falls below the release bar
+check "candidate_accuracy" {
+  assert {
+    condition     = data.braintrustdata_experiment_summary.candidate.scores["accuracy"].score >= 0.85
+    error_message = "Candidate accuracy is below 0.85."
+  }
+}
+
+output "candidate_regressions" {
+  value = {
+    for name, score in data.braintrustdata_experiment_summary.candidate.scores : name => score.regressions
+  }
+}
+
+output "candidate_cost" {
+  value = try(data.braintrustdata_experiment_summary.candidate.metrics["estimated_cost"].metric, null)
+}
@@ -24,3 +24,14 @@ output "function_lookup" {
     slug          = data.braintrustdata_function.by_id.slug
   }
 }
+
+# Read a function as of a specific version.
+data "braintrustdata_function" "pinned" {
+  # replace with real ID or wire from data/resource
+  id      = "function-abc123"
+  version = "1000192656880881099"
+}
+
+output "pinned_function_data" {
+  value = data.braintrustdata_function.pinned.function_data
+}
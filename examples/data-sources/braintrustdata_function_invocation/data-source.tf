@@ -0,0 +1,14 @@
+data "braintrustdata_function_invocation" "exact_match_smoke_test" {
+  function_id = "00000000-0000-0000-0000-000000000000" # replace with real ID or wire from data/resource
+  input = jsonencode({
+    output   = "Paris"
+    expected = "Paris"
+  })
+
+  lifecycle {
+    postcondition {
+      condition     = try(jsondecode(self.output) == 1, false)
+      error_message = "exact-match scorer failed its smoke test: ${coalesce(self.error, self.output)}"
+    }
+  }
+}
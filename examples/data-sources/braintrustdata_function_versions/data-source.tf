@@ -0,0 +1,26 @@
+# List the most recent versions of a function.
+data "braintrustdata_function_versions" "history" {
+  # replace with real ID or wire from data/resource
+  function_id = "function-abc123"
+  limit       = 5
+}
+
+# Pin a function to its oldest listed version.
+data "braintrustdata_function" "oldest" {
+  id      = data.braintrustdata_function_versions.history.function_id
+  version = data.braintrustdata_function_versions.history.versions[length(data.braintrustdata_function_versions.history.versions) - 1].version
+}
+
+output "function_history" {
+  value = [
+    for v in data.braintrustdata_function_versions.history.versions : {
+      version = v.version
+      created = v.created
+      author  = v.user_id
+    }
+  ]
+}
+
+output "oldest_function_data" {
+  value = data.braintrustdata_function.oldest.function_data
+}
@@ -0,0 +1,11 @@
+data "braintrustdata_git_repo_info" "current" {
+  path           = path.root
+  include_diff   = true
+  max_diff_bytes = 32768
+}
+
+resource "braintrustdata_experiment" "run" {
+  project_id = "00000000-0000-0000-0000-000000000000" # replace with real ID or wire from data/resource
+  name       = "eval-${substr(data.braintrustdata_git_repo_info.current.repo_info.commit, 0, 8)}"
+  repo_info  = data.braintrustdata_git_repo_info.current.repo_info
+}
@@ -0,0 +1,20 @@
+# Flatten a group and every group nested in it into a list of users.
+data "braintrustdata_group_expanded_members" "engineering" {
+  group_id = "group-123"
+}
+
+output "engineering_member_emails" {
+  value = [for user in data.braintrustdata_group_expanded_members.engineering.users : user.email]
+}
+
+# Find every group a user belongs to, directly or through nested groups.
+data "braintrustdata_group_expanded_members" "alice" {
+  user_id = "user-123"
+}
+
+output "alice_groups" {
+  value = {
+    for group in data.braintrustdata_group_expanded_members.alice.groups :
+    group.name => group.direct ? "direct" : "inherited"
+  }
+}
@@ -0,0 +1,18 @@
+# Read a project automation by ID
+data "braintrustdata_project_automation" "by_id" {
+  id = "automation-123"
+}
+
+# Read a project automation by name with optional project filters
+data "braintrustdata_project_automation" "by_name" {
+  name       = "error-alert"
+  project_id = "proj-123"
+}
+
+output "automation_filter" {
+  value = data.braintrustdata_project_automation.by_name.btql_filter
+}
+
+output "automation_webhook_url" {
+  value = data.braintrustdata_project_automation.by_name.action.url
+}
@@ -0,0 +1,19 @@
+# List project automations with API-native filters
+data "braintrustdata_project_automations" "all" {
+  project_id = "proj-123"
+  limit      = 50
+}
+
+# Filter project automations by exact name
+data "braintrustdata_project_automations" "filtered" {
+  project_name    = "example-project"
+  automation_name = "error-alert"
+}
+
+output "all_automation_ids" {
+  value = data.braintrustdata_project_automations.all.ids
+}
+
+output "filtered_automations" {
+  value = data.braintrustdata_project_automations.filtered.project_automations
+}
@@ -28,3 +28,14 @@ output "prompt_lookup" {
     }
   }
 }
+
+# Read a prompt as of a specific version.
+data "braintrustdata_prompt" "pinned" {
+  # replace with real ID or wire from data/resource
+  id      = "prompt-abc123"
+  version = "1000192656880881099"
+}
+
+output "pinned_prompt_data" {
+  value = data.braintrustdata_prompt.pinned.prompt_data
+}
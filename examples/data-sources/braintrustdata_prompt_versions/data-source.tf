@@ -0,0 +1,26 @@
+# List the most recent versions of a prompt.
+data "braintrustdata_prompt_versions" "history" {
+  # replace with real ID or wire from data/resource
+  prompt_id = "prompt-abc123"
+  limit     = 5
+}
+
+# Pin a prompt to the version before the current one.
+data "braintrustdata_prompt" "previous" {
+  id      = data.braintrustdata_prompt_versions.history.prompt_id
+  version = data.braintrustdata_prompt_versions.history.versions[length(data.braintrustdata_prompt_versions.history.versions) > 1 ? 1 : 0].version
+}
+
+output "prompt_history" {
+  value = [
+    for v in data.braintrustdata_prompt_versions.history.versions : {
+      version = v.version
+      created = v.created
+      author  = v.user_id
+    }
+  ]
+}
+
+output "previous_prompt_data" {
+  value = data.braintrustdata_prompt.previous.prompt_data
+}
@@ -0,0 +1,14 @@
+# Read a span iframe by ID
+data "braintrustdata_span_iframe" "by_id" {
+  id = "span-iframe-123"
+}
+
+# Read a span iframe by name with optional project filters
+data "braintrustdata_span_iframe" "by_name" {
+  name       = "trace-viewer"
+  project_id = "proj-123"
+}
+
+output "span_iframe_url" {
+  value = data.braintrustdata_span_iframe.by_name.url
+}
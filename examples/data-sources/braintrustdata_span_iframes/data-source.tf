@@ -0,0 +1,19 @@
+# List span iframes with API-native filters
+data "braintrustdata_span_iframes" "all" {
+  project_id = "proj-123"
+  limit      = 50
+}
+
+# Filter span iframes by exact name
+data "braintrustdata_span_iframes" "filtered" {
+  project_name     = "example-project"
+  span_iframe_name = "trace-viewer"
+}
+
+output "all_span_iframe_ids" {
+  value = data.braintrustdata_span_iframes.all.ids
+}
+
+output "filtered_span_iframes" {
+  value = data.braintrustdata_span_iframes.filtered.span_iframes
+}
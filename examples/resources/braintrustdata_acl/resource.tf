@@ -25,6 +25,15 @@ resource "braintrustdata_acl" "user_editor_update" {
   permission  = "update"
 }
 
+# User-based access by email: the email is resolved to a user ID at plan time.
+# replace with a real org member email
+resource "braintrustdata_acl" "user_reviewer_read" {
+  object_id   = braintrustdata_project.example.id
+  object_type = "project"
+  user_email  = "alice@example.com"
+  permission  = "read"
+}
+
 resource "braintrustdata_role" "restricted_viewer" {
   name        = "acl-example-restricted-viewer"
   description = "Read access restricted to experiments"
@@ -43,6 +52,7 @@ output "acl_ids" {
   value = {
     group_viewer_read = braintrustdata_acl.group_viewer_read.id
     user_editor       = braintrustdata_acl.user_editor_update.id
+    user_reviewer     = braintrustdata_acl.user_reviewer_read.id
     role_restricted   = braintrustdata_acl.role_restricted_read.id
   }
 }
@@ -0,0 +1,24 @@
+resource "braintrustdata_project" "example" {
+  for_each = toset(["acl-batch-example-a", "acl-batch-example-b", "acl-batch-example-c"])
+
+  name        = each.value
+  description = "Project used for ACL batch examples"
+}
+
+resource "braintrustdata_group" "viewers" {
+  name        = "acl-batch-example-viewers"
+  description = "Users with read access to every example project"
+}
+
+# Grant read on every project to the viewers group in a single API call
+# instead of one braintrustdata_acl resource per project.
+resource "braintrustdata_acl_batch" "viewers_read" {
+  acls = [
+    for project in braintrustdata_project.example : {
+      object_id   = project.id
+      object_type = "project"
+      group_id    = braintrustdata_group.viewers.id
+      permission  = "read"
+    }
+  ]
+}
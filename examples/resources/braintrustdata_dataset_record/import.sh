@@ -0,0 +1,2 @@
+# Dataset records can be imported using "<dataset_id>,<record_id>"
+terraform import braintrustdata_dataset_record.capital_of_france dataset-123456789,capital-of-france
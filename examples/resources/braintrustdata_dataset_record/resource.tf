@@ -0,0 +1,19 @@
+resource "braintrustdata_project" "example" {
+  name = "dataset-record-example-project"
+}
+
+resource "braintrustdata_dataset" "golden" {
+  project_id  = braintrustdata_project.example.id
+  name        = "golden-set"
+  description = "Golden test cases"
+}
+
+# A stable ID keeps the record addressable across applies.
+resource "braintrustdata_dataset_record" "capital_of_france" {
+  id         = "capital-of-france"
+  dataset_id = braintrustdata_dataset.golden.id
+  input      = jsonencode({ question = "What is the capital of France?" })
+  expected   = jsonencode("Paris")
+  metadata   = jsonencode({ source = "spreadsheet", difficulty = "easy" })
+  tags       = ["geography"]
+}
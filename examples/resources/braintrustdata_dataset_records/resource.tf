@@ -0,0 +1,29 @@
+resource "braintrustdata_project" "example" {
+  name = "dataset-records-example-project"
+}
+
+resource "braintrustdata_dataset" "golden" {
+  project_id  = braintrustdata_project.example.id
+  name        = "golden-set"
+  description = "Golden test cases exported from a spreadsheet"
+}
+
+# Each line of golden.jsonl is an object such as:
+# {"id": "capital-of-france", "input": {"question": "Capital of France?"}, "expected": "Paris", "tags": ["geography"]}
+resource "braintrustdata_dataset_records" "golden" {
+  dataset_id = braintrustdata_dataset.golden.id
+  source     = "${path.module}/golden.jsonl"
+}
+
+# CSV exports work too; here the ID lives in a "case_id" column.
+resource "braintrustdata_dataset_records" "regressions" {
+  dataset_id = braintrustdata_dataset.golden.id
+  source     = "${path.module}/regressions.csv"
+  format     = "csv"
+  id_field   = "case_id"
+  batch_size = 500
+}
+
+output "golden_row_count" {
+  value = braintrustdata_dataset_records.golden.row_count
+}
@@ -0,0 +1,2 @@
+# Environments can be imported using their ID
+terraform import braintrustdata_environment.production env-123456789
@@ -0,0 +1,10 @@
+resource "braintrustdata_environment" "staging" {
+  name        = "Staging"
+  slug        = "staging"
+  description = "Pre-production checks"
+}
+
+resource "braintrustdata_environment" "production" {
+  name = "Production"
+  slug = "production"
+}
@@ -37,9 +37,29 @@ resource "braintrustdata_experiment" "production_candidate" {
   }
 }
 
+# Experiment pinned to a dataset release and compared against the baseline.
+resource "braintrustdata_dataset" "golden" {
+  name       = "customer-support-golden"
+  project_id = braintrustdata_project.evaluation.id
+}
+
+data "braintrustdata_dataset_snapshot" "release" {
+  dataset_id = braintrustdata_dataset.golden.id
+  tag        = "release-2026.10"
+}
+
+resource "braintrustdata_experiment" "release_candidate" {
+  name               = "prompt-optimization-v2"
+  project_id         = braintrustdata_project.evaluation.id
+  dataset_id         = braintrustdata_dataset.golden.id
+  dataset_version    = data.braintrustdata_dataset_snapshot.release.version
+  base_experiment_id = braintrustdata_experiment.minimal.id
+}
+
 output "experiment_ids" {
   value = {
     minimal              = braintrustdata_experiment.minimal.id
     production_candidate = braintrustdata_experiment.production_candidate.id
+    release_candidate    = braintrustdata_experiment.release_candidate.id
   }
 }
@@ -0,0 +1,24 @@
+# Freeze the current production experiment as the baseline of a prompt branch.
+resource "braintrustdata_experiment_copy" "baseline" {
+  source_experiment_id = "experiment-123" # replace with real ID or wire from data/resource
+  name                 = "support-prompt-v2-baseline"
+  description          = "Frozen copy of production for the v2 prompt branch"
+}
+
+# Copy the source as of a pinned version into another project.
+resource "braintrustdata_experiment_copy" "archived" {
+  source_experiment_id = "experiment-123" # replace with real ID or wire from data/resource
+  source_version       = "1000192656880881099"
+  project_id           = "project-456" # replace with real ID or wire from data/resource
+  name                 = "support-prompt-2026-q3-archive"
+}
+
+resource "braintrustdata_experiment" "candidate" {
+  project_id         = "project-123" # replace with real ID or wire from data/resource
+  name               = "support-prompt-v2"
+  base_experiment_id = braintrustdata_experiment_copy.baseline.id
+}
+
+output "baseline_event_count" {
+  value = braintrustdata_experiment_copy.baseline.event_count
+}
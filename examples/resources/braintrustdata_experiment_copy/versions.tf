@@ -0,0 +1,10 @@
+terraform {
+  required_version = ">= 1.4.0"
+
+  required_providers {
+    braintrustdata = {
+      source  = "braintrustdata/braintrustdata"
+      version = "= 0.1.0"
+    }
+  }
+}
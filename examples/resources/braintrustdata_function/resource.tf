@@ -40,6 +40,32 @@ resource "braintrustdata_function" "support_tool" {
   tags = ["support", "production"]
 }
 
+# Small scorer stored inline. Plans show code_hash instead of the source.
+resource "braintrustdata_function" "length_check" {
+  project_id    = braintrustdata_project.ai_functions.id
+  name          = "length-check"
+  function_type = "scorer"
+
+  code = {
+    runtime = "python"
+    version = "3.12"
+    source  = file("${path.module}/scorers/length_check.py")
+  }
+}
+
+# Code function bundled from a local directory. The directory is zipped and
+# uploaded again only when its contents change.
+resource "braintrustdata_function" "exact_match" {
+  project_id    = braintrustdata_project.ai_functions.id
+  name          = "exact-match"
+  function_type = "scorer"
+
+  source_dir      = "${path.module}/scorers/exact_match"
+  entrypoint      = "scorer.py"
+  runtime         = "python"
+  runtime_version = "3.12"
+}
+
 output "function_id" {
   value = braintrustdata_function.support_tool.id
 }
@@ -14,6 +14,18 @@ resource "braintrustdata_group" "ml_team" {
   member_groups = [braintrustdata_group.support_reviewers.id]
 }
 
+# Group membership by email, resolved to user IDs at plan time.
+resource "braintrustdata_group" "reviewers" {
+  name        = "reviewers"
+  description = "Reviewers managed from an HR-driven roster"
+
+  # replace with real org member emails
+  member_user_emails = [
+    "alice@example.com",
+    "bob@example.com",
+  ]
+}
+
 # Optional org-scoped group.
 resource "braintrustdata_group" "org_admins" {
   name        = "org-admins"
@@ -27,6 +39,7 @@ output "group_ids" {
   value = {
     support_reviewers = braintrustdata_group.support_reviewers.id
     ml_team           = braintrustdata_group.ml_team.id
+    reviewers         = braintrustdata_group.reviewers.id
     org_admins        = braintrustdata_group.org_admins.id
   }
 }
@@ -0,0 +1,2 @@
+# Group members can be imported using <group_id>,<user|group>,<member_id>
+terraform import braintrustdata_group_member.alice "group-id,user,user-id"
@@ -0,0 +1,23 @@
+# Shared group owned by a platform team.
+resource "braintrustdata_group" "reviewers" {
+  name        = "reviewers"
+  description = "Shared reviewers group; membership managed by individual teams"
+}
+
+resource "braintrustdata_group" "support_team" {
+  name = "support-team"
+}
+
+# Add a single user without taking ownership of the rest of the group.
+resource "braintrustdata_group_member" "alice" {
+  group_id = braintrustdata_group.reviewers.id
+
+  # replace with real ID or wire from data/resource
+  user_id = "866a8a8a-fee9-4a5b-8278-12970de499c2"
+}
+
+# Nest another group inside the shared group.
+resource "braintrustdata_group_member" "support_team" {
+  group_id        = braintrustdata_group.reviewers.id
+  member_group_id = braintrustdata_group.support_team.id
+}
@@ -0,0 +1,2 @@
+# Group members can be imported using the group ID. Every current member is adopted.
+terraform import braintrustdata_group_members.ml_team_reviewers "group-id"
@@ -0,0 +1,21 @@
+# Shared group owned by a platform team.
+resource "braintrustdata_group" "reviewers" {
+  name        = "reviewers"
+  description = "Shared reviewers group; membership managed by individual teams"
+}
+
+resource "braintrustdata_group" "ml_team" {
+  name = "ml-team"
+}
+
+# The ML team manages only its own slice of the shared group.
+# Members added by other teams or in the UI are left untouched.
+resource "braintrustdata_group_members" "ml_team_reviewers" {
+  group_id = braintrustdata_group.reviewers.id
+
+  # replace with real ID or wire from data/resource
+  member_users = [
+    "866a8a8a-fee9-4a5b-8278-12970de499c2",
+  ]
+  member_groups = [braintrustdata_group.ml_team.id]
+}
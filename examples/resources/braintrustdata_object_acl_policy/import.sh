@@ -0,0 +1,2 @@
+# Object ACL policies can be imported using <object_type>,<object_id>
+terraform import braintrustdata_object_acl_policy.example "project,project-id"
@@ -0,0 +1,39 @@
+resource "braintrustdata_project" "example" {
+  name        = "acl-policy-example-project"
+  description = "Project whose ACLs are managed as a single policy"
+}
+
+resource "braintrustdata_group" "viewers" {
+  name        = "acl-policy-example-viewers"
+  description = "Users with project read access"
+}
+
+resource "braintrustdata_role" "editor" {
+  name               = "acl-policy-example-editor"
+  description        = "Role granting edit access"
+  member_permissions = [{ permission = "read" }, { permission = "update" }]
+}
+
+# Every ACL on the project is declared here. ACLs created outside this
+# resource are removed on the next apply.
+resource "braintrustdata_object_acl_policy" "example" {
+  object_id   = braintrustdata_project.example.id
+  object_type = "project"
+
+  bindings = [
+    {
+      group_id   = braintrustdata_group.viewers.id
+      permission = "read"
+    },
+    {
+      # replace with real ID or wire from data/resource
+      user_id = "866a8a8a-fee9-4a5b-8278-12970de499c2"
+      role_id = braintrustdata_role.editor.id
+    },
+    {
+      group_id             = braintrustdata_group.viewers.id
+      permission           = "read"
+      restrict_object_type = "dataset"
+    },
+  ]
+}
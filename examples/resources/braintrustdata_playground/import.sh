@@ -0,0 +1,2 @@
+# Playgrounds can be imported using their prompt session ID
+terraform import braintrustdata_playground.baseline prompt-session-123456789
@@ -0,0 +1,36 @@
+resource "braintrustdata_project" "example" {
+  name        = "playground-example-project"
+  description = "Project with a standard evaluation playground"
+}
+
+resource "braintrustdata_prompt" "summarizer" {
+  project_id = braintrustdata_project.example.id
+  name       = "summarizer"
+}
+
+resource "braintrustdata_dataset" "golden" {
+  project_id = braintrustdata_project.example.id
+  name       = "golden-set"
+}
+
+resource "braintrustdata_group" "reviewers" {
+  name        = "playground-reviewers"
+  description = "Users who review playground runs"
+}
+
+# Bind the prompt and the dataset to a playground.
+resource "braintrustdata_playground" "baseline" {
+  project_id   = braintrustdata_project.example.id
+  name         = "baseline"
+  description  = "Standard evaluation playground"
+  function_ids = [braintrustdata_prompt.summarizer.id]
+  dataset_id   = braintrustdata_dataset.golden.id
+}
+
+# Playgrounds are prompt sessions for ACL purposes.
+resource "braintrustdata_acl" "reviewers_read" {
+  object_id   = braintrustdata_playground.baseline.id
+  object_type = "prompt_session"
+  group_id    = braintrustdata_group.reviewers.id
+  permission  = "read"
+}
@@ -0,0 +1,2 @@
+# Project automations can be imported using their ID
+terraform import braintrustdata_project_automation.errors automation-123456789
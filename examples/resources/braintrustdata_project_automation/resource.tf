@@ -0,0 +1,35 @@
+resource "braintrustdata_project" "example" {
+  name        = "automation-example-project"
+  description = "Project with alerting managed in Terraform"
+}
+
+# Post to a webhook every hour when errored spans were logged.
+resource "braintrustdata_project_automation" "errors" {
+  project_id       = braintrustdata_project.example.id
+  name             = "error-alert"
+  description      = "Notify on-call when spans fail"
+  event_type       = "logs"
+  btql_filter      = "error IS NOT NULL"
+  interval_seconds = 3600
+
+  action = {
+    type = "webhook"
+    url  = "https://hooks.example.com/braintrust/errors"
+  }
+}
+
+# Export the project's traces to S3 as Parquet once a day.
+resource "braintrustdata_project_automation" "export" {
+  project_id       = braintrustdata_project.example.id
+  name             = "nightly-export"
+  event_type       = "btql_export"
+  interval_seconds = 86400
+
+  export = {
+    scope       = "log_traces"
+    path        = "s3://example-bucket/braintrust/"
+    format      = "parquet"
+    role_arn    = "arn:aws:iam::123456789012:role/braintrust-export"
+    external_id = "replace-with-your-external-id"
+  }
+}
@@ -0,0 +1,2 @@
+# Prompt environment bindings can be imported using <object_type>,<object_id>,<environment_slug>
+terraform import braintrustdata_prompt_environment_binding.triage_production "prompt,prompt-id,production"
@@ -0,0 +1,22 @@
+resource "braintrustdata_environment" "production" {
+  name = "Production"
+  slug = "production"
+}
+
+# Pin production to a reviewed version of the triage prompt. Promoting a new
+# version is a one-line change to `version`.
+resource "braintrustdata_prompt_environment_binding" "triage_production" {
+  # replace with real ID or wire from data/resource
+  object_id        = "prompt-abc123"
+  environment_slug = braintrustdata_environment.production.slug
+  version          = "1000192656880881099"
+}
+
+# Functions are bound the same way.
+resource "braintrustdata_prompt_environment_binding" "scorer_production" {
+  object_type = "function"
+  # replace with real ID or wire from data/resource
+  object_id        = "function-abc123"
+  environment_slug = braintrustdata_environment.production.slug
+  version          = "1000192656880881099"
+}
@@ -3,16 +3,22 @@ resource "braintrustdata_role" "viewer" {
   name        = "example-viewer"
   description = "Read-only access role"
 
-  member_permissions = ["read"]
+  member_permissions = [{ permission = "read" }]
 }
 
-# Editor role that composes the viewer role and adds write capability.
+# Editor role that composes the viewer role and adds write capability
+# limited to datasets.
 resource "braintrustdata_role" "editor" {
   name        = "example-editor"
   description = "Read/write access role"
 
-  member_permissions = ["update"]
-  member_roles       = [braintrustdata_role.viewer.id]
+  member_permissions = [
+    {
+      permission           = "update"
+      restrict_object_type = "dataset"
+    },
+  ]
+  member_roles = [braintrustdata_role.viewer.id]
 }
 
 output "role_ids" {
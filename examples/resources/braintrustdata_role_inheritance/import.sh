@@ -0,0 +1,2 @@
+# Role inheritances can be imported using <role_id>,<member_role_id>
+terraform import braintrustdata_role_inheritance.viewer "role-id,member-role-id"
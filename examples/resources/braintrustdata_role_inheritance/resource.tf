@@ -0,0 +1,21 @@
+# Shared role owned by a platform team. member_roles is ignored so other
+# configurations can add inherited roles without clobbering each other.
+resource "braintrustdata_role" "shared" {
+  name        = "shared-operator"
+  description = "Shared role; inherited roles managed by individual teams"
+
+  lifecycle {
+    ignore_changes = [member_roles]
+  }
+}
+
+resource "braintrustdata_role" "viewer" {
+  name               = "viewer"
+  member_permissions = [{ permission = "read" }]
+}
+
+# Make the shared role inherit every permission of the viewer role.
+resource "braintrustdata_role_inheritance" "viewer" {
+  role_id        = braintrustdata_role.shared.id
+  member_role_id = braintrustdata_role.viewer.id
+}
@@ -0,0 +1,3 @@
+# Role permissions can be imported using <role_id>,<permission> or
+# <role_id>,<permission>,<restrict_object_type>
+terraform import braintrustdata_role_permission.update_datasets "role-id,update,dataset"
@@ -0,0 +1,23 @@
+# Shared role owned by a platform team. member_permissions is ignored so other
+# configurations can add permissions without clobbering each other.
+resource "braintrustdata_role" "shared" {
+  name        = "shared-reviewer"
+  description = "Shared role; permissions managed by individual teams"
+
+  lifecycle {
+    ignore_changes = [member_permissions]
+  }
+}
+
+# Grant read access on everything.
+resource "braintrustdata_role_permission" "read" {
+  role_id    = braintrustdata_role.shared.id
+  permission = "read"
+}
+
+# Grant update access on datasets only.
+resource "braintrustdata_role_permission" "update_datasets" {
+  role_id              = braintrustdata_role.shared.id
+  permission           = "update"
+  restrict_object_type = "dataset"
+}
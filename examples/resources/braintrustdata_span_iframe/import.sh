@@ -0,0 +1,2 @@
+# Span iframes can be imported using their ID
+terraform import braintrustdata_span_iframe.viewer span-iframe-123456789
@@ -0,0 +1,13 @@
+resource "braintrustdata_project" "example" {
+  name        = "span-iframe-example-project"
+  description = "Project with a custom trace viewer"
+}
+
+# Render spans in a custom viewer, sending span data via postMessage.
+resource "braintrustdata_span_iframe" "viewer" {
+  project_id   = braintrustdata_project.example.id
+  name         = "trace-viewer"
+  description  = "Custom visualization for agent traces"
+  url          = "https://viewer.example.com/braintrust"
+  post_message = true
+}
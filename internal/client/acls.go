@@ -154,3 +154,31 @@ func (c *Client) ListACLs(ctx context.Context, opts *ListACLsOptions) (*ListACLs
 	}
 	return &result, nil
 }
+
+// BatchUpdateACLsRequest represents a request to add and remove many ACLs at once
+type BatchUpdateACLsRequest struct {
+	AddACLs    []CreateACLRequest `json:"add_acls,omitempty"`
+	RemoveACLs []CreateACLRequest `json:"remove_acls,omitempty"`
+}
+
+// BatchUpdateACLsResponse represents the ACLs affected by a batch update
+type BatchUpdateACLsResponse struct {
+	AddedACLs   []ACL `json:"added_acls"`
+	RemovedACLs []ACL `json:"removed_acls"`
+}
+
+// BatchUpdateACLs adds and removes ACLs in a single request.
+// Entries in remove are matched by their object, principal, and grant rather than by ACL ID.
+func (c *Client) BatchUpdateACLs(ctx context.Context, add, remove []CreateACLRequest) (*BatchUpdateACLsResponse, error) {
+	req := &BatchUpdateACLsRequest{
+		AddACLs:    add,
+		RemoveACLs: remove,
+	}
+
+	var result BatchUpdateACLsResponse
+	err := c.Do(ctx, "POST", "/v1/acl/batch_update", req, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
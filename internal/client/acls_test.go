@@ -4,8 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"testing"
 	"time"
 )
@@ -521,3 +523,82 @@ func TestDeleteACL_EmptyID(t *testing.T) {
 		t.Errorf("expected error '%v', got '%v'", ErrEmptyACLID, err)
 	}
 }
+
+// TestBatchUpdateACLs verifies the batch ACL payload shape and response decoding
+func TestBatchUpdateACLs(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("expected POST method, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/acl/batch_update" {
+			t.Errorf("expected path /v1/acl/batch_update, got %s", r.URL.Path)
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("failed reading request body: %v", err)
+		}
+		var payload map[string]any
+		if err := json.Unmarshal(body, &payload); err != nil {
+			t.Fatalf("failed unmarshalling request body: %v", err)
+		}
+
+		want := map[string]any{
+			"add_acls": []any{
+				map[string]any{
+					"object_id":   "project-123",
+					"object_type": "project",
+					"group_id":    "group-1",
+					"permission":  "read",
+				},
+			},
+			"remove_acls": []any{
+				map[string]any{
+					"object_id":   "project-123",
+					"object_type": "project",
+					"user_id":     "user-1",
+					"role_id":     "role-1",
+				},
+			},
+		}
+		if !reflect.DeepEqual(payload, want) {
+			t.Errorf("unexpected payload: got %#v, want %#v", payload, want)
+		}
+
+		resp := BatchUpdateACLsResponse{
+			AddedACLs: []ACL{
+				{ID: "acl-1", ObjectID: "project-123", ObjectType: ACLObjectTypeProject, GroupID: "group-1", Permission: PermissionRead},
+			},
+			RemovedACLs: []ACL{
+				{ID: "acl-2", ObjectID: "project-123", ObjectType: ACLObjectTypeProject, UserID: "user-1", RoleID: "role-1"},
+			},
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	result, err := client.BatchUpdateACLs(context.Background(),
+		[]CreateACLRequest{
+			{ObjectID: "project-123", ObjectType: ACLObjectTypeProject, GroupID: "group-1", Permission: PermissionRead},
+		},
+		[]CreateACLRequest{
+			{ObjectID: "project-123", ObjectType: ACLObjectTypeProject, UserID: "user-1", RoleID: "role-1"},
+		},
+	)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result.AddedACLs) != 1 || result.AddedACLs[0].ID != "acl-1" {
+		t.Errorf("expected added ACL acl-1, got %#v", result.AddedACLs)
+	}
+	if len(result.RemovedACLs) != 1 || result.RemovedACLs[0].ID != "acl-2" {
+		t.Errorf("expected removed ACL acl-2, got %#v", result.RemovedACLs)
+	}
+}
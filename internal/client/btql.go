@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// ErrEmptyBTQLQuery is returned when a BTQL query is empty.
+var ErrEmptyBTQLQuery = errors.New("BTQL query cannot be empty")
+
+// BTQLQueryRequest represents a request to run a BTQL query.
+type BTQLQueryRequest struct {
+	Query string `json:"query"`
+	Fmt   string `json:"fmt,omitempty"`
+}
+
+// BTQLQueryResponse represents the rows returned by a BTQL query.
+type BTQLQueryResponse struct {
+	Cursor string                   `json:"cursor,omitempty"`
+	Data   []map[string]interface{} `json:"data"`
+}
+
+// QueryBTQL runs a BTQL query.
+func (c *Client) QueryBTQL(ctx context.Context, req *BTQLQueryRequest) (*BTQLQueryResponse, error) {
+	if req == nil || strings.TrimSpace(req.Query) == "" {
+		return nil, ErrEmptyBTQLQuery
+	}
+
+	var result BTQLQueryResponse
+	err := c.Do(ctx, "POST", "/btql", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// BTQLStringLiteral quotes a value as a single-quoted BTQL string literal.
+func BTQLStringLiteral(v string) string {
+	v = strings.ReplaceAll(v, `\`, `\\`)
+	v = strings.ReplaceAll(v, `'`, `\'`)
+	return "'" + v + "'"
+}
@@ -0,0 +1,69 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestQueryBTQL(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST method, got %s", r.Method)
+		}
+		if r.URL.Path != "/btql" {
+			t.Errorf("expected path /btql, got %s", r.URL.Path)
+		}
+
+		var req BTQLQueryRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+		if req.Query != "select: * | from: dataset('dataset-123')" {
+			t.Errorf("unexpected query: %q", req.Query)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"data":   []any{map[string]any{"id": "case-1"}},
+			"cursor": "cursor-2",
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	result, err := client.QueryBTQL(context.Background(), &BTQLQueryRequest{Query: "select: * | from: dataset('dataset-123')"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Cursor != "cursor-2" || len(result.Data) != 1 || result.Data[0]["id"] != "case-1" {
+		t.Fatalf("unexpected result: %#v", result)
+	}
+}
+
+func TestQueryBTQL_EmptyQuery(t *testing.T) {
+	client := NewClient("sk-test", "https://api.example.com", "org-test")
+
+	if _, err := client.QueryBTQL(context.Background(), &BTQLQueryRequest{Query: " \n"}); !errors.Is(err, ErrEmptyBTQLQuery) {
+		t.Fatalf("expected ErrEmptyBTQLQuery, got %v", err)
+	}
+}
+
+func TestBTQLStringLiteral(t *testing.T) {
+	testCases := map[string]string{
+		"dataset-123": `'dataset-123'`,
+		`it's`:        `'it\'s'`,
+		`a\b`:         `'a\\b'`,
+	}
+
+	for input, want := range testCases {
+		if got := BTQLStringLiteral(input); got != want {
+			t.Errorf("BTQLStringLiteral(%q) = %s, want %s", input, got, want)
+		}
+	}
+}
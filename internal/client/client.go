@@ -120,13 +120,30 @@ func (c *Client) OrgID() string {
 
 // Do executes an HTTP request with the given method, path, body, and response destination
 func (c *Client) Do(ctx context.Context, method, path string, body, v interface{}) error {
-	baseURL, err := validateBaseURL(c.baseURL)
+	respBody, err := c.doRaw(ctx, method, path, body)
 	if err != nil {
 		return err
 	}
+
+	// Unmarshal response if destination provided
+	if v != nil {
+		if err := json.Unmarshal(respBody, v); err != nil {
+			return fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// doRaw executes an HTTP request and returns the undecoded response body.
+func (c *Client) doRaw(ctx context.Context, method, path string, body interface{}) ([]byte, error) {
+	baseURL, err := validateBaseURL(c.baseURL)
+	if err != nil {
+		return nil, err
+	}
 	pathURL, err := validateRequestPath(path)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	fullURL := baseURL.ResolveReference(pathURL).String()
 
@@ -135,7 +152,7 @@ func (c *Client) Do(ctx context.Context, method, path string, body, v interface{
 	if body != nil {
 		bodyBytes, err := json.Marshal(body)
 		if err != nil {
-			return fmt.Errorf("failed to marshal request body: %w", err)
+			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
 		bodyReader = bytes.NewReader(bodyBytes)
 	}
@@ -143,7 +160,7 @@ func (c *Client) Do(ctx context.Context, method, path string, body, v interface{
 	// Create request
 	req, err := http.NewRequestWithContext(ctx, method, fullURL, bodyReader)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	// Add headers
@@ -155,27 +172,20 @@ func (c *Client) Do(ctx context.Context, method, path string, body, v interface{
 	// Execute request
 	resp, err := c.httpClient.Do(req) //nolint:gosec // G704 false positive: baseURL/path are validated above (https + relative path only).
 	if err != nil {
-		return fmt.Errorf("request failed: %w", err)
+		return nil, fmt.Errorf("request failed: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	// Read response body
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
 	// Check for errors
 	if resp.StatusCode >= 400 {
-		return parseAPIError(resp.StatusCode, respBody)
-	}
-
-	// Unmarshal response if destination provided
-	if v != nil {
-		if err := json.Unmarshal(respBody, v); err != nil {
-			return fmt.Errorf("failed to unmarshal response: %w", err)
-		}
+		return nil, parseAPIError(resp.StatusCode, respBody)
 	}
 
-	return nil
+	return respBody, nil
 }
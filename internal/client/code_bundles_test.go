@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestCreateCodeBundleUpload(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("expected POST method, got %s", r.Method)
+		}
+		if r.URL.Path != "/function/code" {
+			t.Errorf("expected path /function/code, got %s", r.URL.Path)
+		}
+
+		var payload map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		want := map[string]any{
+			"org_id":          "org-test",
+			"runtime_context": map[string]any{"runtime": "python", "version": "3.12"},
+		}
+		if !reflect.DeepEqual(payload, want) {
+			t.Errorf("unexpected payload: %#v", payload)
+		}
+
+		_, _ = w.Write([]byte(`{"url":"https://uploads.example.com/bundle?sig=abc","bundleId":"bundle-123"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	upload, err := client.CreateCodeBundleUpload(context.Background(), &CodeBundleUploadRequest{
+		RuntimeContext: RuntimeContext{Runtime: "python", Version: "3.12"},
+	})
+	if err != nil {
+		t.Fatalf("CreateCodeBundleUpload() error = %v", err)
+	}
+	if upload.BundleID != "bundle-123" || upload.URL != "https://uploads.example.com/bundle?sig=abc" {
+		t.Errorf("unexpected upload: %#v", upload)
+	}
+}
+
+func TestUploadCodeBundle(t *testing.T) {
+	bundle := []byte("PK\x03\x04bundle")
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" {
+			t.Errorf("expected PUT method, got %s", r.Method)
+		}
+		if r.URL.RawQuery != "sig=abc" {
+			t.Errorf("expected signed query to be preserved, got %q", r.URL.RawQuery)
+		}
+		if auth := r.Header.Get("Authorization"); auth != "" {
+			t.Errorf("expected no Authorization header, got %q", auth)
+		}
+		if contentType := r.Header.Get("Content-Type"); contentType != "application/zip" {
+			t.Errorf("expected application/zip content type, got %q", contentType)
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("failed to read body: %v", err)
+		}
+		if string(body) != string(bundle) {
+			t.Errorf("unexpected body: %q", body)
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", "https://api.example.com", "org-test")
+	client.httpClient = server.Client()
+
+	if err := client.UploadCodeBundle(context.Background(), server.URL+"/bundle?sig=abc", bundle); err != nil {
+		t.Fatalf("UploadCodeBundle() error = %v", err)
+	}
+}
+
+func TestUploadCodeBundle_Error(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte("<Error><Code>AccessDenied</Code></Error>"))
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", "https://api.example.com", "org-test")
+	client.httpClient = server.Client()
+
+	err := client.UploadCodeBundle(context.Background(), server.URL+"/bundle", []byte("zip"))
+	apiErr := &APIError{}
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected 403 APIError, got %v", err)
+	}
+}
+
+func TestUploadCodeBundle_InvalidURL(t *testing.T) {
+	client := NewClient("sk-test", "https://api.example.com", "org-test")
+
+	if err := client.UploadCodeBundle(context.Background(), " ", nil); !errors.Is(err, ErrEmptyBundleUploadURL) {
+		t.Errorf("expected ErrEmptyBundleUploadURL, got %v", err)
+	}
+	if err := client.UploadCodeBundle(context.Background(), "http://uploads.example.com/bundle", nil); err == nil {
+		t.Error("expected error for non-https URL")
+	}
+}
@@ -0,0 +1,203 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrEmptyDatasetEventID is returned when a dataset event ID is empty.
+var ErrEmptyDatasetEventID = errors.New("dataset event ID cannot be empty")
+
+// DatasetEvent represents a single record stored in a dataset.
+type DatasetEvent struct {
+	Input      interface{}            `json:"input,omitempty"`
+	Expected   interface{}            `json:"expected,omitempty"`
+	Metadata   map[string]interface{} `json:"metadata,omitempty"`
+	ID         string                 `json:"id"`
+	XactID     string                 `json:"_xact_id,omitempty"`
+	Created    string                 `json:"created,omitempty"`
+	DatasetID  string                 `json:"dataset_id,omitempty"`
+	ProjectID  string                 `json:"project_id,omitempty"`
+	SpanID     string                 `json:"span_id,omitempty"`
+	RootSpanID string                 `json:"root_span_id,omitempty"`
+	Tags       []string               `json:"tags,omitempty"`
+}
+
+// InsertDatasetEvent represents a record written to a dataset. Pointer fields
+// are omitted when nil and sent as JSON null when they point to a nil value,
+// which clears the field on merge events.
+type InsertDatasetEvent struct {
+	Input        *interface{}            `json:"input,omitempty"`
+	Expected     *interface{}            `json:"expected,omitempty"`
+	Metadata     *map[string]interface{} `json:"metadata,omitempty"`
+	Tags         *[]string               `json:"tags,omitempty"`
+	ID           string                  `json:"id,omitempty"`
+	MergePaths   [][]string              `json:"_merge_paths,omitempty"`
+	IsMerge      bool                    `json:"_is_merge,omitempty"`
+	ObjectDelete bool                    `json:"_object_delete,omitempty"`
+}
+
+// InsertDatasetEventsRequest represents a request to insert dataset events.
+type InsertDatasetEventsRequest struct {
+	Events []InsertDatasetEvent `json:"events"`
+}
+
+// InsertDatasetEventsResponse contains the IDs of the inserted rows, in the
+// same order as the request events.
+type InsertDatasetEventsResponse struct {
+	RowIDs []string `json:"row_ids"`
+}
+
+// DatasetEventFilter narrows fetched dataset events. Only `path_lookup`
+// filters, which match an exact value at a JSON path, are supported by the API.
+type DatasetEventFilter struct {
+	Value interface{} `json:"value"`
+	Type  string      `json:"type"`
+	Path  []string    `json:"path"`
+}
+
+// FetchDatasetEventsRequest represents a request to fetch dataset events.
+// Version pins the fetch to the dataset as of a transaction ID.
+type FetchDatasetEventsRequest struct {
+	Cursor  string               `json:"cursor,omitempty"`
+	Version string               `json:"version,omitempty"`
+	Filters []DatasetEventFilter `json:"filters,omitempty"`
+	Limit   int                  `json:"limit,omitempty"`
+}
+
+// FetchDatasetEventsResponse represents a page of dataset events.
+type FetchDatasetEventsResponse struct {
+	Cursor string         `json:"cursor,omitempty"`
+	Events []DatasetEvent `json:"events"`
+}
+
+// DatasetEventIDFilter returns a filter matching the event with the given ID.
+func DatasetEventIDFilter(id string) DatasetEventFilter {
+	return DatasetEventFilter{
+		Type:  "path_lookup",
+		Path:  []string{"id"},
+		Value: id,
+	}
+}
+
+// InsertDatasetEvents inserts, merges or deletes records in a dataset.
+func (c *Client) InsertDatasetEvents(ctx context.Context, datasetID string, req *InsertDatasetEventsRequest) (*InsertDatasetEventsResponse, error) {
+	datasetID = strings.TrimSpace(datasetID)
+	if datasetID == "" {
+		return nil, ErrEmptyDatasetID
+	}
+
+	var result InsertDatasetEventsResponse
+	err := c.Do(ctx, "POST", datasetPath(datasetID)+"/insert", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// FetchDatasetEvents fetches records from a dataset.
+func (c *Client) FetchDatasetEvents(ctx context.Context, datasetID string, req *FetchDatasetEventsRequest) (*FetchDatasetEventsResponse, error) {
+	datasetID = strings.TrimSpace(datasetID)
+	if datasetID == "" {
+		return nil, ErrEmptyDatasetID
+	}
+	if req == nil {
+		req = &FetchDatasetEventsRequest{}
+	}
+
+	var result FetchDatasetEventsResponse
+	err := c.Do(ctx, "POST", datasetPath(datasetID)+"/fetch", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// QueryDatasetEvents fetches dataset records matching a BTQL filter
+// expression. An empty filter matches every record.
+func (c *Client) QueryDatasetEvents(ctx context.Context, datasetID, filter string, limit int, cursor string) (*FetchDatasetEventsResponse, error) {
+	datasetID = strings.TrimSpace(datasetID)
+	if datasetID == "" {
+		return nil, ErrEmptyDatasetID
+	}
+
+	clauses := []string{
+		"select: *",
+		"from: dataset(" + BTQLStringLiteral(datasetID) + ")",
+	}
+	if filter = strings.TrimSpace(filter); filter != "" {
+		clauses = append(clauses, "filter: "+filter)
+	}
+	if limit > 0 {
+		clauses = append(clauses, fmt.Sprintf("limit: %d", limit))
+	}
+	if cursor != "" {
+		clauses = append(clauses, "cursor: "+BTQLStringLiteral(cursor))
+	}
+
+	result, err := c.QueryBTQL(ctx, &BTQLQueryRequest{Query: strings.Join(clauses, "\n")})
+	if err != nil {
+		return nil, err
+	}
+
+	// BTQL rows share the fetch event shape, so round-trip them through JSON.
+	encoded, err := json.Marshal(result.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FetchDatasetEventsResponse{Cursor: result.Cursor}
+	if err := json.Unmarshal(encoded, &response.Events); err != nil {
+		return nil, fmt.Errorf("decode BTQL rows: %w", err)
+	}
+
+	return response, nil
+}
+
+// GetDatasetEvent fetches a single dataset record by ID. It returns a
+// not-found error when the dataset has no record with that ID.
+func (c *Client) GetDatasetEvent(ctx context.Context, datasetID, id string) (*DatasetEvent, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return nil, ErrEmptyDatasetEventID
+	}
+
+	result, err := c.FetchDatasetEvents(ctx, datasetID, &FetchDatasetEventsRequest{
+		Filters: []DatasetEventFilter{DatasetEventIDFilter(id)},
+		Limit:   1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range result.Events {
+		if result.Events[i].ID == id {
+			return &result.Events[i], nil
+		}
+	}
+
+	return nil, &APIError{StatusCode: 404, Message: "dataset event not found: " + id}
+}
+
+// DeleteDatasetEvents deletes records from a dataset by ID.
+func (c *Client) DeleteDatasetEvents(ctx context.Context, datasetID string, ids []string) error {
+	events := make([]InsertDatasetEvent, 0, len(ids))
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			return ErrEmptyDatasetEventID
+		}
+		events = append(events, InsertDatasetEvent{ID: id, ObjectDelete: true})
+	}
+	if len(events) == 0 {
+		return nil
+	}
+
+	_, err := c.InsertDatasetEvents(ctx, datasetID, &InsertDatasetEventsRequest{Events: events})
+	return err
+}
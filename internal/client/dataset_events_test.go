@@ -0,0 +1,348 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestInsertDatasetEvents(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST method, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/dataset/dataset-123/insert" {
+			t.Errorf("expected path /v1/dataset/dataset-123/insert, got %s", r.URL.Path)
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("read request: %v", err)
+		}
+
+		var got map[string]any
+		if err := json.Unmarshal(body, &got); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+
+		want := map[string]any{
+			"events": []any{
+				map[string]any{
+					"id":       "case-1",
+					"input":    map[string]any{"question": "2+2"},
+					"expected": "4",
+					"metadata": map[string]any{"source": "golden"},
+					"tags":     []any{"math"},
+				},
+				map[string]any{
+					"id":           "case-2",
+					"expected":     nil,
+					"_is_merge":    true,
+					"_merge_paths": []any{[]any{"expected"}},
+				},
+			},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("unexpected request payload:\n got: %#v\nwant: %#v", got, want)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(InsertDatasetEventsResponse{RowIDs: []string{"case-1", "case-2"}})
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	var input interface{} = map[string]interface{}{"question": "2+2"}
+	var expected interface{} = "4"
+	var cleared interface{}
+	metadata := map[string]interface{}{"source": "golden"}
+	tags := []string{"math"}
+
+	result, err := client.InsertDatasetEvents(context.Background(), "dataset-123", &InsertDatasetEventsRequest{
+		Events: []InsertDatasetEvent{
+			{ID: "case-1", Input: &input, Expected: &expected, Metadata: &metadata, Tags: &tags},
+			{ID: "case-2", Expected: &cleared, IsMerge: true, MergePaths: [][]string{{"expected"}}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(result.RowIDs, []string{"case-1", "case-2"}) {
+		t.Fatalf("unexpected row_ids: %v", result.RowIDs)
+	}
+}
+
+func TestInsertDatasetEvents_WhitespaceDatasetID(t *testing.T) {
+	requestCount := 0
+	server := httptest.NewTLSServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		requestCount++
+		t.Fatalf("expected no API call for whitespace-only ID")
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	_, err := client.InsertDatasetEvents(context.Background(), " \t\r\n", &InsertDatasetEventsRequest{})
+	if !errors.Is(err, ErrEmptyDatasetID) {
+		t.Fatalf("expected ErrEmptyDatasetID, got %v", err)
+	}
+	if requestCount != 0 {
+		t.Fatalf("expected no API call for whitespace-only ID, got %d request(s)", requestCount)
+	}
+}
+
+func TestFetchDatasetEvents(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST method, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/dataset/dataset-123/fetch" {
+			t.Errorf("expected path /v1/dataset/dataset-123/fetch, got %s", r.URL.Path)
+		}
+
+		var req FetchDatasetEventsRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+		if req.Limit != 100 || req.Cursor != "cursor-1" {
+			t.Errorf("unexpected pagination: limit=%d cursor=%q", req.Limit, req.Cursor)
+		}
+
+		resp := FetchDatasetEventsResponse{
+			Cursor: "cursor-2",
+			Events: []DatasetEvent{{ID: "case-1", Expected: "4", Tags: []string{"math"}}},
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	result, err := client.FetchDatasetEvents(context.Background(), "dataset-123", &FetchDatasetEventsRequest{
+		Limit:  100,
+		Cursor: "cursor-1",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Cursor != "cursor-2" {
+		t.Errorf("expected cursor cursor-2, got %q", result.Cursor)
+	}
+	if len(result.Events) != 1 || result.Events[0].Expected != "4" {
+		t.Fatalf("unexpected events: %#v", result.Events)
+	}
+}
+
+func TestGetDatasetEvent(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("read request: %v", err)
+		}
+
+		var got map[string]any
+		if err := json.Unmarshal(body, &got); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+
+		want := map[string]any{
+			"limit": float64(1),
+			"filters": []any{
+				map[string]any{"type": "path_lookup", "path": []any{"id"}, "value": "case-1"},
+			},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("unexpected request payload:\n got: %#v\nwant: %#v", got, want)
+		}
+
+		resp := FetchDatasetEventsResponse{}
+		if got["filters"].([]any)[0].(map[string]any)["value"] == "case-1" {
+			resp.Events = []DatasetEvent{{ID: "case-1", Input: "2+2"}}
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	event, err := client.GetDatasetEvent(context.Background(), "dataset-123", "case-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if event.ID != "case-1" || event.Input != "2+2" {
+		t.Fatalf("unexpected event: %#v", event)
+	}
+}
+
+func TestGetDatasetEvent_NotFound(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(FetchDatasetEventsResponse{Events: []DatasetEvent{}})
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	_, err := client.GetDatasetEvent(context.Background(), "dataset-123", "missing")
+	if !IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestGetDatasetEvent_WhitespaceID(t *testing.T) {
+	requestCount := 0
+	server := httptest.NewTLSServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		requestCount++
+		t.Fatalf("expected no API call for whitespace-only ID")
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	_, err := client.GetDatasetEvent(context.Background(), "dataset-123", " \t\r\n")
+	if !errors.Is(err, ErrEmptyDatasetEventID) {
+		t.Fatalf("expected ErrEmptyDatasetEventID, got %v", err)
+	}
+	if requestCount != 0 {
+		t.Fatalf("expected no API call for whitespace-only ID, got %d request(s)", requestCount)
+	}
+}
+
+func TestDeleteDatasetEvents(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/dataset/dataset-123/insert" {
+			t.Errorf("expected path /v1/dataset/dataset-123/insert, got %s", r.URL.Path)
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("read request: %v", err)
+		}
+
+		var got map[string]any
+		if err := json.Unmarshal(body, &got); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+
+		want := map[string]any{
+			"events": []any{
+				map[string]any{"id": "case-1", "_object_delete": true},
+				map[string]any{"id": "case-2", "_object_delete": true},
+			},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("unexpected request payload:\n got: %#v\nwant: %#v", got, want)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(InsertDatasetEventsResponse{RowIDs: []string{"case-1", "case-2"}})
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	if err := client.DeleteDatasetEvents(context.Background(), "dataset-123", []string{"case-1", "case-2"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestDeleteDatasetEvents_WhitespaceID(t *testing.T) {
+	requestCount := 0
+	server := httptest.NewTLSServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		requestCount++
+		t.Fatalf("expected no API call for whitespace-only ID")
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	err := client.DeleteDatasetEvents(context.Background(), "dataset-123", []string{"case-1", " "})
+	if !errors.Is(err, ErrEmptyDatasetEventID) {
+		t.Fatalf("expected ErrEmptyDatasetEventID, got %v", err)
+	}
+	if requestCount != 0 {
+		t.Fatalf("expected no API call for whitespace-only ID, got %d request(s)", requestCount)
+	}
+}
+
+func TestFetchDatasetEvents_Version(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req FetchDatasetEventsRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+		if req.Version != "1000192656880881099" {
+			t.Errorf("expected version 1000192656880881099, got %q", req.Version)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(FetchDatasetEventsResponse{Events: []DatasetEvent{}})
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	if _, err := client.FetchDatasetEvents(context.Background(), "dataset-123", &FetchDatasetEventsRequest{Version: "1000192656880881099"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestQueryDatasetEvents(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/btql" {
+			t.Errorf("expected path /btql, got %s", r.URL.Path)
+		}
+
+		var req BTQLQueryRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+
+		want := "select: *\nfrom: dataset('dataset-123')\nfilter: metadata.source = 'golden'\nlimit: 50\ncursor: 'cursor-1'"
+		if req.Query != want {
+			t.Errorf("unexpected query:\n got: %q\nwant: %q", req.Query, want)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"data": []any{
+				map[string]any{"id": "case-1", "_xact_id": "1000192656880881099", "expected": "4", "tags": []any{"math"}},
+			},
+			"cursor": "cursor-2",
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	result, err := client.QueryDatasetEvents(context.Background(), "dataset-123", "metadata.source = 'golden'", 50, "cursor-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Cursor != "cursor-2" {
+		t.Errorf("expected cursor cursor-2, got %q", result.Cursor)
+	}
+	if len(result.Events) != 1 || result.Events[0].XactID != "1000192656880881099" || !reflect.DeepEqual(result.Events[0].Tags, []string{"math"}) {
+		t.Fatalf("unexpected events: %#v", result.Events)
+	}
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// GetDatasetVersion returns the latest transaction ID (`_xact_id`) among the
+// dataset's current records, optionally narrowed by a BTQL filter expression.
+// It returns an empty version when no record matches.
+func (c *Client) GetDatasetVersion(ctx context.Context, datasetID, filter string) (string, error) {
+	datasetID = strings.TrimSpace(datasetID)
+	if datasetID == "" {
+		return "", ErrEmptyDatasetID
+	}
+
+	return c.latestVersion(ctx, "dataset("+BTQLStringLiteral(datasetID)+")", filter)
+}
+
+// GetExperimentVersion returns the latest transaction ID (`_xact_id`) among the
+// experiment's current events. It returns an empty version when the experiment
+// has no events.
+func (c *Client) GetExperimentVersion(ctx context.Context, experimentID string) (string, error) {
+	experimentID = strings.TrimSpace(experimentID)
+	if experimentID == "" {
+		return "", ErrEmptyExperimentID
+	}
+
+	return c.latestVersion(ctx, "experiment("+BTQLStringLiteral(experimentID)+")", "")
+}
+
+// latestVersion returns the highest `_xact_id` among the rows of a BTQL source
+// that match filter.
+func (c *Client) latestVersion(ctx context.Context, from, filter string) (string, error) {
+	clauses := []string{
+		"select: _xact_id",
+		"from: " + from,
+	}
+	if filter = strings.TrimSpace(filter); filter != "" {
+		clauses = append(clauses, "filter: "+filter)
+	}
+	clauses = append(clauses, "sort: _xact_id desc", "limit: 1")
+
+	result, err := c.QueryBTQL(ctx, &BTQLQueryRequest{Query: strings.Join(clauses, "\n")})
+	if err != nil {
+		return "", err
+	}
+	if len(result.Data) == 0 {
+		return "", nil
+	}
+
+	version, ok := result.Data[0]["_xact_id"].(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected _xact_id value %v", result.Data[0]["_xact_id"])
+	}
+
+	return version, nil
+}
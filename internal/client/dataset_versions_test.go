@@ -0,0 +1,123 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetDatasetVersion(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/btql" {
+			t.Errorf("expected path /btql, got %s", r.URL.Path)
+		}
+
+		var req BTQLQueryRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+
+		want := "select: _xact_id\nfrom: dataset('dataset-123')\nfilter: tags includes 'release'\nsort: _xact_id desc\nlimit: 1"
+		if req.Query != want {
+			t.Errorf("unexpected query:\n got: %q\nwant: %q", req.Query, want)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"data": []any{map[string]any{"_xact_id": "1000192656880881099"}},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	version, err := client.GetDatasetVersion(context.Background(), "dataset-123", "tags includes 'release'")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if version != "1000192656880881099" {
+		t.Errorf("expected version 1000192656880881099, got %q", version)
+	}
+}
+
+func TestGetDatasetVersion_Empty(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req BTQLQueryRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+
+		want := "select: _xact_id\nfrom: dataset('dataset-123')\nsort: _xact_id desc\nlimit: 1"
+		if req.Query != want {
+			t.Errorf("unexpected query:\n got: %q\nwant: %q", req.Query, want)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": []any{}})
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	version, err := client.GetDatasetVersion(context.Background(), "dataset-123", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if version != "" {
+		t.Errorf("expected empty version, got %q", version)
+	}
+}
+
+func TestGetDatasetVersion_EmptyID(t *testing.T) {
+	client := NewClient("sk-test", "https://example.com", "org-test")
+
+	_, err := client.GetDatasetVersion(context.Background(), "  ", "")
+	if !errors.Is(err, ErrEmptyDatasetID) {
+		t.Fatalf("expected ErrEmptyDatasetID, got %v", err)
+	}
+}
+
+func TestGetExperimentVersion(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req BTQLQueryRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+
+		want := "select: _xact_id\nfrom: experiment('experiment-123')\nsort: _xact_id desc\nlimit: 1"
+		if req.Query != want {
+			t.Errorf("unexpected query:\n got: %q\nwant: %q", req.Query, want)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"data": []any{map[string]any{"_xact_id": "1000192656880881099"}},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	version, err := client.GetExperimentVersion(context.Background(), "experiment-123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if version != "1000192656880881099" {
+		t.Errorf("expected version 1000192656880881099, got %q", version)
+	}
+}
+
+func TestGetExperimentVersion_EmptyID(t *testing.T) {
+	client := NewClient("sk-test", "https://example.com", "org-test")
+
+	_, err := client.GetExperimentVersion(context.Background(), "  ")
+	if !errors.Is(err, ErrEmptyExperimentID) {
+		t.Fatalf("expected ErrEmptyExperimentID, got %v", err)
+	}
+}
@@ -0,0 +1,273 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+var (
+	// ErrEmptyEnvironmentID is returned when an environment ID is empty.
+	ErrEmptyEnvironmentID = errors.New("environment ID cannot be empty")
+	// ErrEmptyEnvironmentSlug is returned when an environment slug is empty.
+	ErrEmptyEnvironmentSlug = errors.New("environment slug cannot be empty")
+	// ErrEmptyEnvironmentObjectType is returned when an environment object type is empty.
+	ErrEmptyEnvironmentObjectType = errors.New("environment object type cannot be empty")
+	// ErrEmptyEnvironmentObjectID is returned when an environment object ID is empty.
+	ErrEmptyEnvironmentObjectID = errors.New("environment object ID cannot be empty")
+)
+
+// Environment represents a Braintrust environment such as dev, staging or
+// production.
+type Environment struct {
+	ID          string `json:"id"`
+	OrgID       string `json:"org_id,omitempty"`
+	Name        string `json:"name"`
+	Slug        string `json:"slug"`
+	Description string `json:"description,omitempty"`
+	Created     string `json:"created,omitempty"`
+	DeletedAt   string `json:"deleted_at,omitempty"`
+}
+
+// ListEnvironmentsOptions represents options for listing environments.
+type ListEnvironmentsOptions struct {
+	OrgName         string
+	EnvironmentName string
+	StartingAfter   string
+	EndingBefore    string
+	IDs             []string
+	Limit           int
+}
+
+// ListEnvironmentsResponse represents a list of environments.
+type ListEnvironmentsResponse struct {
+	Environments []Environment `json:"objects"`
+}
+
+// CreateEnvironmentRequest represents a request to create an environment.
+type CreateEnvironmentRequest struct {
+	Name        string `json:"name"`
+	Slug        string `json:"slug"`
+	Description string `json:"description,omitempty"`
+	OrgName     string `json:"org_name,omitempty"`
+}
+
+// UpdateEnvironmentRequest represents a request to update an environment.
+type UpdateEnvironmentRequest struct {
+	Name        *string `json:"name,omitempty"`
+	Slug        *string `json:"slug,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// EnvironmentObject associates a version of an object, such as a prompt or
+// function, with an environment.
+type EnvironmentObject struct {
+	ID              string `json:"id"`
+	OrgID           string `json:"org_id,omitempty"`
+	ObjectType      string `json:"object_type"`
+	ObjectID        string `json:"object_id"`
+	ObjectVersion   string `json:"object_version"`
+	EnvironmentSlug string `json:"environment_slug"`
+	Created         string `json:"created,omitempty"`
+}
+
+// ListEnvironmentObjectsResponse represents the environment associations of
+// an object.
+type ListEnvironmentObjectsResponse struct {
+	EnvironmentObjects []EnvironmentObject `json:"objects"`
+}
+
+// SetEnvironmentObjectRequest represents a request to point an environment at
+// an object version.
+type SetEnvironmentObjectRequest struct {
+	ObjectVersion string `json:"object_version"`
+}
+
+func environmentPath(id string) string {
+	return "/v1/environment/" + url.PathEscape(id)
+}
+
+func environmentObjectsPath(objectType, objectID string) string {
+	return "/v1/environment-object/" + url.PathEscape(objectType) + "/" + url.PathEscape(objectID)
+}
+
+func environmentObjectPath(objectType, objectID, environmentSlug string) string {
+	return environmentObjectsPath(objectType, objectID) + "/" + url.PathEscape(environmentSlug)
+}
+
+// GetEnvironment retrieves an environment by ID.
+func (c *Client) GetEnvironment(ctx context.Context, id string) (*Environment, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return nil, ErrEmptyEnvironmentID
+	}
+
+	var environment Environment
+	err := c.Do(ctx, "GET", environmentPath(id), nil, &environment)
+	if err != nil {
+		return nil, err
+	}
+
+	return &environment, nil
+}
+
+// CreateEnvironment creates a new environment.
+func (c *Client) CreateEnvironment(ctx context.Context, req *CreateEnvironmentRequest) (*Environment, error) {
+	var environment Environment
+	err := c.Do(ctx, "POST", "/v1/environment", req, &environment)
+	if err != nil {
+		return nil, err
+	}
+
+	return &environment, nil
+}
+
+// UpdateEnvironment updates an existing environment.
+func (c *Client) UpdateEnvironment(ctx context.Context, id string, req *UpdateEnvironmentRequest) (*Environment, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return nil, ErrEmptyEnvironmentID
+	}
+
+	var environment Environment
+	err := c.Do(ctx, "PATCH", environmentPath(id), req, &environment)
+	if err != nil {
+		return nil, err
+	}
+
+	return &environment, nil
+}
+
+// DeleteEnvironment deletes an environment by ID.
+func (c *Client) DeleteEnvironment(ctx context.Context, id string) error {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return ErrEmptyEnvironmentID
+	}
+
+	return c.Do(ctx, "DELETE", environmentPath(id), nil, nil)
+}
+
+// ListEnvironments lists environments, optionally filtered by API-native query parameters.
+func (c *Client) ListEnvironments(ctx context.Context, opts *ListEnvironmentsOptions) (*ListEnvironmentsResponse, error) {
+	path := "/v1/environment"
+
+	if opts != nil {
+		params := url.Values{}
+		if opts.Limit > 0 {
+			params.Set("limit", fmt.Sprintf("%d", opts.Limit))
+		}
+		if opts.StartingAfter != "" {
+			params.Set("starting_after", opts.StartingAfter)
+		}
+		if opts.EndingBefore != "" {
+			params.Set("ending_before", opts.EndingBefore)
+		}
+		for _, id := range opts.IDs {
+			if id != "" {
+				params.Add("ids", id)
+			}
+		}
+		if opts.OrgName != "" {
+			params.Set("org_name", opts.OrgName)
+		}
+		if opts.EnvironmentName != "" {
+			params.Set("environment_name", opts.EnvironmentName)
+		}
+
+		if encodedParams := params.Encode(); encodedParams != "" {
+			path += "?" + encodedParams
+		}
+	}
+
+	var result ListEnvironmentsResponse
+	err := c.Do(ctx, "GET", path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetEnvironmentObject retrieves the version of an object an environment
+// points at.
+func (c *Client) GetEnvironmentObject(ctx context.Context, objectType, objectID, environmentSlug string) (*EnvironmentObject, error) {
+	objectType, objectID, environmentSlug, err := normalizeEnvironmentObjectKey(objectType, objectID, environmentSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	var environmentObject EnvironmentObject
+	err = c.Do(ctx, "GET", environmentObjectPath(objectType, objectID, environmentSlug), nil, &environmentObject)
+	if err != nil {
+		return nil, err
+	}
+
+	return &environmentObject, nil
+}
+
+// SetEnvironmentObject points an environment at a version of an object,
+// replacing any version it pointed at before.
+func (c *Client) SetEnvironmentObject(ctx context.Context, objectType, objectID, environmentSlug string, req *SetEnvironmentObjectRequest) (*EnvironmentObject, error) {
+	objectType, objectID, environmentSlug, err := normalizeEnvironmentObjectKey(objectType, objectID, environmentSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	var environmentObject EnvironmentObject
+	err = c.Do(ctx, "PUT", environmentObjectPath(objectType, objectID, environmentSlug), req, &environmentObject)
+	if err != nil {
+		return nil, err
+	}
+
+	return &environmentObject, nil
+}
+
+// DeleteEnvironmentObject removes an environment's association with an object.
+func (c *Client) DeleteEnvironmentObject(ctx context.Context, objectType, objectID, environmentSlug string) error {
+	objectType, objectID, environmentSlug, err := normalizeEnvironmentObjectKey(objectType, objectID, environmentSlug)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(ctx, "DELETE", environmentObjectPath(objectType, objectID, environmentSlug), nil, nil)
+}
+
+// ListEnvironmentObjects lists the environments an object is associated with.
+func (c *Client) ListEnvironmentObjects(ctx context.Context, objectType, objectID string) (*ListEnvironmentObjectsResponse, error) {
+	objectType = strings.TrimSpace(objectType)
+	if objectType == "" {
+		return nil, ErrEmptyEnvironmentObjectType
+	}
+	objectID = strings.TrimSpace(objectID)
+	if objectID == "" {
+		return nil, ErrEmptyEnvironmentObjectID
+	}
+
+	var result ListEnvironmentObjectsResponse
+	err := c.Do(ctx, "GET", environmentObjectsPath(objectType, objectID), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func normalizeEnvironmentObjectKey(objectType, objectID, environmentSlug string) (string, string, string, error) {
+	objectType = strings.TrimSpace(objectType)
+	if objectType == "" {
+		return "", "", "", ErrEmptyEnvironmentObjectType
+	}
+	objectID = strings.TrimSpace(objectID)
+	if objectID == "" {
+		return "", "", "", ErrEmptyEnvironmentObjectID
+	}
+	environmentSlug = strings.TrimSpace(environmentSlug)
+	if environmentSlug == "" {
+		return "", "", "", ErrEmptyEnvironmentSlug
+	}
+
+	return objectType, objectID, environmentSlug, nil
+}
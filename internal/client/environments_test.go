@@ -0,0 +1,300 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestGetEnvironment(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("expected GET method, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/environment/env-123" {
+			t.Errorf("expected path /v1/environment/env-123, got %s", r.URL.Path)
+		}
+
+		_ = json.NewEncoder(w).Encode(Environment{ID: "env-123", Name: "Production", Slug: "production"})
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	environment, err := client.GetEnvironment(context.Background(), "env-123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if environment.Slug != "production" {
+		t.Errorf("expected slug production, got %s", environment.Slug)
+	}
+}
+
+func TestGetEnvironment_EmptyID(t *testing.T) {
+	client := NewClient("sk-test", "https://api.braintrust.dev", "org-test")
+
+	_, err := client.GetEnvironment(context.Background(), " ")
+	if !errors.Is(err, ErrEmptyEnvironmentID) {
+		t.Fatalf("expected ErrEmptyEnvironmentID, got %v", err)
+	}
+}
+
+func TestCreateEnvironment(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("expected POST method, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/environment" {
+			t.Errorf("expected path /v1/environment, got %s", r.URL.Path)
+		}
+
+		var payload map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		expected := map[string]any{"name": "Staging", "slug": "staging"}
+		if !reflect.DeepEqual(payload, expected) {
+			t.Errorf("expected payload %v, got %v", expected, payload)
+		}
+
+		_ = json.NewEncoder(w).Encode(Environment{ID: "env-123", Name: "Staging", Slug: "staging"})
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	environment, err := client.CreateEnvironment(context.Background(), &CreateEnvironmentRequest{Name: "Staging", Slug: "staging"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if environment.ID != "env-123" {
+		t.Errorf("expected id env-123, got %s", environment.ID)
+	}
+}
+
+func TestUpdateEnvironment(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PATCH" {
+			t.Errorf("expected PATCH method, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/environment/env-123" {
+			t.Errorf("expected path /v1/environment/env-123, got %s", r.URL.Path)
+		}
+
+		var payload map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		expected := map[string]any{"description": ""}
+		if !reflect.DeepEqual(payload, expected) {
+			t.Errorf("expected payload %v, got %v", expected, payload)
+		}
+
+		_ = json.NewEncoder(w).Encode(Environment{ID: "env-123", Name: "Staging", Slug: "staging"})
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	description := ""
+	if _, err := client.UpdateEnvironment(context.Background(), "env-123", &UpdateEnvironmentRequest{Description: &description}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestDeleteEnvironment(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" {
+			t.Errorf("expected DELETE method, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/environment/env-123" {
+			t.Errorf("expected path /v1/environment/env-123, got %s", r.URL.Path)
+		}
+
+		_ = json.NewEncoder(w).Encode(Environment{ID: "env-123"})
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	if err := client.DeleteEnvironment(context.Background(), "env-123"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestListEnvironments_WithOptions(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/environment" {
+			t.Errorf("expected path /v1/environment, got %s", r.URL.Path)
+		}
+
+		query := r.URL.Query()
+		if got := query.Get("environment_name"); got != "Production" {
+			t.Errorf("expected environment_name Production, got %q", got)
+		}
+		if got := query["ids"]; !reflect.DeepEqual(got, []string{"env-1", "env-2"}) {
+			t.Errorf("expected ids [env-1 env-2], got %v", got)
+		}
+		if got := query.Get("limit"); got != "5" {
+			t.Errorf("expected limit 5, got %q", got)
+		}
+
+		_ = json.NewEncoder(w).Encode(ListEnvironmentsResponse{Environments: []Environment{{ID: "env-1"}}})
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	result, err := client.ListEnvironments(context.Background(), &ListEnvironmentsOptions{
+		EnvironmentName: "Production",
+		IDs:             []string{"env-1", "env-2"},
+		Limit:           5,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result.Environments) != 1 {
+		t.Fatalf("expected 1 environment, got %d", len(result.Environments))
+	}
+}
+
+func TestSetEnvironmentObject(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" {
+			t.Errorf("expected PUT method, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/environment-object/prompt/prompt-123/production" {
+			t.Errorf("expected path /v1/environment-object/prompt/prompt-123/production, got %s", r.URL.Path)
+		}
+
+		var payload map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		expected := map[string]any{"object_version": "1000192656880881099"}
+		if !reflect.DeepEqual(payload, expected) {
+			t.Errorf("expected payload %v, got %v", expected, payload)
+		}
+
+		_ = json.NewEncoder(w).Encode(EnvironmentObject{
+			ID:              "assoc-123",
+			ObjectType:      "prompt",
+			ObjectID:        "prompt-123",
+			ObjectVersion:   "1000192656880881099",
+			EnvironmentSlug: "production",
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	environmentObject, err := client.SetEnvironmentObject(context.Background(), "prompt", " prompt-123 ", "production", &SetEnvironmentObjectRequest{
+		ObjectVersion: "1000192656880881099",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if environmentObject.ID != "assoc-123" {
+		t.Errorf("expected id assoc-123, got %s", environmentObject.ID)
+	}
+}
+
+func TestGetEnvironmentObject_EscapesPath(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("expected GET method, got %s", r.Method)
+		}
+		if r.URL.EscapedPath() != "/v1/environment-object/function/func%2F1/qa%20env" {
+			t.Errorf("unexpected escaped path %s", r.URL.EscapedPath())
+		}
+
+		_ = json.NewEncoder(w).Encode(EnvironmentObject{ID: "assoc-123"})
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	if _, err := client.GetEnvironmentObject(context.Background(), "function", "func/1", "qa env"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestDeleteEnvironmentObject(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" {
+			t.Errorf("expected DELETE method, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/environment-object/prompt/prompt-123/staging" {
+			t.Errorf("expected path /v1/environment-object/prompt/prompt-123/staging, got %s", r.URL.Path)
+		}
+
+		_ = json.NewEncoder(w).Encode(EnvironmentObject{ID: "assoc-123"})
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	if err := client.DeleteEnvironmentObject(context.Background(), "prompt", "prompt-123", "staging"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestListEnvironmentObjects(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/environment-object/prompt/prompt-123" {
+			t.Errorf("expected path /v1/environment-object/prompt/prompt-123, got %s", r.URL.Path)
+		}
+
+		_ = json.NewEncoder(w).Encode(ListEnvironmentObjectsResponse{EnvironmentObjects: []EnvironmentObject{
+			{ID: "assoc-1", EnvironmentSlug: "staging"},
+			{ID: "assoc-2", EnvironmentSlug: "production"},
+		}})
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	result, err := client.ListEnvironmentObjects(context.Background(), "prompt", "prompt-123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result.EnvironmentObjects) != 2 {
+		t.Fatalf("expected 2 environment objects, got %d", len(result.EnvironmentObjects))
+	}
+}
+
+func TestEnvironmentObject_EmptyKey(t *testing.T) {
+	client := NewClient("sk-test", "https://api.braintrust.dev", "org-test")
+	ctx := context.Background()
+
+	if _, err := client.GetEnvironmentObject(ctx, "", "prompt-123", "production"); !errors.Is(err, ErrEmptyEnvironmentObjectType) {
+		t.Errorf("expected ErrEmptyEnvironmentObjectType, got %v", err)
+	}
+	if err := client.DeleteEnvironmentObject(ctx, "prompt", " ", "production"); !errors.Is(err, ErrEmptyEnvironmentObjectID) {
+		t.Errorf("expected ErrEmptyEnvironmentObjectID, got %v", err)
+	}
+	if _, err := client.SetEnvironmentObject(ctx, "prompt", "prompt-123", "", &SetEnvironmentObjectRequest{}); !errors.Is(err, ErrEmptyEnvironmentSlug) {
+		t.Errorf("expected ErrEmptyEnvironmentSlug, got %v", err)
+	}
+	if _, err := client.ListEnvironmentObjects(ctx, "prompt", ""); !errors.Is(err, ErrEmptyEnvironmentObjectID) {
+		t.Errorf("expected ErrEmptyEnvironmentObjectID, got %v", err)
+	}
+}
@@ -0,0 +1,161 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ExperimentEvent represents a single span logged to an experiment. Scores
+// without a value are decoded as nil.
+type ExperimentEvent struct {
+	Input           interface{}            `json:"input,omitempty"`
+	Output          interface{}            `json:"output,omitempty"`
+	Expected        interface{}            `json:"expected,omitempty"`
+	Error           interface{}            `json:"error,omitempty"`
+	Scores          map[string]*float64    `json:"scores,omitempty"`
+	Metadata        map[string]interface{} `json:"metadata,omitempty"`
+	Metrics         map[string]interface{} `json:"metrics,omitempty"`
+	Context         map[string]interface{} `json:"context,omitempty"`
+	SpanAttributes  map[string]interface{} `json:"span_attributes,omitempty"`
+	ID              string                 `json:"id"`
+	XactID          string                 `json:"_xact_id,omitempty"`
+	Created         string                 `json:"created,omitempty"`
+	ExperimentID    string                 `json:"experiment_id,omitempty"`
+	ProjectID       string                 `json:"project_id,omitempty"`
+	DatasetRecordID string                 `json:"dataset_record_id,omitempty"`
+	SpanID          string                 `json:"span_id,omitempty"`
+	RootSpanID      string                 `json:"root_span_id,omitempty"`
+	SpanParents     []string               `json:"span_parents,omitempty"`
+	Tags            []string               `json:"tags,omitempty"`
+}
+
+// InsertExperimentEvent represents a span written to an experiment.
+type InsertExperimentEvent struct {
+	Input           interface{}            `json:"input,omitempty"`
+	Output          interface{}            `json:"output,omitempty"`
+	Expected        interface{}            `json:"expected,omitempty"`
+	Error           interface{}            `json:"error,omitempty"`
+	Scores          map[string]*float64    `json:"scores,omitempty"`
+	Metadata        map[string]interface{} `json:"metadata,omitempty"`
+	Metrics         map[string]interface{} `json:"metrics,omitempty"`
+	Context         map[string]interface{} `json:"context,omitempty"`
+	SpanAttributes  map[string]interface{} `json:"span_attributes,omitempty"`
+	ID              string                 `json:"id,omitempty"`
+	Created         string                 `json:"created,omitempty"`
+	DatasetRecordID string                 `json:"dataset_record_id,omitempty"`
+	SpanID          string                 `json:"span_id,omitempty"`
+	RootSpanID      string                 `json:"root_span_id,omitempty"`
+	SpanParents     []string               `json:"span_parents,omitempty"`
+	Tags            []string               `json:"tags,omitempty"`
+}
+
+// InsertExperimentEventsRequest represents a request to insert experiment events.
+type InsertExperimentEventsRequest struct {
+	Events []InsertExperimentEvent `json:"events"`
+}
+
+// InsertExperimentEventsResponse contains the IDs of the inserted rows, in the
+// same order as the request events.
+type InsertExperimentEventsResponse struct {
+	RowIDs []string `json:"row_ids"`
+}
+
+// ExperimentEventFilter narrows fetched experiment events. Only `path_lookup`
+// filters, which match an exact value at a JSON path, are supported by the API.
+type ExperimentEventFilter struct {
+	Value interface{} `json:"value"`
+	Type  string      `json:"type"`
+	Path  []string    `json:"path"`
+}
+
+// FetchExperimentEventsRequest represents a request to fetch experiment events.
+type FetchExperimentEventsRequest struct {
+	Cursor  string                  `json:"cursor,omitempty"`
+	Version string                  `json:"version,omitempty"`
+	Filters []ExperimentEventFilter `json:"filters,omitempty"`
+	Limit   int                     `json:"limit,omitempty"`
+}
+
+// FetchExperimentEventsResponse represents a page of experiment events.
+type FetchExperimentEventsResponse struct {
+	Cursor string            `json:"cursor,omitempty"`
+	Events []ExperimentEvent `json:"events"`
+}
+
+// InsertExperimentEvents inserts events into an experiment.
+func (c *Client) InsertExperimentEvents(ctx context.Context, experimentID string, req *InsertExperimentEventsRequest) (*InsertExperimentEventsResponse, error) {
+	experimentID = strings.TrimSpace(experimentID)
+	if experimentID == "" {
+		return nil, ErrEmptyExperimentID
+	}
+
+	var result InsertExperimentEventsResponse
+	err := c.Do(ctx, "POST", experimentPath(experimentID)+"/insert", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// FetchExperimentEvents fetches events from an experiment.
+func (c *Client) FetchExperimentEvents(ctx context.Context, experimentID string, req *FetchExperimentEventsRequest) (*FetchExperimentEventsResponse, error) {
+	experimentID = strings.TrimSpace(experimentID)
+	if experimentID == "" {
+		return nil, ErrEmptyExperimentID
+	}
+	if req == nil {
+		req = &FetchExperimentEventsRequest{}
+	}
+
+	var result FetchExperimentEventsResponse
+	err := c.Do(ctx, "POST", experimentPath(experimentID)+"/fetch", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// QueryExperimentEvents fetches experiment events matching a BTQL filter
+// expression. An empty filter matches every event.
+func (c *Client) QueryExperimentEvents(ctx context.Context, experimentID, filter string, limit int, cursor string) (*FetchExperimentEventsResponse, error) {
+	experimentID = strings.TrimSpace(experimentID)
+	if experimentID == "" {
+		return nil, ErrEmptyExperimentID
+	}
+
+	clauses := []string{
+		"select: *",
+		"from: experiment(" + BTQLStringLiteral(experimentID) + ")",
+	}
+	if filter = strings.TrimSpace(filter); filter != "" {
+		clauses = append(clauses, "filter: "+filter)
+	}
+	if limit > 0 {
+		clauses = append(clauses, fmt.Sprintf("limit: %d", limit))
+	}
+	if cursor != "" {
+		clauses = append(clauses, "cursor: "+BTQLStringLiteral(cursor))
+	}
+
+	result, err := c.QueryBTQL(ctx, &BTQLQueryRequest{Query: strings.Join(clauses, "\n")})
+	if err != nil {
+		return nil, err
+	}
+
+	// BTQL rows share the fetch event shape, so round-trip them through JSON.
+	encoded, err := json.Marshal(result.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FetchExperimentEventsResponse{Cursor: result.Cursor}
+	if err := json.Unmarshal(encoded, &response.Events); err != nil {
+		return nil, fmt.Errorf("decode BTQL rows: %w", err)
+	}
+
+	return response, nil
+}
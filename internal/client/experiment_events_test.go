@@ -0,0 +1,196 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestFetchExperimentEvents(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST method, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/experiment/experiment-123/fetch" {
+			t.Errorf("expected path /v1/experiment/experiment-123/fetch, got %s", r.URL.Path)
+		}
+
+		var got map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+
+		want := map[string]any{
+			"cursor": "cursor-1",
+			"limit":  float64(100),
+			"filters": []any{
+				map[string]any{"type": "path_lookup", "path": []any{"metadata", "split"}, "value": "test"},
+			},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("unexpected request: %#v", got)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"cursor": "cursor-2",
+			"events": []any{
+				map[string]any{
+					"id":           "span-1",
+					"root_span_id": "span-1",
+					"output":       "5",
+					"expected":     "4",
+					"scores":       map[string]any{"accuracy": 0, "factuality": nil},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	result, err := client.FetchExperimentEvents(context.Background(), "experiment-123", &FetchExperimentEventsRequest{
+		Cursor: "cursor-1",
+		Limit:  100,
+		Filters: []ExperimentEventFilter{
+			{Type: "path_lookup", Path: []string{"metadata", "split"}, Value: "test"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Cursor != "cursor-2" {
+		t.Errorf("expected cursor cursor-2, got %q", result.Cursor)
+	}
+	if len(result.Events) != 1 {
+		t.Fatalf("unexpected events: %#v", result.Events)
+	}
+
+	scores := result.Events[0].Scores
+	if scores["accuracy"] == nil || *scores["accuracy"] != 0 {
+		t.Errorf("expected accuracy score 0, got %v", scores["accuracy"])
+	}
+	if value, ok := scores["factuality"]; !ok || value != nil {
+		t.Errorf("expected null factuality score, got %v", value)
+	}
+}
+
+func TestInsertExperimentEvents(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST method, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/experiment/experiment-123/insert" {
+			t.Errorf("expected path /v1/experiment/experiment-123/insert, got %s", r.URL.Path)
+		}
+
+		var got map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+
+		want := map[string]any{
+			"events": []any{
+				map[string]any{
+					"id":           "span-1",
+					"span_id":      "span-1",
+					"root_span_id": "span-1",
+					"input":        "2+2",
+					"output":       "4",
+					"scores":       map[string]any{"accuracy": float64(1)},
+				},
+				map[string]any{
+					"id":           "span-2",
+					"span_id":      "span-2",
+					"root_span_id": "span-1",
+					"span_parents": []any{"span-1"},
+					"metrics":      map[string]any{"tokens": float64(12)},
+				},
+			},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("unexpected request: %#v", got)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(InsertExperimentEventsResponse{RowIDs: []string{"span-1", "span-2"}})
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	accuracy := 1.0
+	result, err := client.InsertExperimentEvents(context.Background(), "experiment-123", &InsertExperimentEventsRequest{
+		Events: []InsertExperimentEvent{
+			{ID: "span-1", SpanID: "span-1", RootSpanID: "span-1", Input: "2+2", Output: "4", Scores: map[string]*float64{"accuracy": &accuracy}},
+			{ID: "span-2", SpanID: "span-2", RootSpanID: "span-1", SpanParents: []string{"span-1"}, Metrics: map[string]interface{}{"tokens": 12}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(result.RowIDs, []string{"span-1", "span-2"}) {
+		t.Errorf("unexpected row IDs: %v", result.RowIDs)
+	}
+}
+
+func TestQueryExperimentEvents(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/btql" {
+			t.Errorf("expected path /btql, got %s", r.URL.Path)
+		}
+
+		var req BTQLQueryRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+
+		want := "select: *\nfrom: experiment('experiment-123')\nfilter: scores.accuracy < 0.5\nlimit: 50\ncursor: 'cursor-1'"
+		if req.Query != want {
+			t.Errorf("unexpected query:\n got: %q\nwant: %q", req.Query, want)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"data": []any{
+				map[string]any{"id": "span-1", "_xact_id": "1000192656880881099", "scores": map[string]any{"accuracy": 0.25}},
+			},
+			"cursor": "cursor-2",
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	result, err := client.QueryExperimentEvents(context.Background(), "experiment-123", "scores.accuracy < 0.5", 50, "cursor-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Cursor != "cursor-2" {
+		t.Errorf("expected cursor cursor-2, got %q", result.Cursor)
+	}
+	if len(result.Events) != 1 || result.Events[0].XactID != "1000192656880881099" || *result.Events[0].Scores["accuracy"] != 0.25 {
+		t.Fatalf("unexpected events: %#v", result.Events)
+	}
+}
+
+func TestExperimentEvents_EmptyID(t *testing.T) {
+	client := NewClient("sk-test", "https://api.example.com", "org-test")
+
+	if _, err := client.InsertExperimentEvents(context.Background(), " ", &InsertExperimentEventsRequest{}); !errors.Is(err, ErrEmptyExperimentID) {
+		t.Errorf("expected ErrEmptyExperimentID from insert, got %v", err)
+	}
+	if _, err := client.FetchExperimentEvents(context.Background(), " ", nil); !errors.Is(err, ErrEmptyExperimentID) {
+		t.Errorf("expected ErrEmptyExperimentID from fetch, got %v", err)
+	}
+	if _, err := client.QueryExperimentEvents(context.Background(), " ", "", 0, ""); !errors.Is(err, ErrEmptyExperimentID) {
+		t.Errorf("expected ErrEmptyExperimentID from query, got %v", err)
+	}
+}
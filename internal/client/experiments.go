@@ -2,9 +2,11 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/url"
+	"strings"
 )
 
 // ErrEmptyExperimentID is returned when an experiment ID is empty
@@ -17,18 +19,21 @@ func experimentPath(id string) string {
 
 // Experiment represents a Braintrust experiment
 type Experiment struct {
-	Metadata    map[string]interface{} `json:"metadata,omitempty"`
-	RepoInfo    *RepoInfo              `json:"repo_info,omitempty"`
-	ID          string                 `json:"id"`
-	ProjectID   string                 `json:"project_id"`
-	Name        string                 `json:"name"`
-	Description string                 `json:"description,omitempty"`
-	Created     string                 `json:"created,omitempty"`
-	DeletedAt   string                 `json:"deleted_at,omitempty"`
-	UserID      string                 `json:"user_id,omitempty"`
-	OrgID       string                 `json:"org_id,omitempty"`
-	Tags        []string               `json:"tags,omitempty"`
-	Public      bool                   `json:"public"`
+	Metadata       map[string]interface{} `json:"metadata,omitempty"`
+	RepoInfo       *RepoInfo              `json:"repo_info,omitempty"`
+	ID             string                 `json:"id"`
+	ProjectID      string                 `json:"project_id"`
+	Name           string                 `json:"name"`
+	Description    string                 `json:"description,omitempty"`
+	Created        string                 `json:"created,omitempty"`
+	DeletedAt      string                 `json:"deleted_at,omitempty"`
+	UserID         string                 `json:"user_id,omitempty"`
+	OrgID          string                 `json:"org_id,omitempty"`
+	DatasetID      string                 `json:"dataset_id,omitempty"`
+	DatasetVersion string                 `json:"dataset_version,omitempty"`
+	BaseExpID      string                 `json:"base_exp_id,omitempty"`
+	Tags           []string               `json:"tags,omitempty"`
+	Public         bool                   `json:"public"`
 }
 
 // RepoInfo represents Git repository metadata attached to an experiment.
@@ -44,25 +49,68 @@ type RepoInfo struct {
 	GitDiff       *string `json:"git_diff,omitempty"`
 }
 
-// CreateExperimentRequest represents a request to create an experiment
+// CreateExperimentRequest represents a request to create an experiment.
+// EnsureNew creates a uniquely named experiment instead of returning an
+// existing experiment with the same name.
 type CreateExperimentRequest struct {
-	Metadata    map[string]interface{} `json:"metadata,omitempty"`
-	RepoInfo    *RepoInfo              `json:"repo_info,omitempty"`
-	Public      *bool                  `json:"public,omitempty"`
-	ProjectID   string                 `json:"project_id"`
-	Name        string                 `json:"name"`
-	Description string                 `json:"description,omitempty"`
-	Tags        []string               `json:"tags,omitempty"`
+	Metadata       map[string]interface{} `json:"metadata,omitempty"`
+	RepoInfo       *RepoInfo              `json:"repo_info,omitempty"`
+	Public         *bool                  `json:"public,omitempty"`
+	ProjectID      string                 `json:"project_id"`
+	Name           string                 `json:"name"`
+	Description    string                 `json:"description,omitempty"`
+	DatasetID      string                 `json:"dataset_id,omitempty"`
+	DatasetVersion string                 `json:"dataset_version,omitempty"`
+	BaseExpID      string                 `json:"base_exp_id,omitempty"`
+	Tags           []string               `json:"tags,omitempty"`
+	EnsureNew      bool                   `json:"ensure_new,omitempty"`
 }
 
-// UpdateExperimentRequest represents a request to update an experiment
+// UpdateExperimentRequest represents a request to update an experiment.
+// DatasetID, DatasetVersion and BaseExpID are omitted when nil and sent as
+// JSON null when they point to an empty string, which clears them.
 type UpdateExperimentRequest struct {
-	Name        string                 `json:"name,omitempty"`
-	Description string                 `json:"description,omitempty"`
-	RepoInfo    *RepoInfo              `json:"repo_info,omitempty"`
-	Public      *bool                  `json:"public,omitempty"`
-	Metadata    map[string]interface{} `json:"metadata,omitempty"`
-	Tags        []string               `json:"tags,omitempty"`
+	RepoInfo       *RepoInfo              `json:"repo_info,omitempty"`
+	Public         *bool                  `json:"public,omitempty"`
+	Metadata       map[string]interface{} `json:"metadata,omitempty"`
+	DatasetID      *string                `json:"-"`
+	DatasetVersion *string                `json:"-"`
+	BaseExpID      *string                `json:"-"`
+	Description    *string                `json:"-"`
+	Name           string                 `json:"name,omitempty"`
+	Tags           []string               `json:"tags,omitempty"`
+}
+
+// MarshalJSON preserves the distinction between omitted and explicit null JSON fields.
+func (r UpdateExperimentRequest) MarshalJSON() ([]byte, error) {
+	type updateExperimentRequest UpdateExperimentRequest
+
+	body, err := json.Marshal(updateExperimentRequest(r))
+	if err != nil {
+		return nil, err
+	}
+
+	payload := map[string]interface{}{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+
+	for key, value := range map[string]*string{
+		"dataset_id":      r.DatasetID,
+		"dataset_version": r.DatasetVersion,
+		"base_exp_id":     r.BaseExpID,
+		"description":     r.Description,
+	} {
+		switch {
+		case value == nil:
+		case *value == "":
+			payload[key] = nil
+		default:
+			payload[key] = *value
+		}
+	}
+
+	return json.Marshal(payload)
 }
 
 // ListExperimentsOptions represents options for listing experiments
@@ -78,6 +126,39 @@ type ListExperimentsResponse struct {
 	Experiments []Experiment `json:"objects"`
 }
 
+// ExperimentSummary represents the summarized results of an experiment,
+// optionally compared against another experiment.
+type ExperimentSummary struct {
+	Scores                   map[string]ExperimentScoreSummary  `json:"scores,omitempty"`
+	Metrics                  map[string]ExperimentMetricSummary `json:"metrics,omitempty"`
+	ProjectName              string                             `json:"project_name"`
+	ExperimentName           string                             `json:"experiment_name"`
+	ProjectURL               string                             `json:"project_url"`
+	ExperimentURL            string                             `json:"experiment_url"`
+	ComparisonExperimentName string                             `json:"comparison_experiment_name,omitempty"`
+}
+
+// ExperimentScoreSummary summarizes a score across an experiment. Diff and the
+// improvement/regression counts are only set when there is a comparison.
+type ExperimentScoreSummary struct {
+	Diff         *float64 `json:"diff,omitempty"`
+	Improvements *int64   `json:"improvements,omitempty"`
+	Regressions  *int64   `json:"regressions,omitempty"`
+	Name         string   `json:"name"`
+	Score        float64  `json:"score"`
+}
+
+// ExperimentMetricSummary summarizes a metric such as latency, tokens or cost
+// across an experiment.
+type ExperimentMetricSummary struct {
+	Diff         *float64 `json:"diff,omitempty"`
+	Improvements *int64   `json:"improvements,omitempty"`
+	Regressions  *int64   `json:"regressions,omitempty"`
+	Name         string   `json:"name"`
+	Unit         string   `json:"unit"`
+	Metric       float64  `json:"metric"`
+}
+
 // CreateExperiment creates a new experiment
 func (c *Client) CreateExperiment(ctx context.Context, req *CreateExperimentRequest) (*Experiment, error) {
 	var experiment Experiment
@@ -151,3 +232,26 @@ func (c *Client) ListExperiments(ctx context.Context, opts *ListExperimentsOptio
 	}
 	return &result, nil
 }
+
+// SummarizeExperiment summarizes the scores and metrics of an experiment. When
+// comparisonExperimentID is empty, the API compares against the experiment's
+// base experiment, or the most recent earlier experiment in the project.
+func (c *Client) SummarizeExperiment(ctx context.Context, id, comparisonExperimentID string) (*ExperimentSummary, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return nil, ErrEmptyExperimentID
+	}
+
+	params := url.Values{}
+	params.Set("summarize_scores", "true")
+	if comparisonExperimentID = strings.TrimSpace(comparisonExperimentID); comparisonExperimentID != "" {
+		params.Set("comparison_experiment_id", comparisonExperimentID)
+	}
+
+	var summary ExperimentSummary
+	err := c.Do(ctx, "GET", experimentPath(id)+"/summarize?"+params.Encode(), nil, &summary)
+	if err != nil {
+		return nil, err
+	}
+	return &summary, nil
+}
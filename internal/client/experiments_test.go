@@ -4,8 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"testing"
 	"time"
 )
@@ -203,8 +205,19 @@ func TestUpdateExperiment(t *testing.T) {
 			t.Errorf("expected path /v1/experiment/experiment-123, got %s", r.URL.Path)
 		}
 
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("failed to read request: %v", err)
+		}
 		var req UpdateExperimentRequest
-		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		if err := json.Unmarshal(body, &req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		// Description is written by MarshalJSON, so decode it separately.
+		var fields struct {
+			Description string `json:"description"`
+		}
+		if err := json.Unmarshal(body, &fields); err != nil {
 			t.Fatalf("failed to decode request: %v", err)
 		}
 
@@ -221,7 +234,7 @@ func TestUpdateExperiment(t *testing.T) {
 			ID:          "experiment-123",
 			ProjectID:   "project-123",
 			Name:        req.Name,
-			Description: req.Description,
+			Description: fields.Description,
 			Public:      public,
 			Metadata:    metadata,
 			Tags:        req.Tags,
@@ -252,7 +265,7 @@ func TestUpdateExperiment(t *testing.T) {
 	}
 	experiment, err := client.UpdateExperiment(context.Background(), "experiment-123", &UpdateExperimentRequest{
 		Name:        "Updated Experiment",
-		Description: "Updated description",
+		Description: stringPtr("Updated description"),
 		Public:      &publicFalse,
 		Metadata:    metadata,
 		Tags:        []string{"updated"},
@@ -295,6 +308,86 @@ func derefString(v *string) string {
 	return *v
 }
 
+// TestCreateExperiment_LineageFields verifies dataset and baseline fields are sent and decoded
+func TestCreateExperiment_LineageFields(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+
+		want := map[string]any{
+			"project_id":      "project-123",
+			"name":            "Lineage Experiment",
+			"dataset_id":      "dataset-123",
+			"dataset_version": "1000192656880881099",
+			"base_exp_id":     "experiment-base",
+			"ensure_new":      true,
+		}
+		if !reflect.DeepEqual(payload, want) {
+			t.Errorf("unexpected payload: %#v", payload)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"id":              "experiment-123",
+			"project_id":      "project-123",
+			"name":            "Lineage Experiment",
+			"dataset_id":      "dataset-123",
+			"dataset_version": "1000192656880881099",
+			"base_exp_id":     "experiment-base",
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	experiment, err := client.CreateExperiment(context.Background(), &CreateExperimentRequest{
+		ProjectID:      "project-123",
+		Name:           "Lineage Experiment",
+		DatasetID:      "dataset-123",
+		DatasetVersion: "1000192656880881099",
+		BaseExpID:      "experiment-base",
+		EnsureNew:      true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if experiment.DatasetID != "dataset-123" || experiment.DatasetVersion != "1000192656880881099" || experiment.BaseExpID != "experiment-base" {
+		t.Errorf("unexpected lineage fields: %#v", experiment)
+	}
+}
+
+// TestUpdateExperimentRequest_MarshalJSON verifies omitted, set and cleared nullable fields
+func TestUpdateExperimentRequest_MarshalJSON(t *testing.T) {
+	body, err := json.Marshal(UpdateExperimentRequest{
+		Name:           "Updated Experiment",
+		DatasetID:      stringPtr("dataset-123"),
+		DatasetVersion: stringPtr(""),
+		Description:    stringPtr(""),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var payload map[string]any
+	if err := json.Unmarshal(body, &payload); err != nil {
+		t.Fatalf("failed to decode payload: %v", err)
+	}
+
+	want := map[string]any{
+		"name":            "Updated Experiment",
+		"dataset_id":      "dataset-123",
+		"dataset_version": nil,
+		"description":     nil,
+	}
+	if !reflect.DeepEqual(payload, want) {
+		t.Errorf("unexpected payload: %#v", payload)
+	}
+}
+
 // TestDeleteExperiment verifies experiment deletion
 func TestDeleteExperiment(t *testing.T) {
 	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -514,3 +607,71 @@ func TestListExperiments_WithOptions(t *testing.T) {
 		})
 	}
 }
+
+// TestSummarizeExperiment verifies the summary request and decoding
+func TestSummarizeExperiment(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("expected GET method, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/experiment/experiment-123/summarize" {
+			t.Errorf("expected path /v1/experiment/experiment-123/summarize, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("summarize_scores"); got != "true" {
+			t.Errorf("expected summarize_scores=true, got %q", got)
+		}
+		if got := r.URL.Query().Get("comparison_experiment_id"); got != "experiment-base" {
+			t.Errorf("expected comparison_experiment_id experiment-base, got %q", got)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"project_name":               "project",
+			"experiment_name":            "candidate",
+			"project_url":                "https://www.braintrust.dev/app/org/p/project",
+			"experiment_url":             "https://www.braintrust.dev/app/org/p/project/experiments/candidate",
+			"comparison_experiment_name": "baseline",
+			"scores": map[string]any{
+				"accuracy": map[string]any{"name": "accuracy", "score": 0.9, "diff": 0.05, "improvements": 3, "regressions": 1},
+			},
+			"metrics": map[string]any{
+				"duration": map[string]any{"name": "duration", "metric": 1.25, "unit": "s"},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	summary, err := client.SummarizeExperiment(context.Background(), " experiment-123 ", "experiment-base")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	accuracy, ok := summary.Scores["accuracy"]
+	if !ok {
+		t.Fatalf("expected accuracy score, got %#v", summary.Scores)
+	}
+	if accuracy.Score != 0.9 || accuracy.Diff == nil || *accuracy.Diff != 0.05 || accuracy.Regressions == nil || *accuracy.Regressions != 1 {
+		t.Errorf("unexpected accuracy summary: %#v", accuracy)
+	}
+
+	duration := summary.Metrics["duration"]
+	if duration.Metric != 1.25 || duration.Unit != "s" || duration.Diff != nil {
+		t.Errorf("unexpected duration summary: %#v", duration)
+	}
+	if summary.ComparisonExperimentName != "baseline" {
+		t.Errorf("expected comparison experiment baseline, got %q", summary.ComparisonExperimentName)
+	}
+}
+
+// TestSummarizeExperiment_EmptyID verifies empty ID validation
+func TestSummarizeExperiment_EmptyID(t *testing.T) {
+	client := NewClient("sk-test", "https://api.example.com", "org-test")
+
+	_, err := client.SummarizeExperiment(context.Background(), "  ", "")
+	if !errors.Is(err, ErrEmptyExperimentID) {
+		t.Errorf("expected error '%v', got '%v'", ErrEmptyExperimentID, err)
+	}
+}
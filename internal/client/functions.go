@@ -1,7 +1,9 @@
 package client
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/url"
@@ -30,17 +32,20 @@ type Function struct {
 	OrgID          string                 `json:"org_id,omitempty"`
 	ProjectID      string                 `json:"project_id,omitempty"`
 	Slug           string                 `json:"slug,omitempty"`
+	UserID         string                 `json:"user_id,omitempty"`
 	Tags           []string               `json:"tags,omitempty"`
 }
 
 // ListFunctionsOptions represents options for listing functions.
 type ListFunctionsOptions struct {
+	Limit         *int
 	ProjectID     string
 	FunctionName  string
 	Slug          string
-	Limit         *int
+	Version       string
 	StartingAfter string
 	EndingBefore  string
+	IDs           []string
 }
 
 // ListFunctionsResponse represents a list of functions.
@@ -77,6 +82,16 @@ type UpdateFunctionRequest struct {
 	Tags           *[]string               `json:"tags,omitempty"`
 }
 
+// InvokeFunctionRequest represents a request to invoke a function.
+type InvokeFunctionRequest struct {
+	Input interface{} `json:"input"`
+	// Version pins the function version (a transaction ID). Empty invokes the
+	// latest version.
+	Version string `json:"version,omitempty"`
+	// Mode selects how the result is returned: auto, parallel, json or text.
+	Mode string `json:"mode,omitempty"`
+}
+
 func functionPath(id string) string {
 	return "/v1/function/" + url.PathEscape(id)
 }
@@ -149,6 +164,14 @@ func (c *Client) ListFunctions(ctx context.Context, opts *ListFunctionsOptions)
 		if opts.Slug != "" {
 			params.Set("slug", opts.Slug)
 		}
+		if opts.Version != "" {
+			params.Set("version", opts.Version)
+		}
+		for _, id := range opts.IDs {
+			if id != "" {
+				params.Add("ids", id)
+			}
+		}
 		if opts.Limit != nil {
 			params.Set("limit", fmt.Sprintf("%d", *opts.Limit))
 		}
@@ -173,6 +196,35 @@ func (c *Client) ListFunctions(ctx context.Context, opts *ListFunctionsOptions)
 	return &result, nil
 }
 
+// InvokeFunction invokes a function and returns its output as raw JSON.
+// Output that is not JSON, such as the result of text mode, is returned as a
+// JSON string, and an empty response as null.
+func (c *Client) InvokeFunction(ctx context.Context, id string, req *InvokeFunctionRequest) (json.RawMessage, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return nil, ErrEmptyFunctionID
+	}
+
+	body, err := c.doRaw(ctx, "POST", functionPath(id)+"/invoke", req)
+	if err != nil {
+		return nil, err
+	}
+
+	return invokeFunctionOutput(body)
+}
+
+func invokeFunctionOutput(body []byte) (json.RawMessage, error) {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 {
+		return json.RawMessage("null"), nil
+	}
+	if json.Valid(trimmed) {
+		return json.RawMessage(trimmed), nil
+	}
+
+	return json.Marshal(string(body))
+}
+
 // IsFunctionNotFound returns true when the API reports function access/not-found semantics.
 func IsFunctionNotFound(err error) bool {
 	apiErr := &APIError{}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"testing"
 )
 
@@ -331,6 +332,114 @@ func TestDeleteFunction_EmptyID(t *testing.T) {
 	}
 }
 
+func TestInvokeFunction(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Fatalf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/function/func-123/invoke" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+
+		var payload map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		want := map[string]any{
+			"input":   map[string]any{"question": "2+2?"},
+			"version": "1000192656880881099",
+			"mode":    "json",
+		}
+		if !reflect.DeepEqual(payload, want) {
+			t.Fatalf("unexpected payload: %#v", payload)
+		}
+
+		_, _ = w.Write([]byte(`{"answer":4}`))
+	}))
+	defer server.Close()
+
+	c := NewClient("test-key", server.URL, "org-test")
+	c.httpClient = server.Client()
+
+	output, err := c.InvokeFunction(context.Background(), " func-123 ", &InvokeFunctionRequest{
+		Input:   map[string]any{"question": "2+2?"},
+		Version: "1000192656880881099",
+		Mode:    "json",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(output) != `{"answer":4}` {
+		t.Fatalf("unexpected output: %s", output)
+	}
+}
+
+func TestInvokeFunction_OmitsEmptyOptions(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if !reflect.DeepEqual(payload, map[string]any{"input": "hello"}) {
+			t.Fatalf("unexpected payload: %#v", payload)
+		}
+
+		_, _ = w.Write([]byte(`"HELLO"`))
+	}))
+	defer server.Close()
+
+	c := NewClient("test-key", server.URL, "org-test")
+	c.httpClient = server.Client()
+
+	output, err := c.InvokeFunction(context.Background(), "func-123", &InvokeFunctionRequest{Input: "hello"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(output) != `"HELLO"` {
+		t.Fatalf("unexpected output: %s", output)
+	}
+}
+
+func TestInvokeFunction_NonJSONOutput(t *testing.T) {
+	testCases := map[string]struct {
+		body string
+		want string
+	}{
+		"text":  {body: "The answer is 4", want: `"The answer is 4"`},
+		"empty": {body: "", want: "null"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "text/plain")
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer server.Close()
+
+			c := NewClient("test-key", server.URL, "org-test")
+			c.httpClient = server.Client()
+
+			output, err := c.InvokeFunction(context.Background(), "func-123", &InvokeFunctionRequest{Input: "x", Mode: "text"})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(output) != tc.want {
+				t.Fatalf("expected output %s, got %s", tc.want, output)
+			}
+		})
+	}
+}
+
+func TestInvokeFunction_EmptyID(t *testing.T) {
+	c := NewClient("test-key", "https://api.example.com", "org-test")
+
+	_, err := c.InvokeFunction(context.Background(), " ", &InvokeFunctionRequest{Input: "x"})
+	if !errors.Is(err, ErrEmptyFunctionID) {
+		t.Fatalf("expected ErrEmptyFunctionID, got %v", err)
+	}
+}
+
 func TestIsFunctionNotFound(t *testing.T) {
 	testCases := map[string]struct {
 		err  error
@@ -31,12 +31,18 @@ type CreateGroupRequest struct {
 	MemberGroups []string `json:"member_groups,omitempty"`
 }
 
-// UpdateGroupRequest represents a request to update a group
+// UpdateGroupRequest represents a request to update a group.
+// MemberUsers and MemberGroups replace the full membership, while the
+// Add*/Remove* fields apply incremental membership changes.
 type UpdateGroupRequest struct {
-	Name         string   `json:"name,omitempty"`
-	Description  string   `json:"description,omitempty"`
-	MemberUsers  []string `json:"member_users,omitempty"`
-	MemberGroups []string `json:"member_groups,omitempty"`
+	Name               string   `json:"name,omitempty"`
+	Description        string   `json:"description,omitempty"`
+	MemberUsers        []string `json:"member_users,omitempty"`
+	MemberGroups       []string `json:"member_groups,omitempty"`
+	AddMemberUsers     []string `json:"add_member_users,omitempty"`
+	RemoveMemberUsers  []string `json:"remove_member_users,omitempty"`
+	AddMemberGroups    []string `json:"add_member_groups,omitempty"`
+	RemoveMemberGroups []string `json:"remove_member_groups,omitempty"`
 }
 
 // ListGroupsOptions represents options for listing groups
@@ -4,8 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"testing"
 	"time"
 )
@@ -192,6 +194,58 @@ func TestUpdateGroup(t *testing.T) {
 	}
 }
 
+// TestUpdateGroup_MembershipDeltaPayloadShape verifies incremental membership fields
+func TestUpdateGroup_MembershipDeltaPayloadShape(t *testing.T) {
+	t.Parallel()
+
+	req := &UpdateGroupRequest{
+		AddMemberUsers:     []string{"user-a"},
+		RemoveMemberUsers:  []string{"user-b"},
+		AddMemberGroups:    []string{"group-a"},
+		RemoveMemberGroups: []string{"group-b"},
+	}
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("failed reading request body: %v", err)
+		}
+		var payload map[string]any
+		if err := json.Unmarshal(body, &payload); err != nil {
+			t.Fatalf("failed unmarshalling request body: %v", err)
+		}
+
+		want := map[string]any{
+			"add_member_users":     []any{"user-a"},
+			"remove_member_users":  []any{"user-b"},
+			"add_member_groups":    []any{"group-a"},
+			"remove_member_groups": []any{"group-b"},
+		}
+		for key, expected := range want {
+			if !reflect.DeepEqual(payload[key], expected) {
+				t.Fatalf("%s payload mismatch: got=%#v want=%#v", key, payload[key], expected)
+			}
+		}
+		for _, key := range []string{"member_users", "member_groups", "name"} {
+			if _, ok := payload[key]; ok {
+				t.Fatalf("expected %s to be omitted from delta payload", key)
+			}
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(Group{ID: "group-123", Name: "Test Group"})
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	_, err := client.UpdateGroup(context.Background(), "group-123", req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
 // TestDeleteGroup verifies group deletion
 func TestDeleteGroup(t *testing.T) {
 	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
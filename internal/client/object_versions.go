@@ -0,0 +1,143 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrVersionNotFound is returned when an object did not exist at the
+// requested version.
+var ErrVersionNotFound = errors.New("object not found at version")
+
+// walkVersions calls fetch with the transaction ID just before the previous
+// version, starting from latest, until fetch reports no earlier version or
+// limit versions have been seen. Each fetch returns the transaction ID of the
+// version it found, which is never newer than the one it was asked for.
+func walkVersions(latest string, limit int, fetch func(version string) (string, error)) error {
+	if latest == "" {
+		return nil
+	}
+
+	current, err := parseXactID(latest)
+	if err != nil {
+		return err
+	}
+
+	for seen := 1; seen < limit && current > 0; seen++ {
+		found, err := fetch(strconv.FormatUint(current-1, 10))
+		if errors.Is(err, ErrVersionNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		previous, err := parseXactID(found)
+		if err != nil {
+			return err
+		}
+		if previous >= current {
+			return fmt.Errorf("version %s is not older than %d", found, current)
+		}
+		current = previous
+	}
+
+	return nil
+}
+
+// GetPromptVersion retrieves a prompt as it was at the given version.
+func (c *Client) GetPromptVersion(ctx context.Context, id, version string) (*Prompt, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return nil, ErrEmptyPromptID
+	}
+
+	result, err := c.ListPrompts(ctx, &ListPromptsOptions{
+		IDs:     []string{id},
+		Version: strings.TrimSpace(version),
+		Limit:   1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Prompts) == 0 {
+		return nil, ErrVersionNotFound
+	}
+
+	return &result.Prompts[0], nil
+}
+
+// ListPromptVersions returns up to limit versions of a prompt, newest first.
+func (c *Client) ListPromptVersions(ctx context.Context, id string, limit int) ([]Prompt, error) {
+	prompt, err := c.GetPrompt(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := []Prompt{*prompt}
+	err = walkVersions(prompt.XactID, limit, func(version string) (string, error) {
+		previous, err := c.GetPromptVersion(ctx, id, version)
+		if err != nil {
+			return "", err
+		}
+
+		versions = append(versions, *previous)
+		return previous.XactID, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return versions, nil
+}
+
+// GetFunctionVersion retrieves a function as it was at the given version.
+func (c *Client) GetFunctionVersion(ctx context.Context, id, version string) (*Function, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return nil, ErrEmptyFunctionID
+	}
+
+	limit := 1
+	result, err := c.ListFunctions(ctx, &ListFunctionsOptions{
+		IDs:     []string{id},
+		Version: strings.TrimSpace(version),
+		Limit:   &limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Functions) == 0 {
+		return nil, ErrVersionNotFound
+	}
+
+	return &result.Functions[0], nil
+}
+
+// ListFunctionVersions returns up to limit versions of a function, newest
+// first.
+func (c *Client) ListFunctionVersions(ctx context.Context, id string, limit int) ([]Function, error) {
+	function, err := c.GetFunction(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := []Function{*function}
+	err = walkVersions(function.XactID, limit, func(version string) (string, error) {
+		previous, err := c.GetFunctionVersion(ctx, id, version)
+		if err != nil {
+			return "", err
+		}
+
+		versions = append(versions, *previous)
+		return previous.XactID, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return versions, nil
+}
@@ -0,0 +1,178 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestListPromptVersions(t *testing.T) {
+	versions := map[string]Prompt{
+		"1000192656880881098": {ID: "prompt-1", Name: "v2", XactID: "1000192656880750000"},
+		"1000192656880749999": {ID: "prompt-1", Name: "v1", XactID: "1000192656880000000"},
+	}
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("expected GET method, got %s", r.Method)
+		}
+
+		if r.URL.Path == "/v1/prompt/prompt-1" {
+			_ = json.NewEncoder(w).Encode(Prompt{ID: "prompt-1", Name: "v3", XactID: "1000192656880881099"})
+			return
+		}
+		if r.URL.Path != "/v1/prompt" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		query := r.URL.Query()
+		if got := query["ids"]; !reflect.DeepEqual(got, []string{"prompt-1"}) {
+			t.Errorf("expected ids [prompt-1], got %v", got)
+		}
+		if got := query.Get("limit"); got != "1" {
+			t.Errorf("expected limit 1, got %q", got)
+		}
+
+		resp := ListPromptsResponse{Prompts: []Prompt{}}
+		if prompt, ok := versions[query.Get("version")]; ok {
+			resp.Prompts = append(resp.Prompts, prompt)
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	result, err := client.ListPromptVersions(context.Background(), "prompt-1", 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var names []string
+	for _, prompt := range result {
+		names = append(names, prompt.Name)
+	}
+	if !reflect.DeepEqual(names, []string{"v3", "v2", "v1"}) {
+		t.Errorf("expected versions [v3 v2 v1], got %v", names)
+	}
+
+	result, err = client.ListPromptVersions(context.Background(), "prompt-1", 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result) != 2 {
+		t.Errorf("expected 2 versions with limit 2, got %d", len(result))
+	}
+}
+
+func TestListPromptVersions_RejectsNewerVersion(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		prompt := Prompt{ID: "prompt-1", XactID: "1000192656880881099"}
+		if r.URL.Path == "/v1/prompt/prompt-1" {
+			_ = json.NewEncoder(w).Encode(prompt)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(ListPromptsResponse{Prompts: []Prompt{prompt}})
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	if _, err := client.ListPromptVersions(context.Background(), "prompt-1", 10); err == nil {
+		t.Fatal("expected error when the API ignores the version filter")
+	}
+}
+
+func TestWalkVersions_UnrecognizedLayout(t *testing.T) {
+	// Versions are stepped back numerically, so IDs outside the known
+	// transaction ID layout are walked too.
+	previous := map[string]string{"41": "17", "16": "3"}
+
+	var asked []string
+	err := walkVersions("42", 10, func(version string) (string, error) {
+		asked = append(asked, version)
+		found, ok := previous[version]
+		if !ok {
+			return "", ErrVersionNotFound
+		}
+		return found, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(asked, []string{"41", "16", "2"}) {
+		t.Errorf("expected versions [41 16 2] to be fetched, got %v", asked)
+	}
+}
+
+func TestGetPromptVersion_NotFound(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("version"); got != "1000192656880881099" {
+			t.Errorf("expected version 1000192656880881099, got %q", got)
+		}
+		_ = json.NewEncoder(w).Encode(ListPromptsResponse{Prompts: []Prompt{}})
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	_, err := client.GetPromptVersion(context.Background(), "prompt-1", "1000192656880881099")
+	if !errors.Is(err, ErrVersionNotFound) {
+		t.Fatalf("expected ErrVersionNotFound, got %v", err)
+	}
+}
+
+func TestGetPromptVersion_EmptyID(t *testing.T) {
+	client := NewClient("sk-test", "https://api.example.com", "org-test")
+
+	if _, err := client.GetPromptVersion(context.Background(), " ", "1000192656880881099"); !errors.Is(err, ErrEmptyPromptID) {
+		t.Fatalf("expected ErrEmptyPromptID, got %v", err)
+	}
+}
+
+func TestListFunctionVersions(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/function/func-1" {
+			_ = json.NewEncoder(w).Encode(Function{ID: "func-1", Name: "v2", XactID: "1000192656880881099", UserID: "user-2"})
+			return
+		}
+		if r.URL.Path != "/v1/function" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		query := r.URL.Query()
+		if got := query["ids"]; !reflect.DeepEqual(got, []string{"func-1"}) {
+			t.Errorf("expected ids [func-1], got %v", got)
+		}
+
+		resp := ListFunctionsResponse{Functions: []Function{}}
+		if query.Get("version") == "1000192656880881098" {
+			resp.Functions = append(resp.Functions, Function{ID: "func-1", Name: "v1", XactID: "1000192656880000000", UserID: "user-1"})
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	result, err := client.ListFunctionVersions(context.Background(), "func-1", 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result) != 2 {
+		t.Fatalf("expected 2 versions, got %d", len(result))
+	}
+	if result[0].UserID != "user-2" || result[1].UserID != "user-1" {
+		t.Errorf("expected authors user-2, user-1, got %s, %s", result[0].UserID, result[1].UserID)
+	}
+}
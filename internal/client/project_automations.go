@@ -0,0 +1,194 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ErrEmptyProjectAutomationID is returned when a project automation ID is empty.
+var ErrEmptyProjectAutomationID = errors.New("project automation ID cannot be empty")
+
+// ProjectAutomationAction represents the action fired when an automation triggers.
+type ProjectAutomationAction struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
+
+// ProjectAutomationExportDefinition selects the data an export automation writes.
+type ProjectAutomationExportDefinition struct {
+	Type      string `json:"type"`
+	BTQLQuery string `json:"btql_query,omitempty"`
+}
+
+// ProjectAutomationCredentials represents the credentials an export
+// automation uses to write to its destination.
+type ProjectAutomationCredentials struct {
+	Type       string `json:"type"`
+	RoleARN    string `json:"role_arn"`
+	ExternalID string `json:"external_id"`
+}
+
+// ProjectAutomationConfig represents the trigger and action of a project
+// automation. Log automations set BTQLFilter and Action; export automations
+// set ExportDefinition, ExportPath, Format and Credentials.
+type ProjectAutomationConfig struct {
+	Action           *ProjectAutomationAction           `json:"action,omitempty"`
+	ExportDefinition *ProjectAutomationExportDefinition `json:"export_definition,omitempty"`
+	Credentials      *ProjectAutomationCredentials      `json:"credentials,omitempty"`
+	EventType        string                             `json:"event_type"`
+	BTQLFilter       string                             `json:"btql_filter,omitempty"`
+	ExportPath       string                             `json:"export_path,omitempty"`
+	Format           string                             `json:"format,omitempty"`
+	IntervalSeconds  int64                              `json:"interval_seconds"`
+	BatchSize        int64                              `json:"batch_size,omitempty"`
+}
+
+// ProjectAutomation represents a Braintrust project automation.
+type ProjectAutomation struct {
+	Config      *ProjectAutomationConfig `json:"config,omitempty"`
+	ID          string                   `json:"id"`
+	ProjectID   string                   `json:"project_id"`
+	Name        string                   `json:"name"`
+	Description string                   `json:"description,omitempty"`
+	UserID      string                   `json:"user_id,omitempty"`
+	Created     string                   `json:"created,omitempty"`
+}
+
+// ListProjectAutomationsOptions represents options for listing project automations.
+type ListProjectAutomationsOptions struct {
+	StartingAfter  string
+	EndingBefore   string
+	OrgName        string
+	ProjectID      string
+	ProjectName    string
+	AutomationName string
+	IDs            []string
+	Limit          int
+}
+
+// ListProjectAutomationsResponse represents a list of project automations.
+type ListProjectAutomationsResponse struct {
+	ProjectAutomations []ProjectAutomation `json:"objects"`
+}
+
+// CreateProjectAutomationRequest represents a request to create a project automation.
+type CreateProjectAutomationRequest struct {
+	Config      *ProjectAutomationConfig `json:"config"`
+	ProjectID   string                   `json:"project_id"`
+	Name        string                   `json:"name"`
+	Description string                   `json:"description,omitempty"`
+}
+
+// UpdateProjectAutomationRequest represents a request to update a project automation.
+type UpdateProjectAutomationRequest struct {
+	Name        *string                  `json:"name,omitempty"`
+	Description *string                  `json:"description,omitempty"`
+	Config      *ProjectAutomationConfig `json:"config,omitempty"`
+}
+
+func projectAutomationPath(id string) string {
+	return "/v1/project_automation/" + url.PathEscape(id)
+}
+
+// GetProjectAutomation retrieves a project automation by ID.
+func (c *Client) GetProjectAutomation(ctx context.Context, id string) (*ProjectAutomation, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return nil, ErrEmptyProjectAutomationID
+	}
+
+	var automation ProjectAutomation
+	err := c.Do(ctx, "GET", projectAutomationPath(id), nil, &automation)
+	if err != nil {
+		return nil, err
+	}
+
+	return &automation, nil
+}
+
+// CreateProjectAutomation creates a new project automation.
+func (c *Client) CreateProjectAutomation(ctx context.Context, req *CreateProjectAutomationRequest) (*ProjectAutomation, error) {
+	var automation ProjectAutomation
+	err := c.Do(ctx, "POST", "/v1/project_automation", req, &automation)
+	if err != nil {
+		return nil, err
+	}
+
+	return &automation, nil
+}
+
+// UpdateProjectAutomation updates an existing project automation.
+func (c *Client) UpdateProjectAutomation(ctx context.Context, id string, req *UpdateProjectAutomationRequest) (*ProjectAutomation, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return nil, ErrEmptyProjectAutomationID
+	}
+
+	var automation ProjectAutomation
+	err := c.Do(ctx, "PATCH", projectAutomationPath(id), req, &automation)
+	if err != nil {
+		return nil, err
+	}
+
+	return &automation, nil
+}
+
+// DeleteProjectAutomation deletes a project automation by ID.
+func (c *Client) DeleteProjectAutomation(ctx context.Context, id string) error {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return ErrEmptyProjectAutomationID
+	}
+
+	return c.Do(ctx, "DELETE", projectAutomationPath(id), nil, nil)
+}
+
+// ListProjectAutomations lists project automations, optionally filtered by API-native query parameters.
+func (c *Client) ListProjectAutomations(ctx context.Context, opts *ListProjectAutomationsOptions) (*ListProjectAutomationsResponse, error) {
+	path := "/v1/project_automation"
+
+	if opts != nil {
+		params := url.Values{}
+		if opts.Limit > 0 {
+			params.Set("limit", fmt.Sprintf("%d", opts.Limit))
+		}
+		if opts.StartingAfter != "" {
+			params.Set("starting_after", opts.StartingAfter)
+		}
+		if opts.EndingBefore != "" {
+			params.Set("ending_before", opts.EndingBefore)
+		}
+		for _, id := range opts.IDs {
+			if id != "" {
+				params.Add("ids", id)
+			}
+		}
+		if opts.OrgName != "" {
+			params.Set("org_name", opts.OrgName)
+		}
+		if opts.ProjectID != "" {
+			params.Set("project_id", opts.ProjectID)
+		}
+		if opts.ProjectName != "" {
+			params.Set("project_name", opts.ProjectName)
+		}
+		if opts.AutomationName != "" {
+			params.Set("project_automation_name", opts.AutomationName)
+		}
+
+		if encodedParams := params.Encode(); encodedParams != "" {
+			path += "?" + encodedParams
+		}
+	}
+
+	var result ListProjectAutomationsResponse
+	err := c.Do(ctx, "GET", path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
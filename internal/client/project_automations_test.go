@@ -0,0 +1,342 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestGetProjectAutomation(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("expected GET method, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/project_automation/auto-123" {
+			t.Errorf("expected path /v1/project_automation/auto-123, got %s", r.URL.Path)
+		}
+
+		resp := ProjectAutomation{
+			ID:        "auto-123",
+			ProjectID: "proj-123",
+			Name:      "error-alert",
+			Config: &ProjectAutomationConfig{
+				EventType:       "logs",
+				BTQLFilter:      "error IS NOT NULL",
+				IntervalSeconds: 3600,
+				Action:          &ProjectAutomationAction{Type: "webhook", URL: "https://example.com/hook"},
+			},
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	automation, err := client.GetProjectAutomation(context.Background(), "auto-123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if automation.ID != "auto-123" {
+		t.Errorf("expected id auto-123, got %s", automation.ID)
+	}
+	if automation.Config == nil || automation.Config.Action == nil || automation.Config.Action.URL != "https://example.com/hook" {
+		t.Errorf("expected webhook action, got %#v", automation.Config)
+	}
+}
+
+func TestGetProjectAutomation_WhitespaceID(t *testing.T) {
+	requestCount := 0
+	server := httptest.NewTLSServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		requestCount++
+		t.Fatalf("expected no API call for whitespace-only ID")
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	_, err := client.GetProjectAutomation(context.Background(), " \t\r\n")
+	if !errors.Is(err, ErrEmptyProjectAutomationID) {
+		t.Fatalf("expected ErrEmptyProjectAutomationID, got %v", err)
+	}
+	if requestCount != 0 {
+		t.Fatalf("expected no API call for whitespace-only ID, got %d request(s)", requestCount)
+	}
+}
+
+func TestListProjectAutomations_WithOptions(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("expected GET method, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/project_automation" {
+			t.Errorf("expected path /v1/project_automation, got %s", r.URL.Path)
+		}
+
+		query := r.URL.Query()
+		if got := query.Get("limit"); got != "10" {
+			t.Errorf("expected limit 10, got %q", got)
+		}
+		if got := query.Get("starting_after"); got != "cursor-next" {
+			t.Errorf("expected starting_after cursor-next, got %q", got)
+		}
+		if got := query.Get("org_name"); got != "test-org" {
+			t.Errorf("expected org_name test-org, got %q", got)
+		}
+		if got := query.Get("project_id"); got != "proj-123" {
+			t.Errorf("expected project_id proj-123, got %q", got)
+		}
+		if got := query.Get("project_name"); got != "example-project" {
+			t.Errorf("expected project_name example-project, got %q", got)
+		}
+		if got := query.Get("project_automation_name"); got != "error-alert" {
+			t.Errorf("expected project_automation_name error-alert, got %q", got)
+		}
+		if got := query["ids"]; !reflect.DeepEqual(got, []string{"auto-1", "auto-2"}) {
+			t.Errorf("expected ids [auto-1 auto-2], got %v", got)
+		}
+
+		resp := ListProjectAutomationsResponse{
+			ProjectAutomations: []ProjectAutomation{{ID: "auto-1", Name: "error-alert", ProjectID: "proj-123"}},
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	result, err := client.ListProjectAutomations(context.Background(), &ListProjectAutomationsOptions{
+		Limit:          10,
+		StartingAfter:  "cursor-next",
+		IDs:            []string{"auto-1", "auto-2"},
+		OrgName:        "test-org",
+		ProjectID:      "proj-123",
+		ProjectName:    "example-project",
+		AutomationName: "error-alert",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.ProjectAutomations) != 1 || result.ProjectAutomations[0].ID != "auto-1" {
+		t.Fatalf("unexpected automations: %#v", result.ProjectAutomations)
+	}
+}
+
+func TestCreateProjectAutomation(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST method, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/project_automation" {
+			t.Errorf("expected path /v1/project_automation, got %s", r.URL.Path)
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("read request: %v", err)
+		}
+
+		var got map[string]any
+		if err := json.Unmarshal(body, &got); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+
+		want := map[string]any{
+			"project_id": "proj-123",
+			"name":       "error-alert",
+			"config": map[string]any{
+				"event_type":       "logs",
+				"btql_filter":      "error IS NOT NULL",
+				"interval_seconds": float64(3600),
+				"action": map[string]any{
+					"type": "webhook",
+					"url":  "https://example.com/hook",
+				},
+			},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("unexpected request payload:\n got: %#v\nwant: %#v", got, want)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(ProjectAutomation{ID: "auto-123", ProjectID: "proj-123", Name: "error-alert"})
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	automation, err := client.CreateProjectAutomation(context.Background(), &CreateProjectAutomationRequest{
+		ProjectID: "proj-123",
+		Name:      "error-alert",
+		Config: &ProjectAutomationConfig{
+			EventType:       "logs",
+			BTQLFilter:      "error IS NOT NULL",
+			IntervalSeconds: 3600,
+			Action:          &ProjectAutomationAction{Type: "webhook", URL: "https://example.com/hook"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if automation.ID != "auto-123" {
+		t.Fatalf("expected id auto-123, got %q", automation.ID)
+	}
+}
+
+func TestCreateProjectAutomation_Export(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var got map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+
+		want := map[string]any{
+			"project_id": "proj-123",
+			"name":       "nightly-export",
+			"config": map[string]any{
+				"event_type":        "btql_export",
+				"export_definition": map[string]any{"type": "log_traces"},
+				"export_path":       "s3://bucket/braintrust/",
+				"format":            "parquet",
+				"interval_seconds":  float64(86400),
+				"credentials": map[string]any{
+					"type":        "aws_iam",
+					"role_arn":    "arn:aws:iam::123456789012:role/braintrust-export",
+					"external_id": "bt-external-id",
+				},
+			},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("unexpected request payload:\n got: %#v\nwant: %#v", got, want)
+		}
+
+		_ = json.NewEncoder(w).Encode(ProjectAutomation{ID: "auto-123"})
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	_, err := client.CreateProjectAutomation(context.Background(), &CreateProjectAutomationRequest{
+		ProjectID: "proj-123",
+		Name:      "nightly-export",
+		Config: &ProjectAutomationConfig{
+			EventType:        "btql_export",
+			ExportDefinition: &ProjectAutomationExportDefinition{Type: "log_traces"},
+			ExportPath:       "s3://bucket/braintrust/",
+			Format:           "parquet",
+			IntervalSeconds:  86400,
+			Credentials: &ProjectAutomationCredentials{
+				Type:       "aws_iam",
+				RoleARN:    "arn:aws:iam::123456789012:role/braintrust-export",
+				ExternalID: "bt-external-id",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestUpdateProjectAutomation(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("expected PATCH method, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/project_automation/auto-123" {
+			t.Errorf("expected path /v1/project_automation/auto-123, got %s", r.URL.Path)
+		}
+
+		var req UpdateProjectAutomationRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+
+		if req.Name == nil || *req.Name != "error-alert-updated" {
+			t.Fatalf("expected name error-alert-updated, got %#v", req.Name)
+		}
+		if req.Description != nil {
+			t.Fatalf("expected description to be omitted, got %#v", req.Description)
+		}
+		if req.Config == nil || req.Config.IntervalSeconds != 600 {
+			t.Fatalf("expected interval_seconds 600, got %#v", req.Config)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(ProjectAutomation{ID: "auto-123", Name: *req.Name, Config: req.Config})
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	name := "error-alert-updated"
+	automation, err := client.UpdateProjectAutomation(context.Background(), "auto-123", &UpdateProjectAutomationRequest{
+		Name: &name,
+		Config: &ProjectAutomationConfig{
+			EventType:       "logs",
+			BTQLFilter:      "error IS NOT NULL",
+			IntervalSeconds: 600,
+			Action:          &ProjectAutomationAction{Type: "webhook", URL: "https://example.com/hook"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if automation.Name != "error-alert-updated" {
+		t.Fatalf("expected name error-alert-updated, got %q", automation.Name)
+	}
+}
+
+func TestDeleteProjectAutomation(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected DELETE method, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/project_automation/auto-123" {
+			t.Errorf("expected path /v1/project_automation/auto-123, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	if err := client.DeleteProjectAutomation(context.Background(), "auto-123"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestDeleteProjectAutomation_WhitespaceID(t *testing.T) {
+	requestCount := 0
+	server := httptest.NewTLSServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		requestCount++
+		t.Fatalf("expected no API call for whitespace-only ID")
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	err := client.DeleteProjectAutomation(context.Background(), " \t\r\n")
+	if !errors.Is(err, ErrEmptyProjectAutomationID) {
+		t.Fatalf("expected ErrEmptyProjectAutomationID, got %v", err)
+	}
+	if requestCount != 0 {
+		t.Fatalf("expected no API call for whitespace-only ID, got %d request(s)", requestCount)
+	}
+}
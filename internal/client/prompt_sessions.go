@@ -0,0 +1,169 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ErrEmptyPromptSessionID is returned when a prompt session ID is empty.
+var ErrEmptyPromptSessionID = errors.New("prompt session ID cannot be empty")
+
+// PromptSession represents a Braintrust prompt session, which backs a playground.
+type PromptSession struct {
+	PromptSessionData *PromptSessionData `json:"prompt_session_data,omitempty"`
+	ID                string             `json:"id"`
+	ProjectID         string             `json:"project_id"`
+	Name              string             `json:"name"`
+	Description       string             `json:"description,omitempty"`
+	UserID            string             `json:"user_id,omitempty"`
+	OrgID             string             `json:"org_id,omitempty"`
+	Created           string             `json:"created,omitempty"`
+	DeletedAt         string             `json:"deleted_at,omitempty"`
+}
+
+// PromptSessionData binds prompts or functions and a dataset to a prompt session.
+// Both fields are always serialized so that sending the data replaces any
+// previously configured bindings.
+type PromptSessionData struct {
+	DatasetID   *string  `json:"dataset_id"`
+	FunctionIDs []string `json:"function_ids"`
+}
+
+// ListPromptSessionsOptions represents options for listing prompt sessions.
+type ListPromptSessionsOptions struct {
+	StartingAfter     string
+	EndingBefore      string
+	OrgName           string
+	ProjectID         string
+	ProjectName       string
+	PromptSessionName string
+	IDs               []string
+	Limit             int
+}
+
+// ListPromptSessionsResponse represents a list of prompt sessions.
+type ListPromptSessionsResponse struct {
+	PromptSessions []PromptSession `json:"objects"`
+}
+
+// CreatePromptSessionRequest represents a request to create a prompt session.
+type CreatePromptSessionRequest struct {
+	PromptSessionData *PromptSessionData `json:"prompt_session_data,omitempty"`
+	ProjectID         string             `json:"project_id"`
+	Name              string             `json:"name"`
+	Description       string             `json:"description,omitempty"`
+}
+
+// UpdatePromptSessionRequest represents a request to update a prompt session.
+type UpdatePromptSessionRequest struct {
+	Name              *string            `json:"name,omitempty"`
+	Description       *string            `json:"description,omitempty"`
+	PromptSessionData *PromptSessionData `json:"prompt_session_data,omitempty"`
+}
+
+func promptSessionPath(id string) string {
+	return "/v1/prompt_session/" + url.PathEscape(id)
+}
+
+// GetPromptSession retrieves a prompt session by ID.
+func (c *Client) GetPromptSession(ctx context.Context, id string) (*PromptSession, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return nil, ErrEmptyPromptSessionID
+	}
+
+	var promptSession PromptSession
+	err := c.Do(ctx, "GET", promptSessionPath(id), nil, &promptSession)
+	if err != nil {
+		return nil, err
+	}
+
+	return &promptSession, nil
+}
+
+// CreatePromptSession creates a new prompt session.
+func (c *Client) CreatePromptSession(ctx context.Context, req *CreatePromptSessionRequest) (*PromptSession, error) {
+	var promptSession PromptSession
+	err := c.Do(ctx, "POST", "/v1/prompt_session", req, &promptSession)
+	if err != nil {
+		return nil, err
+	}
+
+	return &promptSession, nil
+}
+
+// UpdatePromptSession updates an existing prompt session.
+func (c *Client) UpdatePromptSession(ctx context.Context, id string, req *UpdatePromptSessionRequest) (*PromptSession, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return nil, ErrEmptyPromptSessionID
+	}
+
+	var promptSession PromptSession
+	err := c.Do(ctx, "PATCH", promptSessionPath(id), req, &promptSession)
+	if err != nil {
+		return nil, err
+	}
+
+	return &promptSession, nil
+}
+
+// DeletePromptSession deletes a prompt session by ID.
+func (c *Client) DeletePromptSession(ctx context.Context, id string) error {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return ErrEmptyPromptSessionID
+	}
+
+	return c.Do(ctx, "DELETE", promptSessionPath(id), nil, nil)
+}
+
+// ListPromptSessions lists prompt sessions, optionally filtered by API-native query parameters.
+func (c *Client) ListPromptSessions(ctx context.Context, opts *ListPromptSessionsOptions) (*ListPromptSessionsResponse, error) {
+	path := "/v1/prompt_session"
+
+	if opts != nil {
+		params := url.Values{}
+		if opts.Limit > 0 {
+			params.Set("limit", fmt.Sprintf("%d", opts.Limit))
+		}
+		if opts.StartingAfter != "" {
+			params.Set("starting_after", opts.StartingAfter)
+		}
+		if opts.EndingBefore != "" {
+			params.Set("ending_before", opts.EndingBefore)
+		}
+		for _, id := range opts.IDs {
+			if id != "" {
+				params.Add("ids", id)
+			}
+		}
+		if opts.OrgName != "" {
+			params.Set("org_name", opts.OrgName)
+		}
+		if opts.ProjectID != "" {
+			params.Set("project_id", opts.ProjectID)
+		}
+		if opts.ProjectName != "" {
+			params.Set("project_name", opts.ProjectName)
+		}
+		if opts.PromptSessionName != "" {
+			params.Set("prompt_session_name", opts.PromptSessionName)
+		}
+
+		if encodedParams := params.Encode(); encodedParams != "" {
+			path += "?" + encodedParams
+		}
+	}
+
+	var result ListPromptSessionsResponse
+	err := c.Do(ctx, "GET", path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
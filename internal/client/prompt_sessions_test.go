@@ -0,0 +1,282 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestGetPromptSession(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("expected GET method, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/prompt_session/session-123" {
+			t.Errorf("expected path /v1/prompt_session/session-123, got %s", r.URL.Path)
+		}
+
+		datasetID := "dataset-123"
+		resp := PromptSession{
+			ID:        "session-123",
+			ProjectID: "proj-123",
+			Name:      "baseline-playground",
+			PromptSessionData: &PromptSessionData{
+				DatasetID:   &datasetID,
+				FunctionIDs: []string{"prompt-1", "function-1"},
+			},
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	promptSession, err := client.GetPromptSession(context.Background(), "session-123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if promptSession.ID != "session-123" {
+		t.Errorf("expected id session-123, got %s", promptSession.ID)
+	}
+	if promptSession.PromptSessionData == nil || promptSession.PromptSessionData.DatasetID == nil || *promptSession.PromptSessionData.DatasetID != "dataset-123" {
+		t.Errorf("expected dataset_id dataset-123, got %#v", promptSession.PromptSessionData)
+	}
+	if !reflect.DeepEqual(promptSession.PromptSessionData.FunctionIDs, []string{"prompt-1", "function-1"}) {
+		t.Errorf("unexpected function_ids: %v", promptSession.PromptSessionData.FunctionIDs)
+	}
+}
+
+func TestGetPromptSession_WhitespaceID(t *testing.T) {
+	requestCount := 0
+	server := httptest.NewTLSServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		requestCount++
+		t.Fatalf("expected no API call for whitespace-only ID")
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	_, err := client.GetPromptSession(context.Background(), " \t\r\n")
+	if !errors.Is(err, ErrEmptyPromptSessionID) {
+		t.Fatalf("expected ErrEmptyPromptSessionID, got %v", err)
+	}
+	if requestCount != 0 {
+		t.Fatalf("expected no API call for whitespace-only ID, got %d request(s)", requestCount)
+	}
+}
+
+func TestListPromptSessions_WithOptions(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("expected GET method, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/prompt_session" {
+			t.Errorf("expected path /v1/prompt_session, got %s", r.URL.Path)
+		}
+
+		query := r.URL.Query()
+		if got := query.Get("limit"); got != "10" {
+			t.Errorf("expected limit 10, got %q", got)
+		}
+		if got := query.Get("starting_after"); got != "cursor-next" {
+			t.Errorf("expected starting_after cursor-next, got %q", got)
+		}
+		if got := query.Get("org_name"); got != "test-org" {
+			t.Errorf("expected org_name test-org, got %q", got)
+		}
+		if got := query.Get("project_id"); got != "proj-123" {
+			t.Errorf("expected project_id proj-123, got %q", got)
+		}
+		if got := query.Get("project_name"); got != "example-project" {
+			t.Errorf("expected project_name example-project, got %q", got)
+		}
+		if got := query.Get("prompt_session_name"); got != "baseline-playground" {
+			t.Errorf("expected prompt_session_name baseline-playground, got %q", got)
+		}
+		if got := query["ids"]; !reflect.DeepEqual(got, []string{"session-1", "session-2"}) {
+			t.Errorf("expected ids [session-1 session-2], got %v", got)
+		}
+
+		resp := ListPromptSessionsResponse{
+			PromptSessions: []PromptSession{{ID: "session-1", Name: "baseline-playground", ProjectID: "proj-123"}},
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	result, err := client.ListPromptSessions(context.Background(), &ListPromptSessionsOptions{
+		Limit:             10,
+		StartingAfter:     "cursor-next",
+		IDs:               []string{"session-1", "session-2"},
+		OrgName:           "test-org",
+		ProjectID:         "proj-123",
+		ProjectName:       "example-project",
+		PromptSessionName: "baseline-playground",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.PromptSessions) != 1 || result.PromptSessions[0].ID != "session-1" {
+		t.Fatalf("unexpected prompt sessions: %#v", result.PromptSessions)
+	}
+}
+
+func TestCreatePromptSession(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST method, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/prompt_session" {
+			t.Errorf("expected path /v1/prompt_session, got %s", r.URL.Path)
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("read request: %v", err)
+		}
+
+		var got map[string]any
+		if err := json.Unmarshal(body, &got); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+
+		want := map[string]any{
+			"project_id":  "proj-123",
+			"name":        "baseline-playground",
+			"description": "Standard evaluation playground",
+			"prompt_session_data": map[string]any{
+				"dataset_id":   "dataset-123",
+				"function_ids": []any{"prompt-1"},
+			},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("unexpected request payload:\n got: %#v\nwant: %#v", got, want)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(PromptSession{ID: "session-123", ProjectID: "proj-123", Name: "baseline-playground"})
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	datasetID := "dataset-123"
+	promptSession, err := client.CreatePromptSession(context.Background(), &CreatePromptSessionRequest{
+		ProjectID:   "proj-123",
+		Name:        "baseline-playground",
+		Description: "Standard evaluation playground",
+		PromptSessionData: &PromptSessionData{
+			DatasetID:   &datasetID,
+			FunctionIDs: []string{"prompt-1"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if promptSession.ID != "session-123" {
+		t.Fatalf("expected id session-123, got %q", promptSession.ID)
+	}
+}
+
+func TestUpdatePromptSession_ClearsDataset(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("expected PATCH method, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/prompt_session/session-123" {
+			t.Errorf("expected path /v1/prompt_session/session-123, got %s", r.URL.Path)
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("read request: %v", err)
+		}
+
+		var got map[string]any
+		if err := json.Unmarshal(body, &got); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+
+		want := map[string]any{
+			"prompt_session_data": map[string]any{
+				"dataset_id":   nil,
+				"function_ids": []any{"prompt-1", "prompt-2"},
+			},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("unexpected request payload:\n got: %#v\nwant: %#v", got, want)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(PromptSession{ID: "session-123"})
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	_, err := client.UpdatePromptSession(context.Background(), "session-123", &UpdatePromptSessionRequest{
+		PromptSessionData: &PromptSessionData{
+			FunctionIDs: []string{"prompt-1", "prompt-2"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestDeletePromptSession(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected DELETE method, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/prompt_session/session-123" {
+			t.Errorf("expected path /v1/prompt_session/session-123, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	if err := client.DeletePromptSession(context.Background(), "session-123"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestDeletePromptSession_WhitespaceID(t *testing.T) {
+	requestCount := 0
+	server := httptest.NewTLSServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		requestCount++
+		t.Fatalf("expected no API call for whitespace-only ID")
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	err := client.DeletePromptSession(context.Background(), " \t\r\n")
+	if !errors.Is(err, ErrEmptyPromptSessionID) {
+		t.Fatalf("expected ErrEmptyPromptSessionID, got %v", err)
+	}
+	if requestCount != 0 {
+		t.Fatalf("expected no API call for whitespace-only ID, got %d request(s)", requestCount)
+	}
+}
@@ -22,6 +22,7 @@ type Prompt struct {
 	Slug         string                 `json:"slug,omitempty"`
 	Description  string                 `json:"description,omitempty"`
 	Version      string                 `json:"version,omitempty"`
+	XactID       string                 `json:"_xact_id,omitempty"`
 	Created      string                 `json:"created,omitempty"`
 	DeletedAt    string                 `json:"deleted_at,omitempty"`
 	UserID       string                 `json:"user_id,omitempty"`
@@ -37,6 +38,7 @@ type ListPromptsOptions struct {
 	Version       string
 	StartingAfter string
 	EndingBefore  string
+	IDs           []string
 	Limit         int
 }
 
@@ -139,6 +141,11 @@ func (c *Client) ListPrompts(ctx context.Context, opts *ListPromptsOptions) (*Li
 		if opts.Version != "" {
 			params.Set("version", opts.Version)
 		}
+		for _, id := range opts.IDs {
+			if id != "" {
+				params.Add("ids", id)
+			}
+		}
 		if opts.Limit > 0 {
 			params.Set("limit", fmt.Sprintf("%d", opts.Limit))
 		}
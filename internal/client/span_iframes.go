@@ -0,0 +1,163 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ErrEmptySpanIframeID is returned when a span iframe ID is empty.
+var ErrEmptySpanIframeID = errors.New("span iframe ID cannot be empty")
+
+// SpanIframe represents a Braintrust span iframe used to render spans in the trace viewer.
+type SpanIframe struct {
+	PostMessage *bool  `json:"post_message,omitempty"`
+	ID          string `json:"id"`
+	ProjectID   string `json:"project_id"`
+	Name        string `json:"name"`
+	URL         string `json:"url"`
+	Description string `json:"description,omitempty"`
+	UserID      string `json:"user_id,omitempty"`
+	Created     string `json:"created,omitempty"`
+	DeletedAt   string `json:"deleted_at,omitempty"`
+}
+
+// ListSpanIframesOptions represents options for listing span iframes.
+type ListSpanIframesOptions struct {
+	StartingAfter  string
+	EndingBefore   string
+	OrgName        string
+	ProjectID      string
+	ProjectName    string
+	SpanIframeName string
+	IDs            []string
+	Limit          int
+}
+
+// ListSpanIframesResponse represents a list of span iframes.
+type ListSpanIframesResponse struct {
+	SpanIframes []SpanIframe `json:"objects"`
+}
+
+// CreateSpanIframeRequest represents a request to create a span iframe.
+type CreateSpanIframeRequest struct {
+	PostMessage *bool  `json:"post_message,omitempty"`
+	ProjectID   string `json:"project_id"`
+	Name        string `json:"name"`
+	URL         string `json:"url"`
+	Description string `json:"description,omitempty"`
+}
+
+// UpdateSpanIframeRequest represents a request to update a span iframe.
+type UpdateSpanIframeRequest struct {
+	Name        *string `json:"name,omitempty"`
+	URL         *string `json:"url,omitempty"`
+	Description *string `json:"description,omitempty"`
+	PostMessage *bool   `json:"post_message,omitempty"`
+}
+
+func spanIframePath(id string) string {
+	return "/v1/span_iframe/" + url.PathEscape(id)
+}
+
+// GetSpanIframe retrieves a span iframe by ID.
+func (c *Client) GetSpanIframe(ctx context.Context, id string) (*SpanIframe, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return nil, ErrEmptySpanIframeID
+	}
+
+	var spanIframe SpanIframe
+	err := c.Do(ctx, "GET", spanIframePath(id), nil, &spanIframe)
+	if err != nil {
+		return nil, err
+	}
+
+	return &spanIframe, nil
+}
+
+// CreateSpanIframe creates a new span iframe.
+func (c *Client) CreateSpanIframe(ctx context.Context, req *CreateSpanIframeRequest) (*SpanIframe, error) {
+	var spanIframe SpanIframe
+	err := c.Do(ctx, "POST", "/v1/span_iframe", req, &spanIframe)
+	if err != nil {
+		return nil, err
+	}
+
+	return &spanIframe, nil
+}
+
+// UpdateSpanIframe updates an existing span iframe.
+func (c *Client) UpdateSpanIframe(ctx context.Context, id string, req *UpdateSpanIframeRequest) (*SpanIframe, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return nil, ErrEmptySpanIframeID
+	}
+
+	var spanIframe SpanIframe
+	err := c.Do(ctx, "PATCH", spanIframePath(id), req, &spanIframe)
+	if err != nil {
+		return nil, err
+	}
+
+	return &spanIframe, nil
+}
+
+// DeleteSpanIframe deletes a span iframe by ID.
+func (c *Client) DeleteSpanIframe(ctx context.Context, id string) error {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return ErrEmptySpanIframeID
+	}
+
+	return c.Do(ctx, "DELETE", spanIframePath(id), nil, nil)
+}
+
+// ListSpanIframes lists span iframes, optionally filtered by API-native query parameters.
+func (c *Client) ListSpanIframes(ctx context.Context, opts *ListSpanIframesOptions) (*ListSpanIframesResponse, error) {
+	path := "/v1/span_iframe"
+
+	if opts != nil {
+		params := url.Values{}
+		if opts.Limit > 0 {
+			params.Set("limit", fmt.Sprintf("%d", opts.Limit))
+		}
+		if opts.StartingAfter != "" {
+			params.Set("starting_after", opts.StartingAfter)
+		}
+		if opts.EndingBefore != "" {
+			params.Set("ending_before", opts.EndingBefore)
+		}
+		for _, id := range opts.IDs {
+			if id != "" {
+				params.Add("ids", id)
+			}
+		}
+		if opts.OrgName != "" {
+			params.Set("org_name", opts.OrgName)
+		}
+		if opts.ProjectID != "" {
+			params.Set("project_id", opts.ProjectID)
+		}
+		if opts.ProjectName != "" {
+			params.Set("project_name", opts.ProjectName)
+		}
+		if opts.SpanIframeName != "" {
+			params.Set("span_iframe_name", opts.SpanIframeName)
+		}
+
+		if encodedParams := params.Encode(); encodedParams != "" {
+			path += "?" + encodedParams
+		}
+	}
+
+	var result ListSpanIframesResponse
+	err := c.Do(ctx, "GET", path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,272 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestGetSpanIframe(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("expected GET method, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/span_iframe/iframe-123" {
+			t.Errorf("expected path /v1/span_iframe/iframe-123, got %s", r.URL.Path)
+		}
+
+		postMessage := true
+		resp := SpanIframe{
+			ID:          "iframe-123",
+			ProjectID:   "proj-123",
+			Name:        "table-view",
+			URL:         "https://viewer.example.com/span",
+			PostMessage: &postMessage,
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	spanIframe, err := client.GetSpanIframe(context.Background(), "iframe-123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if spanIframe.ID != "iframe-123" {
+		t.Errorf("expected id iframe-123, got %s", spanIframe.ID)
+	}
+	if spanIframe.PostMessage == nil || !*spanIframe.PostMessage {
+		t.Errorf("expected post_message true, got %#v", spanIframe.PostMessage)
+	}
+}
+
+func TestGetSpanIframe_WhitespaceID(t *testing.T) {
+	requestCount := 0
+	server := httptest.NewTLSServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		requestCount++
+		t.Fatalf("expected no API call for whitespace-only ID")
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	_, err := client.GetSpanIframe(context.Background(), " \t\r\n")
+	if !errors.Is(err, ErrEmptySpanIframeID) {
+		t.Fatalf("expected ErrEmptySpanIframeID, got %v", err)
+	}
+	if requestCount != 0 {
+		t.Fatalf("expected no API call for whitespace-only ID, got %d request(s)", requestCount)
+	}
+}
+
+func TestListSpanIframes_WithOptions(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("expected GET method, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/span_iframe" {
+			t.Errorf("expected path /v1/span_iframe, got %s", r.URL.Path)
+		}
+
+		query := r.URL.Query()
+		if got := query.Get("limit"); got != "10" {
+			t.Errorf("expected limit 10, got %q", got)
+		}
+		if got := query.Get("ending_before"); got != "cursor-prev" {
+			t.Errorf("expected ending_before cursor-prev, got %q", got)
+		}
+		if got := query.Get("org_name"); got != "test-org" {
+			t.Errorf("expected org_name test-org, got %q", got)
+		}
+		if got := query.Get("project_id"); got != "proj-123" {
+			t.Errorf("expected project_id proj-123, got %q", got)
+		}
+		if got := query.Get("project_name"); got != "example-project" {
+			t.Errorf("expected project_name example-project, got %q", got)
+		}
+		if got := query.Get("span_iframe_name"); got != "table-view" {
+			t.Errorf("expected span_iframe_name table-view, got %q", got)
+		}
+		if got := query["ids"]; !reflect.DeepEqual(got, []string{"iframe-1", "iframe-2"}) {
+			t.Errorf("expected ids [iframe-1 iframe-2], got %v", got)
+		}
+
+		resp := ListSpanIframesResponse{
+			SpanIframes: []SpanIframe{{ID: "iframe-1", Name: "table-view", ProjectID: "proj-123"}},
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	result, err := client.ListSpanIframes(context.Background(), &ListSpanIframesOptions{
+		Limit:          10,
+		EndingBefore:   "cursor-prev",
+		IDs:            []string{"iframe-1", "iframe-2"},
+		OrgName:        "test-org",
+		ProjectID:      "proj-123",
+		ProjectName:    "example-project",
+		SpanIframeName: "table-view",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.SpanIframes) != 1 || result.SpanIframes[0].ID != "iframe-1" {
+		t.Fatalf("unexpected span iframes: %#v", result.SpanIframes)
+	}
+}
+
+func TestCreateSpanIframe(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST method, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/span_iframe" {
+			t.Errorf("expected path /v1/span_iframe, got %s", r.URL.Path)
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("read request: %v", err)
+		}
+
+		var got map[string]any
+		if err := json.Unmarshal(body, &got); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+
+		want := map[string]any{
+			"project_id":   "proj-123",
+			"name":         "table-view",
+			"url":          "https://viewer.example.com/span",
+			"description":  "Renders spans as tables",
+			"post_message": false,
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("unexpected request payload:\n got: %#v\nwant: %#v", got, want)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(SpanIframe{ID: "iframe-123", ProjectID: "proj-123", Name: "table-view"})
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	postMessage := false
+	spanIframe, err := client.CreateSpanIframe(context.Background(), &CreateSpanIframeRequest{
+		ProjectID:   "proj-123",
+		Name:        "table-view",
+		URL:         "https://viewer.example.com/span",
+		Description: "Renders spans as tables",
+		PostMessage: &postMessage,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if spanIframe.ID != "iframe-123" {
+		t.Fatalf("expected id iframe-123, got %q", spanIframe.ID)
+	}
+}
+
+func TestUpdateSpanIframe(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("expected PATCH method, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/span_iframe/iframe-123" {
+			t.Errorf("expected path /v1/span_iframe/iframe-123, got %s", r.URL.Path)
+		}
+
+		var req UpdateSpanIframeRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+
+		if req.URL == nil || *req.URL != "https://viewer.example.com/v2" {
+			t.Fatalf("expected url https://viewer.example.com/v2, got %#v", req.URL)
+		}
+		if req.Name != nil {
+			t.Fatalf("expected name to be omitted, got %#v", req.Name)
+		}
+		if req.PostMessage == nil || !*req.PostMessage {
+			t.Fatalf("expected post_message true, got %#v", req.PostMessage)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(SpanIframe{ID: "iframe-123", URL: *req.URL, PostMessage: req.PostMessage})
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	url := "https://viewer.example.com/v2"
+	postMessage := true
+	spanIframe, err := client.UpdateSpanIframe(context.Background(), "iframe-123", &UpdateSpanIframeRequest{
+		URL:         &url,
+		PostMessage: &postMessage,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if spanIframe.URL != url {
+		t.Fatalf("expected url %s, got %q", url, spanIframe.URL)
+	}
+}
+
+func TestDeleteSpanIframe(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected DELETE method, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/span_iframe/iframe-123" {
+			t.Errorf("expected path /v1/span_iframe/iframe-123, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	if err := client.DeleteSpanIframe(context.Background(), "iframe-123"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestDeleteSpanIframe_WhitespaceID(t *testing.T) {
+	requestCount := 0
+	server := httptest.NewTLSServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		requestCount++
+		t.Fatalf("expected no API call for whitespace-only ID")
+	}))
+	defer server.Close()
+
+	client := NewClient("sk-test", server.URL, "org-test")
+	client.httpClient = server.Client()
+
+	err := client.DeleteSpanIframe(context.Background(), " \t\r\n")
+	if !errors.Is(err, ErrEmptySpanIframeID) {
+		t.Fatalf("expected ErrEmptySpanIframeID, got %v", err)
+	}
+	if requestCount != 0 {
+		t.Fatalf("expected no API call for whitespace-only ID, got %d request(s)", requestCount)
+	}
+}
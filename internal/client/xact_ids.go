@@ -0,0 +1,57 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Transaction IDs (`_xact_id`) are 64-bit integers made of a fixed 16-bit
+// prefix, 32 bits of unix seconds and a 16-bit per-second counter. The API
+// does not document this layout, so it is only relied on here; callers treat
+// ErrUnrecognizedVersion as a cue to fall back to object timestamps.
+const (
+	xactIDPrefix      uint64 = 0x0DE1 << 48
+	xactIDPrefixMask  uint64 = 0xFFFF << 48
+	xactIDSecondsMask uint64 = 0xFFFFFFFF
+	xactIDCounterBits        = 16
+	xactIDCounterMask uint64 = 1<<xactIDCounterBits - 1
+)
+
+// ErrUnrecognizedVersion is returned when a transaction ID does not carry a
+// readable write time.
+var ErrUnrecognizedVersion = errors.New("transaction ID does not carry a recognized timestamp")
+
+// VersionTime returns the time a transaction ID was written, to the second.
+func VersionTime(version string) (time.Time, error) {
+	xactID, err := parseXactID(version)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if xactID&xactIDPrefixMask != xactIDPrefix {
+		return time.Time{}, fmt.Errorf("%w: %s", ErrUnrecognizedVersion, version)
+	}
+
+	seconds := int64((xactID >> xactIDCounterBits) & xactIDSecondsMask) // #nosec G115 -- masked to 32 bits
+	return time.Unix(seconds, 0).UTC(), nil
+}
+
+// DatasetVersionAt returns the highest transaction ID that can have been
+// written at or before t. Reading a dataset at this version returns its
+// contents as of t.
+func DatasetVersionAt(t time.Time) string {
+	seconds := uint64(t.Unix()) & xactIDSecondsMask // #nosec G115 -- transaction IDs hold 32 bits of seconds
+	return strconv.FormatUint(xactIDPrefix|seconds<<xactIDCounterBits|xactIDCounterMask, 10)
+}
+
+// parseXactID parses a transaction ID as a number, without assuming a layout.
+func parseXactID(version string) (uint64, error) {
+	xactID, err := strconv.ParseUint(strings.TrimSpace(version), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid transaction ID %q", version)
+	}
+
+	return xactID, nil
+}
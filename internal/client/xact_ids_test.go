@@ -0,0 +1,71 @@
+package client
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// realXactID is a transaction ID returned by the API for a write at
+// 2024-03-09T07:48:38Z.
+const realXactID = "1000192656880881099"
+
+func TestVersionTime(t *testing.T) {
+	got, err := VersionTime(realXactID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := time.Date(2024, 3, 9, 7, 48, 38, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestVersionTime_Invalid(t *testing.T) {
+	for _, version := range []string{"", "81cd05ee665fdfb3"} {
+		if _, err := VersionTime(version); err == nil {
+			t.Errorf("expected error for version %q", version)
+		}
+	}
+}
+
+func TestVersionTime_UnrecognizedLayout(t *testing.T) {
+	if _, err := VersionTime("12345"); !errors.Is(err, ErrUnrecognizedVersion) {
+		t.Fatalf("expected ErrUnrecognizedVersion, got %v", err)
+	}
+}
+
+func TestDatasetVersionAt(t *testing.T) {
+	at := time.Date(2024, 3, 9, 7, 48, 38, 0, time.UTC)
+
+	// realXactID was written during this second, so the version at the end
+	// of the second is the same second with the counter saturated.
+	if got := DatasetVersionAt(at); got != "1000192656880893951" {
+		t.Errorf("expected 1000192656880893951, got %s", got)
+	}
+}
+
+func TestDatasetVersionAt_BoundsRealXactID(t *testing.T) {
+	written, err := VersionTime(realXactID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	bound, err := parseXactID(DatasetVersionAt(written))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	before, err := parseXactID(DatasetVersionAt(written.Add(-time.Second)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	xactID, err := parseXactID(realXactID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if xactID > bound || xactID <= before {
+		t.Errorf("expected %d in (%d, %d]", xactID, before, bound)
+	}
+}
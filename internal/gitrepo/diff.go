@@ -0,0 +1,185 @@
+package gitrepo
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+)
+
+// diffContext is the number of unchanged lines shown around a change.
+const diffContext = 3
+
+// diffTruncatedNote ends a diff cut short by InfoOptions.MaxDiffBytes.
+const diffTruncatedNote = "\n[diff truncated]\n"
+
+// diff renders changes as a unified diff of the checked out commit against the
+// working tree. Each file gets a single hunk spanning its first to last changed
+// line, which is a valid patch though not always the smallest one.
+func (r *Repository) diff(changes []change, maxBytes int) (string, error) {
+	var out strings.Builder
+	for _, item := range changes {
+		if err := r.writeFileDiff(&out, item); err != nil {
+			return "", err
+		}
+		if maxBytes > 0 && out.Len() > maxBytes {
+			break
+		}
+	}
+	return truncateDiff(out.String(), maxBytes), nil
+}
+
+func (r *Repository) writeFileDiff(out *strings.Builder, item change) error {
+	if item.oldMode&modeTypeMask == modeGitlink {
+		// Submodule commits are not diffed.
+		return nil
+	}
+
+	var oldContent, newContent []byte
+	if item.oldID != "" {
+		typ, data, err := r.objects.read(item.oldID)
+		if err != nil {
+			return fmt.Errorf("read blob %s: %w", item.oldID, err)
+		}
+		if typ != objectBlob {
+			return fmt.Errorf("object %s is a %s, not a blob", item.oldID, typ)
+		}
+		oldContent = data
+	}
+
+	var newMode uint32
+	if !item.deleted {
+		path := filepath.Join(r.WorkTree, filepath.FromSlash(item.path))
+		stat, err := os.Lstat(path)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		if err == nil && !stat.IsDir() {
+			newMode = worktreeMode(stat)
+			if newContent, err = readWorktreeFile(path, stat); err != nil {
+				return err
+			}
+		}
+	}
+
+	if item.oldID == "" && newMode == 0 {
+		return nil
+	}
+	if item.oldID != "" && newMode == item.oldMode && bytes.Equal(oldContent, newContent) {
+		return nil
+	}
+
+	oldName, newName := "a/"+item.path, "b/"+item.path
+	fmt.Fprintf(out, "diff --git %s %s\n", oldName, newName)
+	switch {
+	case item.oldID == "":
+		fmt.Fprintf(out, "new file mode %o\n", newMode)
+		oldName = "/dev/null"
+	case newMode == 0:
+		fmt.Fprintf(out, "deleted file mode %o\n", item.oldMode)
+		newName = "/dev/null"
+	case newMode != item.oldMode:
+		fmt.Fprintf(out, "old mode %o\nnew mode %o\n", item.oldMode, newMode)
+	}
+
+	if bytes.Equal(oldContent, newContent) {
+		return nil
+	}
+	if isBinary(oldContent) || isBinary(newContent) {
+		fmt.Fprintf(out, "Binary files %s and %s differ\n", oldName, newName)
+		return nil
+	}
+
+	fmt.Fprintf(out, "--- %s\n+++ %s\n", oldName, newName)
+	writeHunk(out, splitLines(oldContent), splitLines(newContent))
+	return nil
+}
+
+// writeHunk writes one hunk covering everything between the common leading and
+// trailing lines of old and new.
+func writeHunk(out *strings.Builder, oldLines, newLines []string) {
+	prefix := 0
+	for prefix < len(oldLines) && prefix < len(newLines) && oldLines[prefix] == newLines[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(oldLines)-prefix && suffix < len(newLines)-prefix &&
+		oldLines[len(oldLines)-1-suffix] == newLines[len(newLines)-1-suffix] {
+		suffix++
+	}
+
+	start := max(prefix-diffContext, 0)
+	trailing := min(suffix, diffContext)
+	oldEnd := len(oldLines) - suffix
+	newEnd := len(newLines) - suffix
+
+	fmt.Fprintf(out, "@@ -%s +%s @@\n",
+		hunkRange(start, oldEnd+trailing-start),
+		hunkRange(start, newEnd+trailing-start))
+
+	writeLines(out, ' ', oldLines[start:prefix])
+	writeLines(out, '-', oldLines[prefix:oldEnd])
+	writeLines(out, '+', newLines[prefix:newEnd])
+	writeLines(out, ' ', oldLines[oldEnd:oldEnd+trailing])
+}
+
+// hunkRange formats a hunk header range from a zero-based start line.
+func hunkRange(start, count int) string {
+	switch count {
+	case 0:
+		return fmt.Sprintf("%d,0", start)
+	case 1:
+		return fmt.Sprintf("%d", start+1)
+	default:
+		return fmt.Sprintf("%d,%d", start+1, count)
+	}
+}
+
+func writeLines(out *strings.Builder, marker byte, lines []string) {
+	for _, line := range lines {
+		out.WriteByte(marker)
+		out.WriteString(line)
+		if !strings.HasSuffix(line, "\n") {
+			out.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+}
+
+// splitLines splits content after each newline, keeping the newlines.
+func splitLines(content []byte) []string {
+	if len(content) == 0 {
+		return nil
+	}
+	lines := strings.SplitAfter(string(content), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// isBinary uses git's heuristic: a NUL byte in the first 8000 bytes.
+func isBinary(content []byte) bool {
+	return bytes.IndexByte(content[:min(len(content), 8000)], 0) >= 0
+}
+
+// truncateDiff shortens diff to at most maxBytes, ending with a note and
+// never splitting a UTF-8 sequence.
+func truncateDiff(diff string, maxBytes int) string {
+	if maxBytes <= 0 || len(diff) <= maxBytes {
+		return diff
+	}
+
+	note := diffTruncatedNote
+	if len(note) > maxBytes {
+		note = ""
+	}
+	cut := maxBytes - len(note)
+	for cut > 0 && !utf8.RuneStart(diff[cut]) {
+		cut--
+	}
+	return diff[:cut] + note
+}
@@ -0,0 +1,78 @@
+package gitrepo
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestWriteHunk(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		old  string
+		new  string
+		want string
+	}{
+		"append": {
+			old:  "a\nb\n",
+			new:  "a\nb\nc\n",
+			want: "@@ -1,2 +1,3 @@\n a\n b\n+c\n",
+		},
+		"missing newline": {
+			old:  "a\nb",
+			new:  "a\nc",
+			want: "@@ -1,2 +1,2 @@\n a\n-b\n\\ No newline at end of file\n+c\n\\ No newline at end of file\n",
+		},
+		"new file": {
+			old:  "",
+			new:  "x\n",
+			want: "@@ -0,0 +1 @@\n+x\n",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var out strings.Builder
+			writeHunk(&out, splitLines([]byte(tc.old)), splitLines([]byte(tc.new)))
+			if out.String() != tc.want {
+				t.Fatalf("writeHunk() =\n%q\nwant\n%q", out.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestTruncateDiff(t *testing.T) {
+	t.Parallel()
+
+	diff := "+" + strings.Repeat("é", 20) + "\n"
+	if got := truncateDiff(diff, 0); got != diff {
+		t.Fatalf("truncateDiff() without limit = %q", got)
+	}
+	if got := truncateDiff(diff, len(diff)); got != diff {
+		t.Fatalf("truncateDiff() at limit = %q", got)
+	}
+
+	got := truncateDiff(diff, 32)
+	if len(got) > 32 || !strings.HasSuffix(got, diffTruncatedNote) || !utf8.ValidString(got) {
+		t.Fatalf("truncateDiff() = %q", got)
+	}
+
+	got = truncateDiff(diff, 4)
+	if len(got) > 4 || !utf8.ValidString(got) {
+		t.Fatalf("truncateDiff() tiny limit = %q", got)
+	}
+}
+
+func TestIsBinary(t *testing.T) {
+	t.Parallel()
+
+	if isBinary([]byte("text\n")) {
+		t.Fatal("isBinary(text) = true")
+	}
+	if !isBinary([]byte("bin\x00ary")) {
+		t.Fatal("isBinary(binary) = false")
+	}
+}
@@ -0,0 +1,376 @@
+package gitrepo
+
+import (
+	"bytes"
+	"crypto/sha1" // #nosec G505 -- git object IDs are SHA-1
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// File modes as stored in the index and in tree objects.
+const (
+	modeTree       = 0o040000
+	modeRegular    = 0o100644
+	modeExecutable = 0o100755
+	modeSymlink    = 0o120000
+	modeGitlink    = 0o160000
+	modeTypeMask   = 0o170000
+)
+
+// Index entry flags.
+const (
+	indexFlagAssumeValid  = 0x8000
+	indexFlagExtended     = 0x4000
+	indexFlagSkipWorktree = 0x4000 // in the extended flags
+)
+
+// indexEntry is a single path staged in .git/index.
+type indexEntry struct {
+	path         string
+	id           string
+	mtimeSeconds uint32
+	mtimeNanos   uint32
+	mode         uint32
+	size         uint32
+	stage        int
+	assumeValid  bool
+	skipWorktree bool
+}
+
+// treeEntry is a blob or gitlink reachable from a commit's tree.
+type treeEntry struct {
+	id   string
+	mode uint32
+}
+
+// change is a path whose working tree content differs from the checked out
+// commit.
+type change struct {
+	path string
+	// oldID is the blob in the checked out commit, empty when the path is new.
+	oldID   string
+	oldMode uint32
+	// deleted reports that the path is missing from the working tree.
+	deleted bool
+}
+
+// worktreeChanges lists tracked paths that differ between the commit and the
+// index, or between the index and the working tree. Untracked files are not
+// reported, and files are compared byte for byte, without applying clean
+// filters or line ending conversion.
+func (r *Repository) worktreeChanges(commit string) ([]change, error) {
+	indexPath := filepath.Join(r.GitDir, "index")
+	entries, err := readIndex(indexPath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("read index: %w", err)
+	}
+
+	head := map[string]treeEntry{}
+	if commit != "" {
+		if head, err = r.commitTree(commit); err != nil {
+			return nil, err
+		}
+	}
+
+	var indexModTime int64
+	if stat, err := os.Stat(indexPath); err == nil {
+		indexModTime = stat.ModTime().UnixNano()
+	}
+
+	changed := map[string]change{}
+	seen := map[string]bool{}
+	var sparseDirs []string
+	for _, entry := range entries {
+		seen[entry.path] = true
+		if entry.mode&modeTypeMask == modeTree {
+			// Sparse index: the whole directory is outside the checkout.
+			sparseDirs = append(sparseDirs, strings.TrimSuffix(entry.path, "/")+"/")
+			continue
+		}
+
+		old, inHead := head[entry.path]
+		item := change{path: entry.path, oldID: old.id, oldMode: old.mode}
+
+		if entry.stage != 0 || !inHead || old.id != entry.id || old.mode != entry.mode {
+			item.deleted = !r.worktreeExists(entry.path)
+			changed[entry.path] = item
+			continue
+		}
+
+		modified, deleted, err := r.worktreeModified(entry, indexModTime)
+		if err != nil {
+			return nil, err
+		}
+		if modified {
+			item.deleted = deleted
+			changed[entry.path] = item
+		}
+	}
+
+	for path, old := range head {
+		if seen[path] || hasAnyPrefix(path, sparseDirs) {
+			continue
+		}
+		// Removed from the index, so the working tree copy, if any, is
+		// untracked.
+		changed[path] = change{path: path, oldID: old.id, oldMode: old.mode, deleted: true}
+	}
+
+	changes := make([]change, 0, len(changed))
+	for _, item := range changed {
+		changes = append(changes, item)
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].path < changes[j].path })
+	return changes, nil
+}
+
+func (r *Repository) worktreeExists(path string) bool {
+	_, err := os.Lstat(filepath.Join(r.WorkTree, filepath.FromSlash(path)))
+	return err == nil
+}
+
+// worktreeModified compares an index entry with the working tree file. Like
+// git, it trusts matching size and modification time unless the file changed
+// after the index was written.
+func (r *Repository) worktreeModified(entry indexEntry, indexModTime int64) (bool, bool, error) {
+	if entry.assumeValid || entry.skipWorktree || entry.mode&modeTypeMask == modeGitlink {
+		return false, false, nil
+	}
+
+	path := filepath.Join(r.WorkTree, filepath.FromSlash(entry.path))
+	stat, err := os.Lstat(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return true, true, nil
+	}
+	if err != nil {
+		return false, false, err
+	}
+
+	if worktreeMode(stat) != entry.mode {
+		return true, false, nil
+	}
+	if uint32(stat.Size()) != entry.size { // #nosec G115 -- the index stores the size truncated to 32 bits
+		return true, false, nil
+	}
+
+	modTime := stat.ModTime()
+	if uint32(modTime.Unix()) == entry.mtimeSeconds && uint32(modTime.Nanosecond()) == entry.mtimeNanos && modTime.UnixNano() < indexModTime { // #nosec G115 -- the index stores 32-bit timestamps
+		return false, false, nil
+	}
+
+	content, err := readWorktreeFile(path, stat)
+	if err != nil {
+		return false, false, err
+	}
+	return hashObject(objectBlob, content) != entry.id, false, nil
+}
+
+// worktreeMode maps a file to the mode git would stage for it.
+func worktreeMode(stat fs.FileInfo) uint32 {
+	switch {
+	case stat.Mode()&fs.ModeSymlink != 0:
+		return modeSymlink
+	case stat.IsDir():
+		return modeTree
+	case stat.Mode().Perm()&0o111 != 0:
+		return modeExecutable
+	default:
+		return modeRegular
+	}
+}
+
+// readWorktreeFile returns what git would hash for a path: the file content,
+// or the link target for symlinks.
+func readWorktreeFile(path string, stat fs.FileInfo) ([]byte, error) {
+	if stat.Mode()&fs.ModeSymlink != 0 {
+		target, err := os.Readlink(path)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(filepath.ToSlash(target)), nil
+	}
+	if !stat.Mode().IsRegular() {
+		return nil, nil
+	}
+	return os.ReadFile(path) // #nosec G304 -- path is a tracked file in the working tree
+}
+
+func hashObject(typ string, content []byte) string {
+	h := sha1.New() // #nosec G401 -- git object IDs are SHA-1
+	_, _ = fmt.Fprintf(h, "%s %d\x00", typ, len(content))
+	_, _ = h.Write(content)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func hasAnyPrefix(value string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(value, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// commitTree lists every blob and gitlink in a commit's tree by path.
+func (r *Repository) commitTree(commit string) (map[string]treeEntry, error) {
+	typ, data, err := r.objects.read(commit)
+	if err != nil {
+		return nil, fmt.Errorf("read commit %s: %w", commit, err)
+	}
+	if typ != objectCommit {
+		return nil, fmt.Errorf("object %s is a %s, not a commit", commit, typ)
+	}
+
+	tree, ok := strings.CutPrefix(string(data), "tree ")
+	if !ok || len(tree) < 40 || !isObjectID(tree[:40]) {
+		return nil, fmt.Errorf("commit %s has no tree", commit)
+	}
+
+	entries := map[string]treeEntry{}
+	if err := r.walkTree(tree[:40], "", entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (r *Repository) walkTree(id, prefix string, entries map[string]treeEntry) error {
+	typ, data, err := r.objects.read(id)
+	if err != nil {
+		return fmt.Errorf("read tree %s: %w", id, err)
+	}
+	if typ != objectTree {
+		return fmt.Errorf("object %s is a %s, not a tree", id, typ)
+	}
+
+	for len(data) > 0 {
+		header, rest, ok := bytes.Cut(data, []byte{0})
+		if !ok || len(rest) < 20 {
+			return fmt.Errorf("invalid tree %s", id)
+		}
+		modeText, name, ok := bytes.Cut(header, []byte{' '})
+		if !ok {
+			return fmt.Errorf("invalid tree %s", id)
+		}
+		mode, err := strconv.ParseUint(string(modeText), 8, 32)
+		if err != nil {
+			return fmt.Errorf("invalid tree %s: %w", id, err)
+		}
+
+		entryID := hex.EncodeToString(rest[:20])
+		path := prefix + string(name)
+		data = rest[20:]
+
+		if mode&modeTypeMask == modeTree {
+			if err := r.walkTree(entryID, path+"/", entries); err != nil {
+				return err
+			}
+			continue
+		}
+		entries[path] = treeEntry{id: entryID, mode: uint32(mode)}
+	}
+
+	return nil
+}
+
+// readIndex parses a version 2, 3 or 4 index file.
+func readIndex(path string) ([]indexEntry, error) {
+	content, err := os.ReadFile(path) // #nosec G304 -- path is inside the git directory
+	if err != nil {
+		return nil, err
+	}
+
+	if len(content) < 12 || string(content[:4]) != "DIRC" {
+		return nil, errors.New("invalid index signature")
+	}
+	version := binary.BigEndian.Uint32(content[4:8])
+	if version < 2 || version > 4 {
+		return nil, fmt.Errorf("unsupported index version %d", version)
+	}
+	count := binary.BigEndian.Uint32(content[8:12])
+
+	entries := make([]indexEntry, 0, min(int(count), len(content)/62))
+	pos := 12
+	previous := ""
+	for range count {
+		start := pos
+		if pos+62 > len(content) {
+			return nil, errors.New("truncated index")
+		}
+
+		entry := indexEntry{
+			mtimeSeconds: binary.BigEndian.Uint32(content[pos+8:]),
+			mtimeNanos:   binary.BigEndian.Uint32(content[pos+12:]),
+			mode:         binary.BigEndian.Uint32(content[pos+24:]),
+			size:         binary.BigEndian.Uint32(content[pos+36:]),
+			id:           hex.EncodeToString(content[pos+40 : pos+60]),
+		}
+		flags := binary.BigEndian.Uint16(content[pos+60:])
+		entry.assumeValid = flags&indexFlagAssumeValid != 0
+		entry.stage = int(flags>>12) & 3
+		pos += 62
+
+		if version >= 3 && flags&indexFlagExtended != 0 {
+			if pos+2 > len(content) {
+				return nil, errors.New("truncated index")
+			}
+			entry.skipWorktree = binary.BigEndian.Uint16(content[pos:])&indexFlagSkipWorktree != 0
+			pos += 2
+		}
+
+		if version == 4 {
+			strip, n := readOffsetVarint(content[pos:])
+			if n == 0 || strip > len(previous) {
+				return nil, errors.New("invalid index path prefix")
+			}
+			pos += n
+			end := bytes.IndexByte(content[pos:], 0)
+			if end < 0 {
+				return nil, errors.New("truncated index")
+			}
+			entry.path = previous[:len(previous)-strip] + string(content[pos:pos+end])
+			pos += end + 1
+		} else {
+			end := bytes.IndexByte(content[pos:], 0)
+			if end < 0 {
+				return nil, errors.New("truncated index")
+			}
+			entry.path = string(content[pos : pos+end])
+			// Entries are NUL padded to a multiple of eight bytes.
+			pos = start + (pos+end-start+8)&^7
+		}
+
+		previous = entry.path
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// readOffsetVarint decodes git's offset varint, used for OFS_DELTA bases and
+// index v4 path prefixes. It returns the value and the number of bytes read,
+// or zero bytes when the input is truncated.
+func readOffsetVarint(data []byte) (int, int) {
+	if len(data) == 0 {
+		return 0, 0
+	}
+	c := data[0]
+	value := int(c & 0x7f)
+	n := 1
+	for c&0x80 != 0 {
+		if n >= len(data) || n > 8 {
+			return 0, 0
+		}
+		c = data[n]
+		n++
+		value = (value+1)<<7 | int(c&0x7f)
+	}
+	return value, n
+}
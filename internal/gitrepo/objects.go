@@ -0,0 +1,406 @@
+package gitrepo
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// Object types as named in loose object headers.
+const (
+	objectCommit = "commit"
+	objectTree   = "tree"
+	objectBlob   = "blob"
+	objectTag    = "tag"
+)
+
+// Pack object type codes.
+const (
+	packCommit   = 1
+	packTree     = 2
+	packBlob     = 3
+	packTag      = 4
+	packOfsDelta = 6
+	packRefDelta = 7
+)
+
+// maxObjectSize guards allocations against corrupt size headers.
+const maxObjectSize = 1 << 30
+
+// errObjectNotFound is returned when an object is in neither loose storage nor
+// any pack.
+var errObjectNotFound = errors.New("object not found")
+
+// objectStore reads objects from a repository's objects directory.
+type objectStore struct {
+	err   error
+	dir   string
+	packs []*packIndex
+	once  sync.Once
+}
+
+// packIndex is a parsed version 2 pack index (.idx) file.
+type packIndex struct {
+	packPath     string
+	ids          []byte
+	offsets      []byte
+	largeOffsets []byte
+	fanout       [256]uint32
+}
+
+func (s *objectStore) read(id string) (string, []byte, error) {
+	if !isObjectID(id) {
+		return "", nil, fmt.Errorf("invalid object ID %q", id)
+	}
+
+	typ, data, err := s.readLoose(id)
+	if !errors.Is(err, fs.ErrNotExist) {
+		return typ, data, err
+	}
+
+	s.once.Do(func() { s.packs, s.err = loadPackIndexes(filepath.Join(s.dir, "pack")) })
+	if s.err != nil {
+		return "", nil, s.err
+	}
+
+	raw, err := hex.DecodeString(id)
+	if err != nil {
+		return "", nil, err
+	}
+	for _, pack := range s.packs {
+		if offset, ok := pack.find(raw); ok {
+			return s.readPacked(pack.packPath, offset)
+		}
+	}
+
+	return "", nil, fmt.Errorf("%w: %s", errObjectNotFound, id)
+}
+
+func (s *objectStore) readLoose(id string) (string, []byte, error) {
+	f, err := os.Open(filepath.Join(s.dir, id[:2], id[2:])) // #nosec G304 -- path is built from a validated object ID
+	if err != nil {
+		return "", nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	zr, err := zlib.NewReader(f)
+	if err != nil {
+		return "", nil, fmt.Errorf("read object %s: %w", id, err)
+	}
+	defer func() { _ = zr.Close() }()
+
+	content, err := io.ReadAll(io.LimitReader(zr, maxObjectSize))
+	if err != nil {
+		return "", nil, fmt.Errorf("read object %s: %w", id, err)
+	}
+
+	header, data, ok := bytes.Cut(content, []byte{0})
+	if !ok {
+		return "", nil, fmt.Errorf("read object %s: missing header", id)
+	}
+	typ, size, ok := bytes.Cut(header, []byte{' '})
+	if !ok {
+		return "", nil, fmt.Errorf("read object %s: invalid header %q", id, header)
+	}
+	if n, err := strconv.Atoi(string(size)); err != nil || n != len(data) {
+		return "", nil, fmt.Errorf("read object %s: size mismatch", id)
+	}
+
+	return string(typ), data, nil
+}
+
+func (s *objectStore) readPacked(packPath string, offset int64) (string, []byte, error) {
+	f, err := os.Open(packPath) // #nosec G304 -- pack paths come from listing the repository's pack directory
+	if err != nil {
+		return "", nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	code, data, err := s.readPackEntry(f, offset, 0)
+	if err != nil {
+		return "", nil, fmt.Errorf("read %s at offset %d: %w", filepath.Base(packPath), offset, err)
+	}
+
+	switch code {
+	case packCommit:
+		return objectCommit, data, nil
+	case packTree:
+		return objectTree, data, nil
+	case packBlob:
+		return objectBlob, data, nil
+	case packTag:
+		return objectTag, data, nil
+	default:
+		return "", nil, fmt.Errorf("unexpected object type %d", code)
+	}
+}
+
+// readPackEntry reads and, for deltas, reconstructs the object at offset. It
+// returns the pack type code of the resolved base object.
+func (s *objectStore) readPackEntry(f *os.File, offset int64, depth int) (int, []byte, error) {
+	if depth > 50 {
+		return 0, nil, errors.New("delta chain too deep")
+	}
+
+	header := make([]byte, 64)
+	n, err := f.ReadAt(header, offset)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return 0, nil, err
+	}
+	header = header[:n]
+	if len(header) == 0 {
+		return 0, nil, io.ErrUnexpectedEOF
+	}
+
+	c := header[0]
+	code := int(c>>4) & 7
+	size := int64(c & 0x0f)
+	shift := uint(4)
+	pos := 1
+	for c&0x80 != 0 {
+		if pos >= len(header) || shift > 56 {
+			return 0, nil, errors.New("invalid object header")
+		}
+		c = header[pos]
+		size |= int64(c&0x7f) << shift
+		shift += 7
+		pos++
+	}
+	if size > maxObjectSize {
+		return 0, nil, fmt.Errorf("object too large: %d bytes", size)
+	}
+
+	var baseOffset int64
+	var baseID string
+	switch code {
+	case packOfsDelta:
+		delta, n := readOffsetVarint(header[pos:])
+		if n == 0 {
+			return 0, nil, io.ErrUnexpectedEOF
+		}
+		pos += n
+		baseOffset = offset - int64(delta)
+		if baseOffset <= 0 {
+			return 0, nil, errors.New("invalid delta base offset")
+		}
+	case packRefDelta:
+		if pos+20 > len(header) {
+			return 0, nil, io.ErrUnexpectedEOF
+		}
+		baseID = hex.EncodeToString(header[pos : pos+20])
+		pos += 20
+	}
+
+	zr, err := zlib.NewReader(io.NewSectionReader(f, offset+int64(pos), 1<<62))
+	if err != nil {
+		return 0, nil, err
+	}
+	defer func() { _ = zr.Close() }()
+
+	data := make([]byte, size)
+	if _, err := io.ReadFull(zr, data); err != nil {
+		return 0, nil, err
+	}
+
+	switch code {
+	case packOfsDelta:
+		baseCode, base, err := s.readPackEntry(f, baseOffset, depth+1)
+		if err != nil {
+			return 0, nil, err
+		}
+		result, err := applyDelta(base, data)
+		return baseCode, result, err
+	case packRefDelta:
+		typ, base, err := s.read(baseID)
+		if err != nil {
+			return 0, nil, err
+		}
+		result, err := applyDelta(base, data)
+		return packTypeCode(typ), result, err
+	default:
+		return code, data, nil
+	}
+}
+
+func packTypeCode(typ string) int {
+	switch typ {
+	case objectCommit:
+		return packCommit
+	case objectTree:
+		return packTree
+	case objectBlob:
+		return packBlob
+	case objectTag:
+		return packTag
+	default:
+		return 0
+	}
+}
+
+// applyDelta reconstructs an object from its base and a git delta.
+func applyDelta(base, delta []byte) ([]byte, error) {
+	srcSize, delta, err := readDeltaSize(delta)
+	if err != nil {
+		return nil, err
+	}
+	if srcSize != len(base) {
+		return nil, fmt.Errorf("delta base size mismatch: %d != %d", srcSize, len(base))
+	}
+	dstSize, delta, err := readDeltaSize(delta)
+	if err != nil {
+		return nil, err
+	}
+	if dstSize > maxObjectSize {
+		return nil, fmt.Errorf("object too large: %d bytes", dstSize)
+	}
+
+	result := make([]byte, 0, dstSize)
+	for len(delta) > 0 {
+		op := delta[0]
+		delta = delta[1:]
+
+		if op&0x80 == 0 {
+			if op == 0 || int(op) > len(delta) {
+				return nil, errors.New("invalid delta insert")
+			}
+			result = append(result, delta[:op]...)
+			delta = delta[op:]
+			continue
+		}
+
+		var copyOffset, copySize int
+		for i := 0; i < 7; i++ {
+			if op&(1<<uint(i)) == 0 {
+				continue
+			}
+			if len(delta) == 0 {
+				return nil, errors.New("truncated delta copy")
+			}
+			if i < 4 {
+				copyOffset |= int(delta[0]) << (8 * uint(i))
+			} else {
+				copySize |= int(delta[0]) << (8 * uint(i-4))
+			}
+			delta = delta[1:]
+		}
+		if copySize == 0 {
+			copySize = 0x10000
+		}
+		if copyOffset+copySize > len(base) {
+			return nil, errors.New("delta copy out of range")
+		}
+		result = append(result, base[copyOffset:copyOffset+copySize]...)
+	}
+
+	if len(result) != dstSize {
+		return nil, fmt.Errorf("delta result size mismatch: %d != %d", len(result), dstSize)
+	}
+	return result, nil
+}
+
+func readDeltaSize(delta []byte) (int, []byte, error) {
+	size := 0
+	shift := uint(0)
+	for i, c := range delta {
+		size |= int(c&0x7f) << shift
+		if c&0x80 == 0 {
+			return size, delta[i+1:], nil
+		}
+		shift += 7
+		if shift > 56 {
+			break
+		}
+	}
+	return 0, nil, errors.New("invalid delta size")
+}
+
+func loadPackIndexes(dir string) ([]*packIndex, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, "pack-*.idx"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(matches)
+
+	packs := make([]*packIndex, 0, len(matches))
+	for _, path := range matches {
+		pack, err := readPackIndex(path)
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", filepath.Base(path), err)
+		}
+		packs = append(packs, pack)
+	}
+	return packs, nil
+}
+
+func readPackIndex(path string) (*packIndex, error) {
+	content, err := os.ReadFile(path) // #nosec G304 -- index paths come from listing the repository's pack directory
+	if err != nil {
+		return nil, err
+	}
+
+	if len(content) < 8+256*4 || !bytes.Equal(content[:4], []byte{0xff, 't', 'O', 'c'}) {
+		return nil, errors.New("unsupported pack index format")
+	}
+	if version := binary.BigEndian.Uint32(content[4:8]); version != 2 {
+		return nil, fmt.Errorf("unsupported pack index version %d", version)
+	}
+
+	pack := &packIndex{packPath: path[:len(path)-len(".idx")] + ".pack"}
+	for i := range pack.fanout {
+		pack.fanout[i] = binary.BigEndian.Uint32(content[8+4*i:])
+	}
+
+	count := int(pack.fanout[255])
+	idsStart := 8 + 256*4
+	offsetsStart := idsStart + count*20 + count*4
+	largeStart := offsetsStart + count*4
+	if len(content) < largeStart {
+		return nil, errors.New("truncated pack index")
+	}
+
+	pack.ids = content[idsStart : idsStart+count*20]
+	pack.offsets = content[offsetsStart:largeStart]
+	pack.largeOffsets = content[largeStart:]
+	return pack, nil
+}
+
+// find returns the pack offset of the object with the given raw ID.
+func (p *packIndex) find(id []byte) (int64, bool) {
+	lo := 0
+	if id[0] > 0 {
+		lo = int(p.fanout[id[0]-1])
+	}
+	hi := int(p.fanout[id[0]])
+
+	i := lo + sort.Search(hi-lo, func(i int) bool {
+		return bytes.Compare(p.ids[(lo+i)*20:(lo+i+1)*20], id) >= 0
+	})
+	if i >= hi || !bytes.Equal(p.ids[i*20:(i+1)*20], id) {
+		return 0, false
+	}
+
+	offset := binary.BigEndian.Uint32(p.offsets[i*4:])
+	if offset&0x80000000 == 0 {
+		return int64(offset), true
+	}
+
+	large := int(offset&0x7fffffff) * 8
+	if large+8 > len(p.largeOffsets) {
+		return 0, false
+	}
+	value := binary.BigEndian.Uint64(p.largeOffsets[large:])
+	if value > 1<<62 {
+		return 0, false
+	}
+	return int64(value), true // #nosec G115 -- bounded above
+}
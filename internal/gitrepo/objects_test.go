@@ -0,0 +1,174 @@
+package gitrepo
+
+import (
+	"bytes"
+	"compress/zlib"
+	"crypto/sha1" // #nosec G505 -- git object IDs are SHA-1
+	"encoding/binary"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+)
+
+func TestApplyDelta(t *testing.T) {
+	t.Parallel()
+
+	base := []byte("hello, world\n")
+	delta := []byte{
+		byte(len(base)), 15,
+		0x91, 0, 7, // copy 7 bytes from offset 0
+		3, 'G', 'o', '!',
+		0x91, 12, 1, // copy the trailing newline
+		4, ' ', ':', ')', '\n',
+	}
+
+	got, err := applyDelta(base, delta)
+	if err != nil {
+		t.Fatalf("applyDelta() error = %v", err)
+	}
+	if string(got) != "hello, Go!\n :)\n" {
+		t.Fatalf("applyDelta() = %q", got)
+	}
+
+	if _, err := applyDelta(base, []byte{byte(len(base)), 5, 0x91, 10, 5}); err == nil {
+		t.Fatal("applyDelta() out of range copy error = nil")
+	}
+	if _, err := applyDelta([]byte("x"), delta); err == nil {
+		t.Fatal("applyDelta() base size mismatch error = nil")
+	}
+}
+
+func TestReadOffsetVarint(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		input []byte
+		value int
+		n     int
+	}{
+		{input: []byte{0x05}, value: 5, n: 1},
+		{input: []byte{0x80, 0x00}, value: 128, n: 2},
+		{input: []byte{0x81, 0x7f}, value: 383, n: 2},
+		{input: []byte{0x80}, value: 0, n: 0},
+		{input: nil, value: 0, n: 0},
+	}
+	for _, tc := range cases {
+		value, n := readOffsetVarint(tc.input)
+		if value != tc.value || n != tc.n {
+			t.Fatalf("readOffsetVarint(%v) = %d, %d, want %d, %d", tc.input, value, n, tc.value, tc.n)
+		}
+	}
+}
+
+func TestObjectStore_Pack(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	base := []byte("the quick brown fox\n")
+	baseID := hashObject(objectBlob, base)
+	target := []byte("the quick red fox\n")
+	targetID := hashObject(objectBlob, target)
+
+	var pack bytes.Buffer
+	pack.WriteString("PACK")
+	_ = binary.Write(&pack, binary.BigEndian, uint32(2))
+	_ = binary.Write(&pack, binary.BigEndian, uint32(2))
+
+	baseOffset := pack.Len()
+	writePackObject(t, &pack, packBlob, base, nil)
+
+	deltaOffset := pack.Len()
+	delta := []byte{
+		byte(len(base)), byte(len(target)),
+		0x90, 10, // copy "the quick "
+		3, 'r', 'e', 'd',
+		0x91, 15, 5, // copy " fox\n"
+	}
+	// OFS_DELTA bases are encoded as a distance back from this object.
+	distance := deltaOffset - baseOffset
+	writePackObject(t, &pack, packOfsDelta, delta, []byte{byte(distance)})
+
+	packDir := filepath.Join(dir, "pack")
+	if err := os.MkdirAll(packDir, 0o750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(packDir, "pack-test.pack"), pack.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	writePackIndex(t, filepath.Join(packDir, "pack-test.idx"), map[string]int{baseID: baseOffset, targetID: deltaOffset})
+
+	store := &objectStore{dir: dir}
+	for id, want := range map[string][]byte{baseID: base, targetID: target} {
+		typ, data, err := store.read(id)
+		if err != nil {
+			t.Fatalf("read(%s) error = %v", id, err)
+		}
+		if typ != objectBlob || !bytes.Equal(data, want) {
+			t.Fatalf("read(%s) = %s %q, want blob %q", id, typ, data, want)
+		}
+	}
+
+	if _, _, err := store.read(hashObject(objectBlob, []byte("missing"))); err == nil {
+		t.Fatal("read() missing object error = nil")
+	}
+}
+
+func writePackObject(t *testing.T, pack *bytes.Buffer, code int, data []byte, extra []byte) {
+	t.Helper()
+
+	if len(data) >= 16 {
+		pack.WriteByte(byte(0x80 | code<<4 | len(data)&0x0f))
+		pack.WriteByte(byte(len(data) >> 4))
+	} else {
+		pack.WriteByte(byte(code<<4 | len(data)))
+	}
+	pack.Write(extra)
+
+	zw := zlib.NewWriter(pack)
+	_, _ = zw.Write(data)
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func writePackIndex(t *testing.T, path string, offsets map[string]int) {
+	t.Helper()
+
+	ids := make([]string, 0, len(offsets))
+	for id := range offsets {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var idx bytes.Buffer
+	idx.Write([]byte{0xff, 't', 'O', 'c'})
+	_ = binary.Write(&idx, binary.BigEndian, uint32(2))
+	for i := 0; i < 256; i++ {
+		count := 0
+		for _, id := range ids {
+			raw, _ := hex.DecodeString(id)
+			if int(raw[0]) <= i {
+				count++
+			}
+		}
+		_ = binary.Write(&idx, binary.BigEndian, uint32(count)) // #nosec G115 -- test fixture
+	}
+	for _, id := range ids {
+		raw, _ := hex.DecodeString(id)
+		idx.Write(raw)
+	}
+	for range ids {
+		_ = binary.Write(&idx, binary.BigEndian, uint32(0))
+	}
+	for _, id := range ids {
+		_ = binary.Write(&idx, binary.BigEndian, uint32(offsets[id])) // #nosec G115 -- test fixture
+	}
+	sum := sha1.Sum(idx.Bytes()) // #nosec G401 -- index checksum
+	idx.Write(sum[:])
+
+	if err := os.WriteFile(path, idx.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
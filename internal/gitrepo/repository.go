@@ -0,0 +1,457 @@
+// Package gitrepo reads commit, ref and working tree information from a local
+// Git repository without shelling out to a git binary.
+package gitrepo
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrNotRepository is returned when no .git directory is found at or above the
+// given path.
+var ErrNotRepository = errors.New("not a git repository")
+
+// errRefNotFound is returned when a ref is neither a loose nor a packed ref.
+var errRefNotFound = errors.New("ref not found")
+
+// maxSymrefDepth bounds symbolic ref and tag peeling chains.
+const maxSymrefDepth = 10
+
+// Repository is a Git repository on the local filesystem.
+type Repository struct {
+	objects *objectStore
+	// WorkTree is the root of the working tree.
+	WorkTree string
+	// GitDir is the repository's git directory, which holds HEAD and the index.
+	GitDir string
+	// commonDir holds objects and refs. It differs from GitDir for linked
+	// worktrees.
+	commonDir string
+}
+
+// Info describes the commit checked out in a repository.
+type Info struct {
+	Commit        string
+	Branch        string
+	Tag           string
+	AuthorName    string
+	AuthorEmail   string
+	CommitMessage string
+	CommitTime    string
+	Diff          string
+	Dirty         bool
+}
+
+// InfoOptions controls what Repository.Info reads.
+type InfoOptions struct {
+	// IncludeDiff includes a diff of the working tree against the checked out
+	// commit, like "git diff HEAD".
+	IncludeDiff bool
+	// MaxDiffBytes truncates the diff. Zero means no limit.
+	MaxDiffBytes int
+}
+
+// Open finds the repository containing path, walking up parent directories
+// like git does. A .git file pointing elsewhere ("gitdir: ...") is followed.
+func Open(path string) (*Repository, error) {
+	dir, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		candidate := filepath.Join(dir, ".git")
+		info, err := os.Stat(candidate)
+		if err == nil {
+			gitDir := candidate
+			if !info.IsDir() {
+				gitDir, err = readGitFile(candidate)
+				if err != nil {
+					return nil, err
+				}
+			}
+			return openGitDir(dir, gitDir)
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return nil, fmt.Errorf("%w: %s", ErrNotRepository, path)
+		}
+		dir = parent
+	}
+}
+
+func readGitFile(path string) (string, error) {
+	content, err := os.ReadFile(path) // #nosec G304 -- reading the repository's .git file is the purpose of this package
+	if err != nil {
+		return "", err
+	}
+
+	line := strings.TrimSpace(string(content))
+	gitDir, ok := strings.CutPrefix(line, "gitdir:")
+	if !ok {
+		return "", fmt.Errorf("invalid .git file %s", path)
+	}
+
+	gitDir = strings.TrimSpace(gitDir)
+	if !filepath.IsAbs(gitDir) {
+		gitDir = filepath.Join(filepath.Dir(path), gitDir)
+	}
+	return filepath.Clean(gitDir), nil
+}
+
+func openGitDir(workTree, gitDir string) (*Repository, error) {
+	commonDir := gitDir
+	if content, err := os.ReadFile(filepath.Join(gitDir, "commondir")); err == nil { // #nosec G304 -- path is inside the git directory
+		commonDir = strings.TrimSpace(string(content))
+		if !filepath.IsAbs(commonDir) {
+			commonDir = filepath.Join(gitDir, commonDir)
+		}
+		commonDir = filepath.Clean(commonDir)
+	}
+
+	if err := checkObjectFormat(commonDir); err != nil {
+		return nil, err
+	}
+
+	return &Repository{
+		WorkTree:  workTree,
+		GitDir:    gitDir,
+		commonDir: commonDir,
+		objects:   &objectStore{dir: filepath.Join(commonDir, "objects")},
+	}, nil
+}
+
+// checkObjectFormat rejects SHA-256 repositories, which use a different
+// object ID length.
+func checkObjectFormat(commonDir string) error {
+	content, err := os.ReadFile(filepath.Join(commonDir, "config")) // #nosec G304 -- path is inside the git directory
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+
+	for _, line := range strings.Split(string(content), "\n") {
+		key, value, ok := strings.Cut(line, "=")
+		if ok && strings.EqualFold(strings.TrimSpace(key), "objectformat") && strings.TrimSpace(value) != "sha1" {
+			return fmt.Errorf("unsupported object format %q", strings.TrimSpace(value))
+		}
+	}
+	return nil
+}
+
+// Info reads the checked out commit, branch, tag and working tree state.
+// Fields that do not apply, such as the branch of a detached HEAD, are empty.
+func (r *Repository) Info(opts InfoOptions) (*Info, error) {
+	info := &Info{}
+
+	head, err := r.readRefFile(r.GitDir, "HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("read HEAD: %w", err)
+	}
+	if target, ok := strings.CutPrefix(head, "ref: "); ok {
+		info.Branch = strings.TrimPrefix(target, "refs/heads/")
+		info.Commit, err = r.ResolveRef(target)
+		if errors.Is(err, errRefNotFound) {
+			// Unborn branch: no commit yet.
+			info.Commit, err = "", nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		info.Commit = head
+	}
+
+	if info.Commit != "" {
+		if err := r.readCommitInfo(info); err != nil {
+			return nil, err
+		}
+		if info.Tag, err = r.tagAt(info.Commit); err != nil {
+			return nil, err
+		}
+	}
+
+	changes, err := r.worktreeChanges(info.Commit)
+	if err != nil {
+		return nil, err
+	}
+	info.Dirty = len(changes) > 0
+
+	if opts.IncludeDiff && len(changes) > 0 {
+		if info.Diff, err = r.diff(changes, opts.MaxDiffBytes); err != nil {
+			return nil, err
+		}
+	}
+
+	return info, nil
+}
+
+// ResolveRef resolves a ref name such as HEAD or refs/heads/main to a commit ID.
+func (r *Repository) ResolveRef(name string) (string, error) {
+	for range maxSymrefDepth {
+		dir := r.commonDir
+		if name == "HEAD" {
+			dir = r.GitDir
+		}
+
+		value, err := r.readRefFile(dir, name)
+		if errors.Is(err, fs.ErrNotExist) {
+			refs, err := r.packedRefs()
+			if err != nil {
+				return "", err
+			}
+			if ref, ok := refs[name]; ok {
+				return ref.id, nil
+			}
+			return "", fmt.Errorf("%w: %s", errRefNotFound, name)
+		}
+		if err != nil {
+			return "", err
+		}
+
+		target, ok := strings.CutPrefix(value, "ref: ")
+		if !ok {
+			return value, nil
+		}
+		name = target
+	}
+
+	return "", fmt.Errorf("too many levels of symbolic refs resolving %s", name)
+}
+
+func (r *Repository) readRefFile(dir, name string) (string, error) {
+	content, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name))) // #nosec G304 -- ref names are read from the repository itself
+	if err != nil {
+		return "", err
+	}
+
+	value := strings.TrimSpace(string(content))
+	if !strings.HasPrefix(value, "ref: ") && !isObjectID(value) {
+		return "", fmt.Errorf("invalid ref %s: %q", name, value)
+	}
+	return value, nil
+}
+
+type packedRef struct {
+	id string
+	// peeled is the commit an annotated tag points to, when recorded.
+	peeled string
+}
+
+func (r *Repository) packedRefs() (map[string]packedRef, error) {
+	refs := map[string]packedRef{}
+
+	f, err := os.Open(filepath.Join(r.commonDir, "packed-refs")) // #nosec G304 -- path is inside the git directory
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return refs, nil
+		}
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	last := ""
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "" || strings.HasPrefix(line, "#"):
+		case strings.HasPrefix(line, "^"):
+			if ref, ok := refs[last]; ok {
+				ref.peeled = strings.TrimPrefix(line, "^")
+				refs[last] = ref
+			}
+		default:
+			id, name, ok := strings.Cut(line, " ")
+			if !ok || !isObjectID(id) {
+				return nil, fmt.Errorf("invalid packed-refs line %q", line)
+			}
+			refs[name] = packedRef{id: id}
+			last = name
+		}
+	}
+
+	return refs, scanner.Err()
+}
+
+// tagAt returns the alphabetically first tag pointing at commit, or an empty
+// string when there is none.
+func (r *Repository) tagAt(commit string) (string, error) {
+	refs, err := r.packedRefs()
+	if err != nil {
+		return "", err
+	}
+
+	tags := map[string]packedRef{}
+	for name, ref := range refs {
+		if tag, ok := strings.CutPrefix(name, "refs/tags/"); ok {
+			tags[tag] = ref
+		}
+	}
+
+	tagsDir := filepath.Join(r.commonDir, "refs", "tags")
+	err = filepath.WalkDir(tagsDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(tagsDir, path)
+		if err != nil {
+			return err
+		}
+		id, err := r.ResolveRef("refs/tags/" + filepath.ToSlash(rel))
+		if err != nil {
+			return err
+		}
+		tags[filepath.ToSlash(rel)] = packedRef{id: id}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var matches []string
+	for name, ref := range tags {
+		target := ref.peeled
+		if target == "" {
+			if target, err = r.peelTag(ref.id); err != nil {
+				return "", err
+			}
+		}
+		if target == commit {
+			matches = append(matches, name)
+		}
+	}
+	if len(matches) == 0 {
+		return "", nil
+	}
+
+	sort.Strings(matches)
+	return matches[0], nil
+}
+
+// peelTag follows annotated tag objects to the object they point to.
+func (r *Repository) peelTag(id string) (string, error) {
+	for range maxSymrefDepth {
+		if id == "" {
+			return "", nil
+		}
+
+		typ, data, err := r.objects.read(id)
+		if err != nil {
+			return "", err
+		}
+		if typ != objectTag {
+			return id, nil
+		}
+
+		id = ""
+		for _, line := range strings.Split(string(data), "\n") {
+			if line == "" {
+				break
+			}
+			if target, ok := strings.CutPrefix(line, "object "); ok {
+				id = target
+				break
+			}
+		}
+	}
+
+	return "", fmt.Errorf("too many levels of tags peeling %s", id)
+}
+
+func (r *Repository) readCommitInfo(info *Info) error {
+	typ, data, err := r.objects.read(info.Commit)
+	if err != nil {
+		return fmt.Errorf("read commit %s: %w", info.Commit, err)
+	}
+	if typ != objectCommit {
+		return fmt.Errorf("object %s is a %s, not a commit", info.Commit, typ)
+	}
+
+	headers, message, _ := strings.Cut(string(data), "\n\n")
+	for _, line := range strings.Split(headers, "\n") {
+		if author, ok := strings.CutPrefix(line, "author "); ok {
+			info.AuthorName, info.AuthorEmail, _ = parseSignature(author)
+		}
+		if committer, ok := strings.CutPrefix(line, "committer "); ok {
+			_, _, when := parseSignature(committer)
+			if !when.IsZero() {
+				info.CommitTime = when.Format(time.RFC3339)
+			}
+		}
+	}
+	info.CommitMessage = strings.TrimSpace(message)
+
+	return nil
+}
+
+// parseSignature parses an author or committer line of the form
+// "Name <email> 1700000000 +0100".
+func parseSignature(value string) (string, string, time.Time) {
+	open := strings.Index(value, "<")
+	closing := strings.LastIndex(value, ">")
+	if open < 0 || closing < open {
+		return strings.TrimSpace(value), "", time.Time{}
+	}
+
+	name := strings.TrimSpace(value[:open])
+	email := value[open+1 : closing]
+
+	fields := strings.Fields(value[closing+1:])
+	if len(fields) != 2 {
+		return name, email, time.Time{}
+	}
+
+	seconds, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return name, email, time.Time{}
+	}
+
+	zone := fields[1]
+	offset := 0
+	if len(zone) == 5 {
+		hours, errH := strconv.Atoi(zone[1:3])
+		minutes, errM := strconv.Atoi(zone[3:5])
+		if errH == nil && errM == nil {
+			offset = hours*3600 + minutes*60
+			if zone[0] == '-' {
+				offset = -offset
+			}
+		}
+	}
+
+	return name, email, time.Unix(seconds, 0).In(time.FixedZone(zone, offset))
+}
+
+func isObjectID(value string) bool {
+	if len(value) != 40 {
+		return false
+	}
+	for _, c := range value {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
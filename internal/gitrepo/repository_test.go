@@ -0,0 +1,324 @@
+package gitrepo
+
+import (
+	"bytes"
+	"compress/zlib"
+	"crypto/sha1" // #nosec G505 -- git object IDs are SHA-1
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testRepo builds a minimal repository on disk without a git binary.
+type testRepo struct {
+	t        *testing.T
+	workTree string
+	gitDir   string
+}
+
+func newTestRepo(t *testing.T) *testRepo {
+	t.Helper()
+
+	workTree := t.TempDir()
+	gitDir := filepath.Join(workTree, ".git")
+	for _, dir := range []string{"objects", "refs/heads", "refs/tags"} {
+		if err := os.MkdirAll(filepath.Join(gitDir, dir), 0o750); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	repo := &testRepo{t: t, workTree: workTree, gitDir: gitDir}
+	repo.writeGitFile("HEAD", "ref: refs/heads/main\n")
+	return repo
+}
+
+func (r *testRepo) writeGitFile(name, content string) {
+	r.t.Helper()
+	path := filepath.Join(r.gitDir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		r.t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		r.t.Fatal(err)
+	}
+}
+
+func (r *testRepo) writeWorktreeFile(name, content string) {
+	r.t.Helper()
+	if err := os.WriteFile(filepath.Join(r.workTree, name), []byte(content), 0o600); err != nil {
+		r.t.Fatal(err)
+	}
+}
+
+func (r *testRepo) writeObject(typ string, content []byte) string {
+	r.t.Helper()
+
+	id := hashObject(typ, content)
+	var buf bytes.Buffer
+	zw := zlib.NewWriter(&buf)
+	_, _ = fmt.Fprintf(zw, "%s %d\x00", typ, len(content))
+	_, _ = zw.Write(content)
+	if err := zw.Close(); err != nil {
+		r.t.Fatal(err)
+	}
+	r.writeGitFile("objects/"+id[:2]+"/"+id[2:], buf.String())
+	return id
+}
+
+// commit writes files as a flat tree, commits them and stages them in the
+// index, then checks them out into the working tree.
+func (r *testRepo) commit(files map[string]string, message string, parent string) string {
+	r.t.Helper()
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	// Tree entries and index entries are both sorted by name.
+	sort.Strings(names)
+
+	var tree bytes.Buffer
+	ids := map[string]string{}
+	for _, name := range names {
+		ids[name] = r.writeObject(objectBlob, []byte(files[name]))
+		raw, _ := hex.DecodeString(ids[name])
+		fmt.Fprintf(&tree, "100644 %s\x00", name)
+		tree.Write(raw)
+	}
+	treeID := r.writeObject(objectTree, tree.Bytes())
+
+	var body strings.Builder
+	fmt.Fprintf(&body, "tree %s\n", treeID)
+	if parent != "" {
+		fmt.Fprintf(&body, "parent %s\n", parent)
+	}
+	body.WriteString("author Ada Lovelace <ada@example.com> 1700000000 +0100\n")
+	body.WriteString("committer Grace Hopper <grace@example.com> 1700003600 -0230\n")
+	fmt.Fprintf(&body, "\n%s\n", message)
+	commitID := r.writeObject(objectCommit, []byte(body.String()))
+
+	for _, name := range names {
+		r.writeWorktreeFile(name, files[name])
+	}
+	r.writeIndex(names, ids)
+	return commitID
+}
+
+func (r *testRepo) writeIndex(names []string, ids map[string]string) {
+	r.t.Helper()
+
+	var buf bytes.Buffer
+	buf.WriteString("DIRC")
+	_ = binary.Write(&buf, binary.BigEndian, uint32(2))
+	_ = binary.Write(&buf, binary.BigEndian, uint32(len(names)))
+	for _, name := range names {
+		stat, err := os.Stat(filepath.Join(r.workTree, name))
+		if err != nil {
+			r.t.Fatal(err)
+		}
+		start := buf.Len()
+		fields := []uint32{
+			0, 0,
+			uint32(stat.ModTime().Unix()), uint32(stat.ModTime().Nanosecond()), // #nosec G115 -- test fixture
+			0, 0, modeRegular, 0, 0,
+			uint32(stat.Size()), // #nosec G115 -- test fixture
+		}
+		for _, field := range fields {
+			_ = binary.Write(&buf, binary.BigEndian, field)
+		}
+		raw, _ := hex.DecodeString(ids[name])
+		buf.Write(raw)
+		_ = binary.Write(&buf, binary.BigEndian, uint16(len(name))) // #nosec G115 -- test fixture
+		buf.WriteString(name)
+		buf.WriteByte(0)
+		for (buf.Len()-start)%8 != 0 {
+			buf.WriteByte(0)
+		}
+	}
+	sum := sha1.Sum(buf.Bytes()) // #nosec G401 -- index checksum
+	buf.Write(sum[:])
+
+	r.writeGitFile("index", buf.String())
+	// Make the index newer than the files so stat data is trusted.
+	future := time.Now().Add(time.Minute)
+	if err := os.Chtimes(filepath.Join(r.gitDir, "index"), future, future); err != nil {
+		r.t.Fatal(err)
+	}
+}
+
+func openTestRepo(t *testing.T, path string) *Repository {
+	t.Helper()
+	repo, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	return repo
+}
+
+func TestRepositoryInfo_Clean(t *testing.T) {
+	t.Parallel()
+
+	fixture := newTestRepo(t)
+	first := fixture.commit(map[string]string{"a.txt": "one\n"}, "First", "")
+	second := fixture.commit(map[string]string{"a.txt": "one\ntwo\n", "b.txt": "bee\n"}, "Second commit\n\nWith a body.", first)
+	fixture.writeGitFile("refs/heads/main", second+"\n")
+	fixture.writeGitFile("refs/tags/v0.1.0", first+"\n")
+
+	annotated := fixture.writeObject(objectTag, []byte(fmt.Sprintf("object %s\ntype commit\ntag v1.0.0\ntagger Ada Lovelace <ada@example.com> 1700000000 +0000\n\nRelease\n", second)))
+	fixture.writeGitFile("packed-refs", fmt.Sprintf("# pack-refs with: peeled fully-peeled sorted\n%s refs/tags/v1.0.0\n^%s\n", annotated, second))
+	fixture.writeGitFile("refs/tags/z-last", second+"\n")
+
+	if err := os.MkdirAll(filepath.Join(fixture.workTree, "sub", "dir"), 0o750); err != nil {
+		t.Fatal(err)
+	}
+	repo := openTestRepo(t, filepath.Join(fixture.workTree, "sub", "dir"))
+	if repo.WorkTree != fixture.workTree {
+		t.Fatalf("WorkTree = %q, want %q", repo.WorkTree, fixture.workTree)
+	}
+
+	info, err := repo.Info(InfoOptions{IncludeDiff: true})
+	if err != nil {
+		t.Fatalf("Info() error = %v", err)
+	}
+
+	want := Info{
+		Commit:        second,
+		Branch:        "main",
+		Tag:           "v1.0.0",
+		AuthorName:    "Ada Lovelace",
+		AuthorEmail:   "ada@example.com",
+		CommitMessage: "Second commit\n\nWith a body.",
+		CommitTime:    "2023-11-14T20:43:20-02:30",
+	}
+	if *info != want {
+		t.Fatalf("Info() = %+v, want %+v", *info, want)
+	}
+}
+
+func TestRepositoryInfo_Dirty(t *testing.T) {
+	t.Parallel()
+
+	fixture := newTestRepo(t)
+	commit := fixture.commit(map[string]string{
+		"a.txt": "1\n2\n3\n4\n5\n6\n7\n8\n",
+		"b.txt": "gone\n",
+		"c.txt": "same\n",
+	}, "Initial", "")
+	fixture.writeGitFile("refs/heads/main", commit+"\n")
+
+	fixture.writeWorktreeFile("a.txt", "1\n2\n3\n4\nfive\n6\n7\n8\n")
+	if err := os.Remove(filepath.Join(fixture.workTree, "b.txt")); err != nil {
+		t.Fatal(err)
+	}
+	fixture.writeWorktreeFile("untracked.txt", "ignored\n")
+
+	info, err := openTestRepo(t, fixture.workTree).Info(InfoOptions{IncludeDiff: true})
+	if err != nil {
+		t.Fatalf("Info() error = %v", err)
+	}
+	if !info.Dirty {
+		t.Fatal("Dirty = false, want true")
+	}
+
+	wantDiff := `diff --git a/a.txt b/a.txt
+--- a/a.txt
++++ b/a.txt
+@@ -2,7 +2,7 @@
+ 2
+ 3
+ 4
+-5
++five
+ 6
+ 7
+ 8
+diff --git a/b.txt b/b.txt
+deleted file mode 100644
+--- a/b.txt
++++ /dev/null
+@@ -1 +0,0 @@
+-gone
+`
+	if info.Diff != wantDiff {
+		t.Fatalf("Diff =\n%s\nwant\n%s", info.Diff, wantDiff)
+	}
+
+	withoutDiff, err := openTestRepo(t, fixture.workTree).Info(InfoOptions{})
+	if err != nil {
+		t.Fatalf("Info() error = %v", err)
+	}
+	if withoutDiff.Diff != "" || !withoutDiff.Dirty {
+		t.Fatalf("Info() without diff = %+v", *withoutDiff)
+	}
+}
+
+func TestRepositoryInfo_DetachedAndUnborn(t *testing.T) {
+	t.Parallel()
+
+	fixture := newTestRepo(t)
+	info, err := openTestRepo(t, fixture.workTree).Info(InfoOptions{})
+	if err != nil {
+		t.Fatalf("Info() unborn error = %v", err)
+	}
+	if *info != (Info{Branch: "main"}) {
+		t.Fatalf("Info() unborn = %+v", *info)
+	}
+
+	commit := fixture.commit(map[string]string{"a.txt": "a\n"}, "Initial", "")
+	fixture.writeGitFile("HEAD", commit+"\n")
+
+	info, err = openTestRepo(t, fixture.workTree).Info(InfoOptions{})
+	if err != nil {
+		t.Fatalf("Info() detached error = %v", err)
+	}
+	if info.Commit != commit || info.Branch != "" || info.Dirty {
+		t.Fatalf("Info() detached = %+v", *info)
+	}
+}
+
+func TestOpen_GitFile(t *testing.T) {
+	t.Parallel()
+
+	fixture := newTestRepo(t)
+	commit := fixture.commit(map[string]string{"a.txt": "a\n"}, "Initial", "")
+	fixture.writeGitFile("refs/heads/main", commit+"\n")
+
+	linked := t.TempDir()
+	if err := os.WriteFile(filepath.Join(linked, ".git"), []byte("gitdir: "+fixture.gitDir+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	repo := openTestRepo(t, linked)
+	if repo.GitDir != fixture.gitDir || repo.WorkTree != linked {
+		t.Fatalf("Open() = %+v", repo)
+	}
+	commitID, err := repo.ResolveRef("HEAD")
+	if err != nil || commitID != commit {
+		t.Fatalf("ResolveRef(HEAD) = %q, %v", commitID, err)
+	}
+}
+
+func TestOpen_NotRepository(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Open(t.TempDir()); !errors.Is(err, ErrNotRepository) {
+		t.Fatalf("Open() error = %v, want ErrNotRepository", err)
+	}
+}
+
+func TestOpen_SHA256(t *testing.T) {
+	t.Parallel()
+
+	fixture := newTestRepo(t)
+	fixture.writeGitFile("config", "[extensions]\n\tobjectformat = sha256\n")
+	if _, err := Open(fixture.workTree); err == nil || !strings.Contains(err.Error(), "sha256") {
+		t.Fatalf("Open() error = %v, want unsupported object format", err)
+	}
+}
@@ -0,0 +1,484 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ACLBatchResource{}
+var _ resource.ResourceWithValidateConfig = &ACLBatchResource{}
+
+// NewACLBatchResource creates a new ACL batch resource instance.
+func NewACLBatchResource() resource.Resource {
+	return &ACLBatchResource{}
+}
+
+// ACLBatchResource defines the resource implementation.
+type ACLBatchResource struct {
+	client *client.Client
+}
+
+// ACLBatchResourceModel describes the resource data model.
+type ACLBatchResourceModel struct {
+	ACLs types.Set    `tfsdk:"acls"`
+	ID   types.String `tfsdk:"id"`
+}
+
+var aclBatchEntryAttributeTypes = map[string]attr.Type{
+	"object_id":            types.StringType,
+	"object_type":          types.StringType,
+	"user_id":              types.StringType,
+	"group_id":             types.StringType,
+	"role_id":              types.StringType,
+	"permission":           types.StringType,
+	"restrict_object_type": types.StringType,
+}
+
+type aclBatchEntryModel struct {
+	ObjectID           types.String `tfsdk:"object_id"`
+	ObjectType         types.String `tfsdk:"object_type"`
+	UserID             types.String `tfsdk:"user_id"`
+	GroupID            types.String `tfsdk:"group_id"`
+	RoleID             types.String `tfsdk:"role_id"`
+	Permission         types.String `tfsdk:"permission"`
+	RestrictObjectType types.String `tfsdk:"restrict_object_type"`
+}
+
+// aclBatchObjectKey identifies the object an ACL entry is attached to.
+type aclBatchObjectKey struct {
+	ObjectID   string
+	ObjectType string
+}
+
+// Metadata implements resource.Resource.
+func (r *ACLBatchResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acl_batch"
+}
+
+// Schema implements resource.Resource.
+func (r *ACLBatchResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages many Braintrust ACL entries through a single batch request. Only the entries listed in `acls` are created or removed; " +
+			"other ACLs on the same objects are left untouched. Prefer this resource over many `braintrustdata_acl` resources when granting access at scale.\n\n" +
+			"Refreshing lists the ACLs of every distinct object in `acls`, one request per object and page, because the API only lists ACLs per object. " +
+			"Group entries onto few objects, or split very large batches, to keep refreshes fast.\n\n" +
+			"Import is not supported: the ID is a digest of the entries rather than a remote object. " +
+			"To adopt existing ACLs, list them in `acls`; creating an ACL that already exists leaves it unchanged.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of this batch, derived from the entries it was created with.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"acls": schema.SetNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "Set of ACL entries owned by this resource.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"object_id": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The ID of the object to grant access to (e.g., project ID, dataset ID).",
+						},
+						"object_type": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The type of object. Valid values: organization, project, experiment, dataset, prompt, prompt_session, group, role, org_member, project_log, org_project.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(objectACLObjectTypes...),
+							},
+						},
+						"user_id": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The ID of the user to grant access to. Exactly one of user_id or group_id must be specified.",
+						},
+						"group_id": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The ID of the group to grant access to. Exactly one of user_id or group_id must be specified.",
+						},
+						"role_id": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The ID of the role to grant. Exactly one of permission or role_id must be specified.",
+						},
+						"permission": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The permission to grant. Exactly one of permission or role_id must be specified. Valid values: create, read, update, delete, create_acls, read_acls, update_acls, delete_acls.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									"create",
+									"read",
+									"update",
+									"delete",
+									"create_acls",
+									"read_acls",
+									"update_acls",
+									"delete_acls",
+								),
+							},
+						},
+						"restrict_object_type": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "When specified, restricts the ACL to only apply to objects of this type.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(objectACLObjectTypes...),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure implements resource.Resource.
+func (r *ACLBatchResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create implements resource.Resource by adding every entry in one batch request.
+func (r *ACLBatchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ACLBatchResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entries, diags := aclBatchEntriesFromSet(ctx, data.ACLs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(entries) > 0 {
+		if _, err := r.client.BatchUpdateACLs(ctx, entries, nil); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create ACLs, got error: %s", err))
+			return
+		}
+	}
+
+	data.ID = types.StringValue(aclBatchID(entries))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read implements resource.Resource by dropping entries that were removed out-of-band.
+func (r *ACLBatchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ACLBatchResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entries, diags := aclBatchEntriesFromSet(ctx, data.ACLs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// List each object once rather than looking up every entry individually.
+	// The API cannot list ACLs for several objects at once, so this still
+	// costs one request per distinct object.
+	remote := make(map[client.CreateACLRequest]struct{}, len(entries))
+	listed := make(map[aclBatchObjectKey]struct{})
+	for _, entry := range entries {
+		key := aclBatchObjectKey{ObjectID: entry.ObjectID, ObjectType: string(entry.ObjectType)}
+		if _, ok := listed[key]; ok {
+			continue
+		}
+		listed[key] = struct{}{}
+
+		acls, err := listAllObjectACLs(ctx, r.client, key.ObjectID, key.ObjectType)
+		if err != nil {
+			if client.IsNotFound(err) {
+				continue
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list ACLs for %s %s, got error: %s", key.ObjectType, key.ObjectID, err))
+			return
+		}
+		for i := range acls {
+			remote[aclBatchEntryFromACL(&acls[i])] = struct{}{}
+		}
+	}
+
+	present := make([]client.CreateACLRequest, 0, len(entries))
+	for _, entry := range entries {
+		if _, ok := remote[entry]; ok {
+			present = append(present, entry)
+		}
+	}
+
+	aclsSet, diags := aclBatchEntriesToSet(ctx, present)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ACLs = aclsSet
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource by applying only the delta between state and plan in one batch request.
+func (r *ACLBatchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ACLBatchResourceModel
+	var state ACLBatchResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, diags := aclBatchEntriesFromSet(ctx, state.ACLs)
+	resp.Diagnostics.Append(diags...)
+	desired, diags := aclBatchEntriesFromSet(ctx, data.ACLs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	add, remove := diffACLBatchEntries(current, desired)
+	if len(add) > 0 || len(remove) > 0 {
+		if _, err := r.client.BatchUpdateACLs(ctx, add, remove); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update ACLs, got error: %s", err))
+			return
+		}
+	}
+
+	data.ID = state.ID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete implements resource.Resource by removing every owned entry in one batch request.
+func (r *ACLBatchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ACLBatchResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entries, diags := aclBatchEntriesFromSet(ctx, data.ACLs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || len(entries) == 0 {
+		return
+	}
+
+	if _, err := r.client.BatchUpdateACLs(ctx, nil, entries); err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete ACLs, got error: %s", err))
+		return
+	}
+}
+
+// ValidateConfig implements resource.ResourceWithValidateConfig.
+func (r *ACLBatchResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ACLBatchResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateACLBatchConfig(ctx, data.ACLs)...)
+}
+
+// validateACLBatchConfig checks every configured entry whose principal and
+// grant are known. Entries that still depend on values computed during apply
+// are skipped.
+func validateACLBatchConfig(ctx context.Context, acls types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if acls.IsNull() || acls.IsUnknown() {
+		return diags
+	}
+
+	var models []aclBatchEntryModel
+	diags.Append(acls.ElementsAs(ctx, &models, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	entries := make([]client.CreateACLRequest, 0, len(models))
+	for _, model := range models {
+		if model.UserID.IsUnknown() || model.GroupID.IsUnknown() || model.Permission.IsUnknown() || model.RoleID.IsUnknown() {
+			continue
+		}
+		entries = append(entries, client.CreateACLRequest{
+			ObjectID:   model.ObjectID.ValueString(),
+			ObjectType: client.ACLObjectType(model.ObjectType.ValueString()),
+			UserID:     model.UserID.ValueString(),
+			GroupID:    model.GroupID.ValueString(),
+			RoleID:     model.RoleID.ValueString(),
+			Permission: client.Permission(model.Permission.ValueString()),
+		})
+	}
+
+	diags.Append(validateACLBatchEntries(entries)...)
+	return diags
+}
+
+func validateACLBatchEntries(entries []client.CreateACLRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, entry := range entries {
+		binding := objectACLBinding{
+			UserID:     entry.UserID,
+			GroupID:    entry.GroupID,
+			Permission: string(entry.Permission),
+			RoleID:     entry.RoleID,
+		}
+		if err := validateObjectACLBinding(binding); err != nil {
+			diags.AddAttributeError(
+				path.Root("acls"),
+				"Invalid Configuration",
+				fmt.Sprintf("ACL on %s %s: %s", entry.ObjectType, entry.ObjectID, err),
+			)
+		}
+	}
+
+	return diags
+}
+
+// diffACLBatchEntries returns the entries to add and remove to move from current to desired.
+func diffACLBatchEntries(current, desired []client.CreateACLRequest) ([]client.CreateACLRequest, []client.CreateACLRequest) {
+	currentSet := make(map[client.CreateACLRequest]struct{}, len(current))
+	for _, entry := range current {
+		currentSet[entry] = struct{}{}
+	}
+	desiredSet := make(map[client.CreateACLRequest]struct{}, len(desired))
+	for _, entry := range desired {
+		desiredSet[entry] = struct{}{}
+	}
+
+	var add []client.CreateACLRequest
+	for _, entry := range desired {
+		if _, ok := currentSet[entry]; !ok {
+			add = append(add, entry)
+		}
+	}
+
+	var remove []client.CreateACLRequest
+	for _, entry := range current {
+		if _, ok := desiredSet[entry]; !ok {
+			remove = append(remove, entry)
+		}
+	}
+
+	return add, remove
+}
+
+// aclBatchID derives a stable identifier from the entries the batch was created with.
+func aclBatchID(entries []client.CreateACLRequest) string {
+	keys := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		keys = append(keys, strings.Join([]string{
+			string(entry.ObjectType),
+			entry.ObjectID,
+			entry.UserID,
+			entry.GroupID,
+			entry.RoleID,
+			string(entry.Permission),
+			string(entry.RestrictObjectType),
+		}, ","))
+	}
+	sort.Strings(keys)
+
+	sum := sha256.Sum256([]byte(strings.Join(keys, "\n")))
+	return hex.EncodeToString(sum[:16])
+}
+
+func aclBatchEntryFromACL(acl *client.ACL) client.CreateACLRequest {
+	return client.CreateACLRequest{
+		ObjectID:           acl.ObjectID,
+		ObjectType:         acl.ObjectType,
+		UserID:             acl.UserID,
+		GroupID:            acl.GroupID,
+		RoleID:             acl.RoleID,
+		Permission:         acl.Permission,
+		RestrictObjectType: acl.RestrictObjectType,
+	}
+}
+
+func aclBatchEntriesFromSet(ctx context.Context, acls types.Set) ([]client.CreateACLRequest, diag.Diagnostics) {
+	if acls.IsNull() || acls.IsUnknown() {
+		return nil, nil
+	}
+
+	var models []aclBatchEntryModel
+	diags := acls.ElementsAs(ctx, &models, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	result := make([]client.CreateACLRequest, 0, len(models))
+	for _, model := range models {
+		result = append(result, client.CreateACLRequest{
+			ObjectID:           model.ObjectID.ValueString(),
+			ObjectType:         client.ACLObjectType(model.ObjectType.ValueString()),
+			UserID:             model.UserID.ValueString(),
+			GroupID:            model.GroupID.ValueString(),
+			RoleID:             model.RoleID.ValueString(),
+			Permission:         client.Permission(model.Permission.ValueString()),
+			RestrictObjectType: client.ACLObjectType(model.RestrictObjectType.ValueString()),
+		})
+	}
+
+	return result, diags
+}
+
+func aclBatchEntriesToSet(ctx context.Context, entries []client.CreateACLRequest) (types.Set, diag.Diagnostics) {
+	models := make([]aclBatchEntryModel, 0, len(entries))
+	for _, entry := range entries {
+		models = append(models, aclBatchEntryModel{
+			ObjectID:           types.StringValue(entry.ObjectID),
+			ObjectType:         types.StringValue(string(entry.ObjectType)),
+			UserID:             stringOrNull(entry.UserID),
+			GroupID:            stringOrNull(entry.GroupID),
+			RoleID:             stringOrNull(entry.RoleID),
+			Permission:         stringOrNull(string(entry.Permission)),
+			RestrictObjectType: stringOrNull(string(entry.RestrictObjectType)),
+		})
+	}
+
+	return types.SetValueFrom(ctx, types.ObjectType{AttrTypes: aclBatchEntryAttributeTypes}, models)
+}
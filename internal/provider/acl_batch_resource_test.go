@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccACLBatchResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccACLBatchResourceConfig(`"read"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("braintrustdata_acl_batch.test", "id"),
+					resource.TestCheckResourceAttr("braintrustdata_acl_batch.test", "acls.#", "2"),
+				),
+			},
+			// Update testing
+			{
+				Config: testAccACLBatchResourceConfig(`"read", "update"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("braintrustdata_acl_batch.test", "acls.#", "4"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccACLBatchResourceConfig(permissions string) string {
+	return fmt.Sprintf(`
+resource "braintrustdata_project" "test" {
+  count       = 2
+  name        = "test-acl-batch-project-${count.index}"
+  description = "Project for ACL batch testing"
+}
+
+resource "braintrustdata_group" "test" {
+  name        = "test-acl-batch-group"
+  description = "Group for ACL batch testing"
+}
+
+resource "braintrustdata_acl_batch" "test" {
+  acls = flatten([
+    for project in braintrustdata_project.test : [
+      for permission in [%s] : {
+        object_id   = project.id
+        object_type = "project"
+        group_id    = braintrustdata_group.test.id
+        permission  = permission
+      }
+    ]
+  ])
+}
+`, permissions)
+}
@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDiffACLBatchEntries(t *testing.T) {
+	t.Parallel()
+
+	groupRead := client.CreateACLRequest{ObjectID: "project-1", ObjectType: client.ACLObjectTypeProject, GroupID: "group-1", Permission: client.PermissionRead}
+	groupUpdate := client.CreateACLRequest{ObjectID: "project-1", ObjectType: client.ACLObjectTypeProject, GroupID: "group-1", Permission: client.PermissionUpdate}
+	userRole := client.CreateACLRequest{ObjectID: "project-2", ObjectType: client.ACLObjectTypeProject, UserID: "user-1", RoleID: "role-1"}
+
+	testCases := map[string]struct {
+		current    []client.CreateACLRequest
+		desired    []client.CreateACLRequest
+		wantAdd    []client.CreateACLRequest
+		wantRemove []client.CreateACLRequest
+	}{
+		"no changes": {
+			current: []client.CreateACLRequest{groupRead, userRole},
+			desired: []client.CreateACLRequest{userRole, groupRead},
+		},
+		"add only": {
+			current: []client.CreateACLRequest{groupRead},
+			desired: []client.CreateACLRequest{groupRead, userRole},
+			wantAdd: []client.CreateACLRequest{userRole},
+		},
+		"remove only": {
+			current:    []client.CreateACLRequest{groupRead, userRole},
+			desired:    []client.CreateACLRequest{groupRead},
+			wantRemove: []client.CreateACLRequest{userRole},
+		},
+		"swap permission": {
+			current:    []client.CreateACLRequest{groupRead},
+			desired:    []client.CreateACLRequest{groupUpdate},
+			wantAdd:    []client.CreateACLRequest{groupUpdate},
+			wantRemove: []client.CreateACLRequest{groupRead},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotAdd, gotRemove := diffACLBatchEntries(tc.current, tc.desired)
+			if !reflect.DeepEqual(gotAdd, tc.wantAdd) {
+				t.Fatalf("add mismatch: got %#v, want %#v", gotAdd, tc.wantAdd)
+			}
+			if !reflect.DeepEqual(gotRemove, tc.wantRemove) {
+				t.Fatalf("remove mismatch: got %#v, want %#v", gotRemove, tc.wantRemove)
+			}
+		})
+	}
+}
+
+func TestACLBatchID(t *testing.T) {
+	t.Parallel()
+
+	a := client.CreateACLRequest{ObjectID: "project-1", ObjectType: client.ACLObjectTypeProject, GroupID: "group-1", Permission: client.PermissionRead}
+	b := client.CreateACLRequest{ObjectID: "project-2", ObjectType: client.ACLObjectTypeProject, UserID: "user-1", RoleID: "role-1"}
+
+	first := aclBatchID([]client.CreateACLRequest{a, b})
+	second := aclBatchID([]client.CreateACLRequest{b, a})
+	if first != second {
+		t.Fatalf("expected ID to be independent of entry order, got %q and %q", first, second)
+	}
+	if first == aclBatchID([]client.CreateACLRequest{a}) {
+		t.Fatalf("expected different entries to produce different IDs")
+	}
+}
+
+func TestValidateACLBatchEntries(t *testing.T) {
+	t.Parallel()
+
+	valid := client.CreateACLRequest{ObjectID: "project-1", ObjectType: client.ACLObjectTypeProject, GroupID: "group-1", Permission: client.PermissionRead}
+	invalid := client.CreateACLRequest{ObjectID: "project-1", ObjectType: client.ACLObjectTypeProject, RoleID: "role-1"}
+
+	if diags := validateACLBatchEntries([]client.CreateACLRequest{valid}); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if diags := validateACLBatchEntries([]client.CreateACLRequest{valid, invalid}); diags.ErrorsCount() != 1 {
+		t.Fatalf("expected 1 error, got %d: %v", diags.ErrorsCount(), diags)
+	}
+}
+
+func TestValidateACLBatchConfig(t *testing.T) {
+	t.Parallel()
+
+	elementType := types.ObjectType{AttrTypes: aclBatchEntryAttributeTypes}
+	entry := func(groupID, permission, roleID types.String) attr.Value {
+		return types.ObjectValueMust(aclBatchEntryAttributeTypes, map[string]attr.Value{
+			"object_id":            types.StringValue("project-1"),
+			"object_type":          types.StringValue("project"),
+			"user_id":              types.StringNull(),
+			"group_id":             groupID,
+			"role_id":              roleID,
+			"permission":           permission,
+			"restrict_object_type": types.StringNull(),
+		})
+	}
+
+	testCases := map[string]struct {
+		acls    types.Set
+		wantErr bool
+	}{
+		"valid": {
+			acls: types.SetValueMust(elementType, []attr.Value{
+				entry(types.StringValue("group-1"), types.StringValue("read"), types.StringNull()),
+			}),
+		},
+		"both grants": {
+			acls: types.SetValueMust(elementType, []attr.Value{
+				entry(types.StringValue("group-1"), types.StringValue("read"), types.StringValue("role-1")),
+			}),
+			wantErr: true,
+		},
+		"unknown group": {
+			acls: types.SetValueMust(elementType, []attr.Value{
+				entry(types.StringUnknown(), types.StringValue("read"), types.StringNull()),
+			}),
+		},
+		"unknown set": {
+			acls: types.SetUnknown(elementType),
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := validateACLBatchConfig(context.Background(), tc.acls)
+			if diags.HasError() != tc.wantErr {
+				t.Fatalf("expected error %t, got diagnostics: %v", tc.wantErr, diags)
+			}
+		})
+	}
+}
+
+func TestACLBatchEntriesSetRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	entries := []client.CreateACLRequest{
+		{ObjectID: "project-1", ObjectType: client.ACLObjectTypeProject, GroupID: "group-1", Permission: client.PermissionRead},
+		{ObjectID: "project-2", ObjectType: client.ACLObjectTypeProject, UserID: "user-1", RoleID: "role-1", RestrictObjectType: client.ACLObjectTypeDataset},
+	}
+
+	set, diags := aclBatchEntriesToSet(ctx, entries)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	got, diags := aclBatchEntriesFromSet(ctx, set)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !reflect.DeepEqual(got, entries) {
+		t.Fatalf("round trip mismatch: got %#v, want %#v", got, entries)
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,6 +19,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ACLResource{}
 var _ resource.ResourceWithImportState = &ACLResource{}
+var _ resource.ResourceWithModifyPlan = &ACLResource{}
 
 // NewACLResource creates a new ACL resource instance.
 func NewACLResource() resource.Resource {
@@ -35,6 +37,7 @@ type ACLResourceModel struct {
 	ObjectID           types.String `tfsdk:"object_id"`
 	ObjectType         types.String `tfsdk:"object_type"`
 	UserID             types.String `tfsdk:"user_id"`
+	UserEmail          types.String `tfsdk:"user_email"`
 	GroupID            types.String `tfsdk:"group_id"`
 	RoleID             types.String `tfsdk:"role_id"`
 	Permission         types.String `tfsdk:"permission"`
@@ -91,21 +94,28 @@ func (r *ACLResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 			},
 			"user_id": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "The ID of the user to grant access to. Exactly one of user_id, group_id, or role_id must be specified.",
+				MarkdownDescription: "The ID of the user to grant access to. Exactly one of user_id, user_email, group_id, or role_id must be specified.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_email": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The email of the user to grant access to. The email is resolved to a user ID during plan and apply, and planning fails if it does not belong to a member of the organization. Exactly one of user_id, user_email, group_id, or role_id must be specified.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"group_id": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "The ID of the group to grant access to. Exactly one of user_id, group_id, or role_id must be specified.",
+				MarkdownDescription: "The ID of the group to grant access to. Exactly one of user_id, user_email, group_id, or role_id must be specified.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"role_id": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "The ID of the role to grant access to. Exactly one of user_id, group_id, or role_id must be specified.",
+				MarkdownDescription: "The ID of the role to grant access to. Exactly one of user_id, user_email, group_id, or role_id must be specified.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -182,11 +192,14 @@ func (r *ACLResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
-	// Validate that exactly one of user_id, group_id, or role_id is specified
+	// Validate that exactly one of user_id, user_email, group_id, or role_id is specified
 	count := 0
 	if !data.UserID.IsNull() {
 		count++
 	}
+	if !data.UserEmail.IsNull() {
+		count++
+	}
 	if !data.GroupID.IsNull() {
 		count++
 	}
@@ -196,7 +209,7 @@ func (r *ACLResource) Create(ctx context.Context, req resource.CreateRequest, re
 	if count != 1 {
 		resp.Diagnostics.AddError(
 			"Invalid Configuration",
-			"Exactly one of user_id, group_id, or role_id must be specified.",
+			"Exactly one of user_id, user_email, group_id, or role_id must be specified.",
 		)
 		return
 	}
@@ -210,6 +223,14 @@ func (r *ACLResource) Create(ctx context.Context, req resource.CreateRequest, re
 	if !data.UserID.IsNull() {
 		createReq.UserID = data.UserID.ValueString()
 	}
+	if !data.UserEmail.IsNull() {
+		userID, diags := r.resolveUserEmail(ctx, data.UserEmail.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		createReq.UserID = userID
+	}
 	if !data.GroupID.IsNull() {
 		createReq.GroupID = data.GroupID.ValueString()
 	}
@@ -234,8 +255,9 @@ func (r *ACLResource) Create(ctx context.Context, req resource.CreateRequest, re
 	data.ID = types.StringValue(acl.ID)
 	data.Created = types.StringValue(acl.Created)
 
-	// Set optional fields from response
-	if acl.UserID != "" {
+	// Set optional fields from response. ACLs granted by email keep user_id
+	// unset so the configuration does not plan a replacement.
+	if acl.UserID != "" && data.UserEmail.IsNull() {
 		data.UserID = types.StringValue(acl.UserID)
 	}
 	if acl.GroupID != "" {
@@ -282,7 +304,7 @@ func (r *ACLResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	data.Created = types.StringValue(acl.Created)
 
 	// Set optional fields from response
-	if acl.UserID != "" {
+	if acl.UserID != "" && data.UserEmail.IsNull() {
 		data.UserID = types.StringValue(acl.UserID)
 	} else {
 		data.UserID = types.StringNull()
@@ -344,7 +366,49 @@ func (r *ACLResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 	}
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan by validating user_email at plan time.
+func (r *ACLResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var userEmail types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("user_email"), &userEmail)...)
+	if resp.Diagnostics.HasError() || userEmail.IsNull() || userEmail.IsUnknown() {
+		return
+	}
+
+	_, diags := r.resolveUserEmail(ctx, userEmail.ValueString())
+	resp.Diagnostics.Append(diags...)
+}
+
 // ImportState implements resource.ResourceWithImportState.
 func (r *ACLResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// resolveUserEmail resolves user_email to the ID of the matching organization member.
+func (r *ACLResource) resolveUserEmail(ctx context.Context, email string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	resolved, missing, err := resolveUserIDsByEmail(ctx, r.client, []string{email})
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("user_email"),
+			"Error Resolving User Email",
+			fmt.Sprintf("Could not resolve user_email %q: %s", email, err),
+		)
+		return "", diags
+	}
+	if len(missing) > 0 {
+		diags.AddAttributeError(
+			path.Root("user_email"),
+			"User Email Not Found",
+			fmt.Sprintf("No member of the organization has the email %q.", email),
+		)
+		return "", diags
+	}
+
+	return resolved[email], diags
+}
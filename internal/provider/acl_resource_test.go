@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -34,6 +35,20 @@ func TestAccACLResource(t *testing.T) {
 	})
 }
 
+func TestAccACLResource_UnknownUserEmail(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccACLResourceConfigWithUserEmail("nobody@example.invalid"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`User Email Not Found`),
+			},
+		},
+	})
+}
+
 func TestAccACLResource_WithGroup(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -116,7 +131,7 @@ resource "braintrustdata_project" "test" {
 resource "braintrustdata_role" "test" {
   name        = "test-acl-role"
   description = "Role for ACL testing"
-  member_permissions = ["read"]
+  member_permissions = [{ permission = "read" }]
 }
 
 resource "braintrustdata_acl" "test" {
@@ -126,3 +141,19 @@ resource "braintrustdata_acl" "test" {
 }
 `
 }
+
+func testAccACLResourceConfigWithUserEmail(email string) string {
+	return `
+resource "braintrustdata_project" "test" {
+  name        = "test-acl-project-email"
+  description = "Project for ACL user_email testing"
+}
+
+resource "braintrustdata_acl" "test" {
+  object_id   = braintrustdata_project.test.id
+  object_type = "project"
+  user_email  = "` + email + `"
+  permission  = "read"
+}
+`
+}
@@ -32,6 +32,7 @@ type DatasetDataSourceModel struct {
 	Created     types.String `tfsdk:"created"`
 	UserID      types.String `tfsdk:"user_id"`
 	OrgID       types.String `tfsdk:"org_id"`
+	Version     types.String `tfsdk:"version"`
 }
 
 // Metadata implements datasource.DataSource.
@@ -81,6 +82,10 @@ func (d *DatasetDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Computed:            true,
 				MarkdownDescription: "Metadata associated with the dataset as key-value pairs.",
 			},
+			"version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The latest transaction ID (`_xact_id`) among the dataset's records. Null while the dataset is empty.",
+			},
 		},
 	}
 }
@@ -206,6 +211,16 @@ func (d *DatasetDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	data.UserID = types.StringValue(dataset.UserID)
 	data.OrgID = types.StringValue(dataset.OrgID)
 
+	version, err := d.client.GetDatasetVersion(ctx, dataset.ID, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Dataset Version",
+			fmt.Sprintf("Could not read version of dataset ID %s: %s", dataset.ID, err.Error()),
+		)
+		return
+	}
+	data.Version = stringOrNull(version)
+
 	if len(dataset.Metadata) > 0 {
 		metadataMap := make(map[string]string)
 		for k, v := range dataset.Metadata {
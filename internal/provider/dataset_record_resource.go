@@ -0,0 +1,568 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &DatasetRecordResource{}
+var _ resource.ResourceWithImportState = &DatasetRecordResource{}
+
+// NewDatasetRecordResource creates a new dataset record resource instance.
+func NewDatasetRecordResource() resource.Resource {
+	return &DatasetRecordResource{}
+}
+
+// DatasetRecordResource defines the resource implementation.
+type DatasetRecordResource struct {
+	client *client.Client
+}
+
+// DatasetRecordResourceModel describes the resource data model.
+type DatasetRecordResourceModel struct {
+	Tags      types.Set    `tfsdk:"tags"`
+	ID        types.String `tfsdk:"id"`
+	DatasetID types.String `tfsdk:"dataset_id"`
+	Input     types.String `tfsdk:"input"`
+	Expected  types.String `tfsdk:"expected"`
+	Metadata  types.String `tfsdk:"metadata"`
+	ProjectID types.String `tfsdk:"project_id"`
+	XactID    types.String `tfsdk:"xact_id"`
+	Created   types.String `tfsdk:"created"`
+}
+
+// Metadata implements resource.Resource.
+func (r *DatasetRecordResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dataset_record"
+}
+
+// Schema implements resource.Resource.
+func (r *DatasetRecordResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a single record in a Braintrust dataset. Creates and updates are written as dataset insert and merge events, deletes as delete events, and Read fetches the record to detect drift.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The record ID. Set it to use a stable ID, for example a test case key; otherwise Braintrust generates one. Changing it forces a new record.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"dataset_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the dataset that holds the record.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"input": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The record input as a JSON-encoded value.",
+			},
+			"expected": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The expected output as a JSON-encoded value.",
+			},
+			"metadata": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The record metadata as a JSON-encoded object.",
+			},
+			"tags": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Tags associated with the record.",
+			},
+			"project_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The project ID that owns the dataset.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"xact_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The transaction ID of the latest write to the record.",
+			},
+			"created": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The timestamp when the record was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure implements resource.Resource.
+func (r *DatasetRecordResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// Create implements resource.Resource.
+func (r *DatasetRecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DatasetRecordResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	event, diags := buildCreateDatasetRecordEvent(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	insertResp, err := r.client.InsertDatasetEvents(ctx, data.DatasetID.ValueString(), &client.InsertDatasetEventsRequest{
+		Events: []client.InsertDatasetEvent{*event},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to create dataset record, got error: %s", err),
+		)
+		return
+	}
+	if len(insertResp.RowIDs) != 1 {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to create dataset record, expected 1 row ID in response, got %d", len(insertResp.RowIDs)),
+		)
+		return
+	}
+
+	datasetEvent, err := r.client.GetDatasetEvent(ctx, data.DatasetID.ValueString(), insertResp.RowIDs[0])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read dataset record after create, got error: %s", err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setDatasetRecordResourceModel(ctx, &data, datasetEvent)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read implements resource.Resource.
+func (r *DatasetRecordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DatasetRecordResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	datasetEvent, err := r.client.GetDatasetEvent(ctx, data.DatasetID.ValueString(), data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read dataset record, got error: %s", err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setDatasetRecordResourceModel(ctx, &data, datasetEvent)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource.
+func (r *DatasetRecordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DatasetRecordResourceModel
+	var state DatasetRecordResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	event, diags := buildMergeDatasetRecordEvent(ctx, plan, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if hasDatasetRecordMergeChanges(event) {
+		_, err := r.client.InsertDatasetEvents(ctx, state.DatasetID.ValueString(), &client.InsertDatasetEventsRequest{
+			Events: []client.InsertDatasetEvent{*event},
+		})
+		if err != nil {
+			if client.IsNotFound(err) {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to update dataset record, got error: %s", err),
+			)
+			return
+		}
+	}
+
+	datasetEvent, err := r.client.GetDatasetEvent(ctx, state.DatasetID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read dataset record after update, got error: %s", err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setDatasetRecordResourceModel(ctx, &plan, datasetEvent)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete implements resource.Resource.
+func (r *DatasetRecordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DatasetRecordResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDatasetEvents(ctx, data.DatasetID.ValueString(), []string{data.ID.ValueString()})
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to delete dataset record, got error: %s", err),
+		)
+	}
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (r *DatasetRecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	datasetID, recordID, err := parseDatasetRecordImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), recordID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dataset_id"), datasetID)...)
+}
+
+func parseDatasetRecordImportID(raw string) (string, string, error) {
+	parts := strings.Split(raw, ",")
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("expected import ID in the format <dataset_id>,<record_id>")
+	}
+
+	datasetID := strings.TrimSpace(parts[0])
+	recordID := strings.TrimSpace(parts[1])
+	if datasetID == "" || recordID == "" {
+		return "", "", fmt.Errorf("expected import ID in the format <dataset_id>,<record_id>")
+	}
+
+	return datasetID, recordID, nil
+}
+
+func buildCreateDatasetRecordEvent(ctx context.Context, model DatasetRecordResourceModel) (*client.InsertDatasetEvent, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	event := &client.InsertDatasetEvent{}
+
+	if !model.ID.IsNull() && !model.ID.IsUnknown() {
+		event.ID = model.ID.ValueString()
+	}
+
+	for _, field := range datasetRecordJSONFields(&model) {
+		if field.value.IsNull() || field.value.IsUnknown() {
+			continue
+		}
+		decoded, fieldDiags := optionalDatasetRecordJSONField(field.name, field.value)
+		diags.Append(fieldDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		diags.Append(setDatasetRecordEventField(event, field.name, decoded)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	if !model.Tags.IsNull() && !model.Tags.IsUnknown() {
+		tags, tagDiags := extractTags(ctx, model.Tags)
+		diags.Append(tagDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		event.Tags = &tags
+	}
+
+	return event, diags
+}
+
+// buildMergeDatasetRecordEvent returns a merge event that carries only the
+// changed fields. Changed fields are listed in _merge_paths so they replace
+// the stored value instead of being deep-merged into it, and removed fields
+// are sent as null.
+func buildMergeDatasetRecordEvent(ctx context.Context, plan, state DatasetRecordResourceModel) (*client.InsertDatasetEvent, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	event := &client.InsertDatasetEvent{
+		ID:      state.ID.ValueString(),
+		IsMerge: true,
+	}
+
+	stateFields := datasetRecordJSONFields(&state)
+	for i, field := range datasetRecordJSONFields(&plan) {
+		changed, decoded, fieldDiags := datasetRecordJSONFieldChanged(field.name, field.value, stateFields[i].value)
+		diags.Append(fieldDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		if !changed {
+			continue
+		}
+		diags.Append(setDatasetRecordEventField(event, field.name, decoded)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		event.MergePaths = append(event.MergePaths, []string{field.name})
+	}
+
+	if !plan.Tags.IsUnknown() && !plan.Tags.Equal(state.Tags) {
+		tags, tagDiags := extractTags(ctx, plan.Tags)
+		diags.Append(tagDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		event.Tags = &tags
+		event.MergePaths = append(event.MergePaths, []string{"tags"})
+	}
+
+	return event, diags
+}
+
+func hasDatasetRecordMergeChanges(event *client.InsertDatasetEvent) bool {
+	return len(event.MergePaths) > 0
+}
+
+type datasetRecordJSONField struct {
+	value types.String
+	name  string
+}
+
+func datasetRecordJSONFields(model *DatasetRecordResourceModel) []datasetRecordJSONField {
+	return []datasetRecordJSONField{
+		{name: "input", value: model.Input},
+		{name: "expected", value: model.Expected},
+		{name: "metadata", value: model.Metadata},
+	}
+}
+
+func setDatasetRecordEventField(event *client.InsertDatasetEvent, fieldName string, decoded interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch fieldName {
+	case "input":
+		event.Input = &decoded
+	case "expected":
+		event.Expected = &decoded
+	case "metadata":
+		metadata, ok := decoded.(map[string]interface{})
+		if decoded != nil && !ok {
+			diags.AddError(
+				"Invalid metadata",
+				"metadata must be a JSON object",
+			)
+			return diags
+		}
+		event.Metadata = &metadata
+	}
+
+	return diags
+}
+
+func datasetRecordJSONFieldChanged(fieldName string, plan, state types.String) (bool, interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if plan.IsUnknown() {
+		return false, nil, diags
+	}
+	if plan.IsNull() {
+		return !state.IsNull(), nil, diags
+	}
+
+	planDecoded, fieldDiags := optionalDatasetRecordJSONField(fieldName, plan)
+	diags.Append(fieldDiags...)
+	if diags.HasError() {
+		return false, nil, diags
+	}
+
+	if state.IsNull() || state.IsUnknown() {
+		return true, planDecoded, diags
+	}
+
+	stateDecoded, err := decodeDatasetRecordJSONField(fieldName, state)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Invalid %s", fieldName),
+			err.Error(),
+		)
+		return false, nil, diags
+	}
+
+	if reflect.DeepEqual(planDecoded, stateDecoded) {
+		return false, nil, diags
+	}
+
+	return true, planDecoded, diags
+}
+
+func decodeDatasetRecordJSONField(fieldName string, value types.String) (interface{}, error) {
+	if value.IsNull() || value.IsUnknown() || strings.TrimSpace(value.ValueString()) == "" {
+		return nil, fmt.Errorf("%s must be valid JSON and cannot be empty", fieldName)
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(value.ValueString()), &decoded); err != nil {
+		return nil, fmt.Errorf("%s must be valid JSON: %w", fieldName, err)
+	}
+
+	return decoded, nil
+}
+
+func optionalDatasetRecordJSONField(fieldName string, value types.String) (interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if value.IsNull() || value.IsUnknown() {
+		return nil, diags
+	}
+
+	decoded, err := decodeDatasetRecordJSONField(fieldName, value)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Invalid %s", fieldName),
+			err.Error(),
+		)
+		return nil, diags
+	}
+
+	return decoded, diags
+}
+
+func datasetRecordJSONValueOrPreserve(fieldName string, current types.String, apiValue interface{}) (types.String, diag.Diagnostics) {
+	encoded, diags := jsonEncodedOrNull(fieldName, apiValue)
+	if diags.HasError() || encoded.IsNull() || current.IsNull() || current.IsUnknown() {
+		return encoded, diags
+	}
+
+	currentDecoded, err := decodeDatasetRecordJSONField(fieldName, current)
+	if err != nil {
+		return encoded, diags
+	}
+
+	apiDecoded, err := decodeDatasetRecordJSONField(fieldName, encoded)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Invalid %s", fieldName),
+			err.Error(),
+		)
+		return types.StringNull(), diags
+	}
+
+	if reflect.DeepEqual(currentDecoded, apiDecoded) {
+		return current, diags
+	}
+
+	return encoded, diags
+}
+
+func setDatasetRecordResourceModel(ctx context.Context, model *DatasetRecordResourceModel, event *client.DatasetEvent) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.ID = stringOrNull(event.ID)
+	if event.DatasetID != "" {
+		model.DatasetID = types.StringValue(event.DatasetID)
+	}
+	model.ProjectID = stringOrNull(event.ProjectID)
+	model.XactID = stringOrNull(event.XactID)
+	model.Created = stringOrNull(event.Created)
+
+	input, fieldDiags := datasetRecordJSONValueOrPreserve("input", model.Input, event.Input)
+	diags.Append(fieldDiags...)
+	model.Input = input
+
+	expected, fieldDiags := datasetRecordJSONValueOrPreserve("expected", model.Expected, event.Expected)
+	diags.Append(fieldDiags...)
+	model.Expected = expected
+
+	var metadata interface{}
+	if len(event.Metadata) > 0 {
+		metadata = event.Metadata
+	}
+	metadataValue, fieldDiags := datasetRecordJSONValueOrPreserve("metadata", model.Metadata, metadata)
+	diags.Append(fieldDiags...)
+	model.Metadata = metadataValue
+
+	if diags.HasError() {
+		return diags
+	}
+
+	// tags: keep a configured empty set when the API omits tags.
+	if len(event.Tags) > 0 {
+		tags, tagDiags := types.SetValueFrom(ctx, types.StringType, event.Tags)
+		diags.Append(tagDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		model.Tags = tags
+	} else if model.Tags.IsNull() || model.Tags.IsUnknown() {
+		model.Tags = types.SetNull(types.StringType)
+	} else {
+		model.Tags = types.SetValueMust(types.StringType, []attr.Value{})
+	}
+
+	return diags
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDatasetRecordResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasetRecordResourceConfig(`"4"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("braintrustdata_dataset_record.test", "id", "golden-case-1"),
+					resource.TestCheckResourceAttr("braintrustdata_dataset_record.test", "expected", `"4"`),
+					resource.TestCheckResourceAttr("braintrustdata_dataset_record.test", "tags.#", "1"),
+					resource.TestCheckResourceAttrSet("braintrustdata_dataset_record.test", "xact_id"),
+					resource.TestCheckResourceAttrPair("braintrustdata_dataset_record.test", "dataset_id", "braintrustdata_dataset.test", "id"),
+				),
+			},
+			{
+				Config: testAccDatasetRecordResourceConfig(`"four"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("braintrustdata_dataset_record.test", "expected", `"four"`),
+				),
+			},
+			{
+				ResourceName:      "braintrustdata_dataset_record.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["braintrustdata_dataset_record.test"]
+					if !ok {
+						return "", fmt.Errorf("resource not found: braintrustdata_dataset_record.test")
+					}
+					return rs.Primary.Attributes["dataset_id"] + "," + rs.Primary.ID, nil
+				},
+			},
+		},
+	})
+}
+
+func testAccDatasetRecordResourceConfig(expected string) string {
+	return fmt.Sprintf(`
+resource "braintrustdata_project" "test" {
+  name = "test-project-for-dataset-record"
+}
+
+resource "braintrustdata_dataset" "test" {
+  project_id = braintrustdata_project.test.id
+  name       = "test-dataset-for-dataset-record"
+}
+
+resource "braintrustdata_dataset_record" "test" {
+  id         = "golden-case-1"
+  dataset_id = braintrustdata_dataset.test.id
+  input      = jsonencode({ question = "What is 2+2?" })
+  expected   = %[1]q
+  metadata   = jsonencode({ source = "golden" })
+  tags       = ["math"]
+}
+`, expected)
+}
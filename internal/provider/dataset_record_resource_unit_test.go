@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testDatasetRecordModel() DatasetRecordResourceModel {
+	return DatasetRecordResourceModel{
+		ID:        types.StringValue("case-1"),
+		DatasetID: types.StringValue("dataset-123"),
+		Input:     types.StringValue(`{"question":"2+2"}`),
+		Expected:  types.StringValue(`"4"`),
+		Metadata:  types.StringValue(`{"source":"golden"}`),
+		Tags:      types.SetValueMust(types.StringType, []attr.Value{types.StringValue("math")}),
+	}
+}
+
+func TestParseDatasetRecordImportID(t *testing.T) {
+	t.Parallel()
+
+	datasetID, recordID, err := parseDatasetRecordImportID("dataset-123, case-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if datasetID != "dataset-123" || recordID != "case-1" {
+		t.Fatalf("unexpected parse result: dataset_id=%q record_id=%q", datasetID, recordID)
+	}
+
+	for _, raw := range []string{"", "dataset-123", "dataset-123,", ",case-1", "a,b,c"} {
+		if _, _, err := parseDatasetRecordImportID(raw); err == nil {
+			t.Fatalf("expected error for import ID %q", raw)
+		}
+	}
+}
+
+func TestBuildCreateDatasetRecordEvent(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	event, diags := buildCreateDatasetRecordEvent(ctx, testDatasetRecordModel())
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var input interface{} = map[string]interface{}{"question": "2+2"}
+	var expected interface{} = "4"
+	metadata := map[string]interface{}{"source": "golden"}
+	tags := []string{"math"}
+	want := &client.InsertDatasetEvent{
+		ID:       "case-1",
+		Input:    &input,
+		Expected: &expected,
+		Metadata: &metadata,
+		Tags:     &tags,
+	}
+	if !reflect.DeepEqual(event, want) {
+		t.Fatalf("event mismatch: got=%#v want=%#v", event, want)
+	}
+
+	generated := testDatasetRecordModel()
+	generated.ID = types.StringUnknown()
+	generated.Expected = types.StringNull()
+	generated.Tags = types.SetNull(types.StringType)
+
+	event, diags = buildCreateDatasetRecordEvent(ctx, generated)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if event.ID != "" || event.Expected != nil || event.Tags != nil {
+		t.Fatalf("expected id, expected and tags to be omitted, got %#v", event)
+	}
+
+	invalid := testDatasetRecordModel()
+	invalid.Metadata = types.StringValue(`["not","an","object"]`)
+	if _, diags := buildCreateDatasetRecordEvent(ctx, invalid); !diags.HasError() {
+		t.Fatal("expected diagnostics for non-object metadata")
+	}
+}
+
+func TestBuildMergeDatasetRecordEvent(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	state := testDatasetRecordModel()
+
+	testCases := []struct {
+		mutate         func(*DatasetRecordResourceModel)
+		check          func(*testing.T, *client.InsertDatasetEvent)
+		name           string
+		wantMergePaths [][]string
+	}{
+		{
+			name:   "no_changes",
+			mutate: func(*DatasetRecordResourceModel) {},
+		},
+		{
+			name: "equivalent_json_is_not_a_change",
+			mutate: func(m *DatasetRecordResourceModel) {
+				m.Input = types.StringValue("{ \"question\": \"2+2\" }")
+			},
+		},
+		{
+			name: "expected_changed",
+			mutate: func(m *DatasetRecordResourceModel) {
+				m.Expected = types.StringValue(`"four"`)
+			},
+			wantMergePaths: [][]string{{"expected"}},
+			check: func(t *testing.T, event *client.InsertDatasetEvent) {
+				if event.Expected == nil || *event.Expected != "four" {
+					t.Fatalf("expected expected=four, got %#v", event.Expected)
+				}
+				if event.Input != nil || event.Metadata != nil || event.Tags != nil {
+					t.Fatalf("expected unchanged fields to be omitted, got %#v", event)
+				}
+			},
+		},
+		{
+			name: "metadata_removed_sends_null",
+			mutate: func(m *DatasetRecordResourceModel) {
+				m.Metadata = types.StringNull()
+			},
+			wantMergePaths: [][]string{{"metadata"}},
+			check: func(t *testing.T, event *client.InsertDatasetEvent) {
+				if event.Metadata == nil || *event.Metadata != nil {
+					t.Fatalf("expected explicit null metadata, got %#v", event.Metadata)
+				}
+			},
+		},
+		{
+			name: "tags_changed",
+			mutate: func(m *DatasetRecordResourceModel) {
+				m.Tags = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("arithmetic")})
+			},
+			wantMergePaths: [][]string{{"tags"}},
+			check: func(t *testing.T, event *client.InsertDatasetEvent) {
+				if event.Tags == nil || !reflect.DeepEqual(*event.Tags, []string{"arithmetic"}) {
+					t.Fatalf("expected tags [arithmetic], got %#v", event.Tags)
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			plan := state
+			tc.mutate(&plan)
+
+			event, diags := buildMergeDatasetRecordEvent(ctx, plan, state)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if event.ID != "case-1" || !event.IsMerge {
+				t.Fatalf("expected merge event for case-1, got %#v", event)
+			}
+			if !reflect.DeepEqual(event.MergePaths, tc.wantMergePaths) {
+				t.Fatalf("merge paths mismatch: got=%v want=%v", event.MergePaths, tc.wantMergePaths)
+			}
+			if hasDatasetRecordMergeChanges(event) != (len(tc.wantMergePaths) > 0) {
+				t.Fatalf("hasDatasetRecordMergeChanges() mismatch for %+v", event)
+			}
+			if tc.check != nil {
+				tc.check(t, event)
+			}
+		})
+	}
+}
+
+func TestSetDatasetRecordResourceModel(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	model := testDatasetRecordModel()
+	model.Input = types.StringValue("{ \"question\": \"2+2\" }")
+
+	diags := setDatasetRecordResourceModel(ctx, &model, &client.DatasetEvent{
+		ID:        "case-1",
+		DatasetID: "dataset-123",
+		ProjectID: "project-123",
+		XactID:    "1000192656880881099",
+		Created:   "2026-01-01T00:00:00Z",
+		Input:     map[string]interface{}{"question": "2+2"},
+		Expected:  "5",
+		Tags:      []string{"math"},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if model.Input.ValueString() != "{ \"question\": \"2+2\" }" {
+		t.Fatalf("expected semantically equal input to be preserved, got %s", model.Input)
+	}
+	if model.Expected.ValueString() != `"5"` {
+		t.Fatalf("expected drifted expected value, got %s", model.Expected)
+	}
+	if !model.Metadata.IsNull() {
+		t.Fatalf("expected metadata removed remotely to be null, got %s", model.Metadata)
+	}
+	if model.XactID.ValueString() != "1000192656880881099" {
+		t.Fatalf("expected xact_id to be set, got %s", model.XactID)
+	}
+
+	model.Tags = types.SetValueMust(types.StringType, []attr.Value{})
+	diags = setDatasetRecordResourceModel(ctx, &model, &client.DatasetEvent{ID: "case-1"})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if model.Tags.IsNull() || len(model.Tags.Elements()) != 0 {
+		t.Fatalf("expected configured empty tags to be preserved, got %s", model.Tags)
+	}
+	if model.DatasetID.ValueString() != "dataset-123" {
+		t.Fatalf("expected dataset_id to be preserved, got %s", model.DatasetID)
+	}
+}
@@ -0,0 +1,289 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DatasetRecordsDataSource{}
+
+var defaultDatasetRecordsFields = []string{"input", "expected", "metadata", "tags"}
+
+// NewDatasetRecordsDataSource creates a new dataset records data source instance.
+func NewDatasetRecordsDataSource() datasource.DataSource {
+	return &DatasetRecordsDataSource{}
+}
+
+// DatasetRecordsDataSource defines the data source implementation.
+type DatasetRecordsDataSource struct {
+	client *client.Client
+}
+
+// DatasetRecordsDataSourceModel describes the data source data model.
+type DatasetRecordsDataSourceModel struct {
+	Fields    types.List                       `tfsdk:"fields"`
+	DatasetID types.String                     `tfsdk:"dataset_id"`
+	Version   types.String                     `tfsdk:"version"`
+	Filter    types.String                     `tfsdk:"filter"`
+	Records   []DatasetRecordsDataSourceRecord `tfsdk:"records"`
+	IDs       []string                         `tfsdk:"ids"`
+	Limit     types.Int64                      `tfsdk:"limit"`
+}
+
+// DatasetRecordsDataSourceRecord represents a single dataset record in the list.
+type DatasetRecordsDataSourceRecord struct {
+	ID      types.String `tfsdk:"id"`
+	XactID  types.String `tfsdk:"xact_id"`
+	Created types.String `tfsdk:"created"`
+	Data    types.String `tfsdk:"data"`
+}
+
+// Metadata implements datasource.DataSource.
+func (d *DatasetRecordsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dataset_records"
+}
+
+// Schema implements datasource.DataSource.
+func (d *DatasetRecordsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the records of a Braintrust dataset. Pages are fetched automatically until `limit` records are read or the dataset is exhausted.",
+		Attributes: map[string]schema.Attribute{
+			"dataset_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the dataset to read.",
+			},
+			"version": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional dataset version (`_xact_id`) to read the records as of. Cannot be combined with `filter`, because BTQL filters only see the latest version of each record.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("filter")),
+				},
+			},
+			"filter": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional BTQL filter expression, for example `metadata.source = 'golden'`. When set, records are read with a BTQL query instead of the fetch endpoint.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"fields": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Top-level record fields to include in each record's `data`. Defaults to `input`, `expected`, `metadata` and `tags`.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Optional max number of records to return. All records are returned when omitted.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "List of returned record IDs.",
+			},
+			"records": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of dataset records.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The record ID.",
+						},
+						"xact_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The transaction ID (`_xact_id`) of the record's latest version.",
+						},
+						"created": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The timestamp when the record was created.",
+						},
+						"data": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The projected record fields as a JSON-encoded object.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure implements datasource.DataSource.
+func (d *DatasetRecordsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DatasetRecordsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DatasetRecordsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fields, diags := datasetRecordsProjectionFields(ctx, data.Fields)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limit := 0
+	if !data.Limit.IsNull() {
+		limit = int(data.Limit.ValueInt64())
+	}
+
+	events, err := d.fetchAllDatasetRecords(ctx, data, limit)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Dataset Records",
+			fmt.Sprintf("Could not read records of dataset ID %s: %s", data.DatasetID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	data.Records = make([]DatasetRecordsDataSourceRecord, 0, len(events))
+	data.IDs = make([]string, 0, len(events))
+
+	for i := range events {
+		record, err := datasetRecordsDataSourceRecord(&events[i], fields)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Encoding Dataset Record",
+				fmt.Sprintf("Could not encode dataset record %s: %s", events[i].ID, err.Error()),
+			)
+			return
+		}
+
+		data.Records = append(data.Records, record)
+		data.IDs = append(data.IDs, events[i].ID)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// fetchAllDatasetRecords follows cursors until limit records are read (or all
+// records when limit is zero).
+func (d *DatasetRecordsDataSource) fetchAllDatasetRecords(ctx context.Context, data DatasetRecordsDataSourceModel, limit int) ([]client.DatasetEvent, error) {
+	var events []client.DatasetEvent
+	cursor := ""
+
+	for {
+		pageSize := datasetRecordsFetchPageSize
+		if limit > 0 {
+			pageSize = min(pageSize, limit-len(events))
+		}
+
+		var page *client.FetchDatasetEventsResponse
+		var err error
+		if !data.Filter.IsNull() {
+			page, err = d.client.QueryDatasetEvents(ctx, data.DatasetID.ValueString(), data.Filter.ValueString(), pageSize, cursor)
+		} else {
+			page, err = d.client.FetchDatasetEvents(ctx, data.DatasetID.ValueString(), &client.FetchDatasetEventsRequest{
+				Cursor:  cursor,
+				Version: data.Version.ValueString(),
+				Limit:   pageSize,
+			})
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, page.Events...)
+		if limit > 0 && len(events) >= limit {
+			return events[:limit], nil
+		}
+		if page.Cursor == "" || page.Cursor == cursor || len(page.Events) == 0 {
+			return events, nil
+		}
+		cursor = page.Cursor
+	}
+}
+
+func datasetRecordsProjectionFields(ctx context.Context, value types.List) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if value.IsNull() || value.IsUnknown() {
+		return defaultDatasetRecordsFields, diags
+	}
+
+	var fields []string
+	diags.Append(value.ElementsAs(ctx, &fields, false)...)
+	return fields, diags
+}
+
+// datasetRecordsDataSourceRecord encodes the requested top-level fields of the
+// event as the record's data.
+func datasetRecordsDataSourceRecord(event *client.DatasetEvent, fields []string) (DatasetRecordsDataSourceRecord, error) {
+	record := DatasetRecordsDataSourceRecord{
+		ID:      stringOrNull(event.ID),
+		XactID:  stringOrNull(event.XactID),
+		Created: stringOrNull(event.Created),
+	}
+
+	data, err := encodeProjectedFields(event, fields)
+	if err != nil {
+		return record, err
+	}
+
+	record.Data = types.StringValue(data)
+	return record, nil
+}
+
+// encodeProjectedFields encodes the requested top-level JSON fields of value as
+// a JSON object. Fields value does not have are omitted.
+func encodeProjectedFields(value interface{}, fields []string) (string, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	var all map[string]interface{}
+	if err := json.Unmarshal(encoded, &all); err != nil {
+		return "", err
+	}
+
+	projected := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		if v, ok := all[field]; ok {
+			projected[field] = v
+		}
+	}
+
+	encoded, err = json.Marshal(projected)
+	if err != nil {
+		return "", err
+	}
+
+	return string(encoded), nil
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatasetRecordsDataSource(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "golden.jsonl")
+	content := `{"id":"case-1","input":"2+2","expected":"4","metadata":{"source":"golden"}}
+{"id":"case-2","input":"3+3","expected":"6","metadata":{"source":"generated"}}
+{"id":"case-3","input":"4+4","expected":"8","metadata":{"source":"golden"}}
+`
+	if err := os.WriteFile(source, []byte(content), 0o600); err != nil {
+		t.Fatalf("write source: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasetRecordsResourceConfig(source) + `
+data "braintrustdata_dataset_records" "all" {
+  dataset_id = braintrustdata_dataset.test.id
+  depends_on = [braintrustdata_dataset_records.test]
+}
+
+data "braintrustdata_dataset_records" "golden" {
+  dataset_id = braintrustdata_dataset.test.id
+  filter     = "metadata.source = 'golden'"
+  fields     = ["input", "expected"]
+  depends_on = [braintrustdata_dataset_records.test]
+}
+
+data "braintrustdata_dataset_records" "first" {
+  dataset_id = braintrustdata_dataset.test.id
+  limit      = 1
+  depends_on = [braintrustdata_dataset_records.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.braintrustdata_dataset_records.all", "records.#", "3"),
+					resource.TestCheckResourceAttrSet("data.braintrustdata_dataset_records.all", "records.0.xact_id"),
+					resource.TestCheckResourceAttr("data.braintrustdata_dataset_records.golden", "records.#", "2"),
+					resource.TestCheckResourceAttr("data.braintrustdata_dataset_records.first", "records.#", "1"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDatasetRecordsProjectionFields(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	fields, diags := datasetRecordsProjectionFields(ctx, types.ListNull(types.StringType))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !reflect.DeepEqual(fields, []string{"input", "expected", "metadata", "tags"}) {
+		t.Fatalf("unexpected default fields: %v", fields)
+	}
+
+	fields, diags = datasetRecordsProjectionFields(ctx, types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("input"),
+		types.StringValue("_xact_id"),
+	}))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !reflect.DeepEqual(fields, []string{"input", "_xact_id"}) {
+		t.Fatalf("unexpected fields: %v", fields)
+	}
+}
+
+func TestDatasetRecordsDataSourceRecord(t *testing.T) {
+	t.Parallel()
+
+	event := &client.DatasetEvent{
+		ID:       "case-1",
+		XactID:   "1000192656880881099",
+		Created:  "2026-01-01T00:00:00Z",
+		Input:    map[string]interface{}{"question": "2+2"},
+		Expected: "4",
+		Tags:     []string{"math"},
+	}
+
+	record, err := datasetRecordsDataSourceRecord(event, []string{"input", "expected", "metadata", "_xact_id"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if record.ID.ValueString() != "case-1" || record.XactID.ValueString() != "1000192656880881099" {
+		t.Fatalf("unexpected record identity: %+v", record)
+	}
+
+	want := `{"_xact_id":"1000192656880881099","expected":"4","input":{"question":"2+2"}}`
+	if record.Data.ValueString() != want {
+		t.Fatalf("data mismatch:\n got: %s\nwant: %s", record.Data.ValueString(), want)
+	}
+}
@@ -0,0 +1,781 @@
+package provider
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	datasetRecordsFormatJSONL = "jsonl"
+	datasetRecordsFormatCSV   = "csv"
+
+	datasetRecordsDefaultBatchSize = 100
+	datasetRecordsFetchPageSize    = 1000
+	datasetRecordsMaxLineBytes     = 64 * 1024 * 1024
+)
+
+var _ resource.Resource = &DatasetRecordsResource{}
+var _ resource.ResourceWithModifyPlan = &DatasetRecordsResource{}
+
+// NewDatasetRecordsResource creates a new dataset records resource instance.
+func NewDatasetRecordsResource() resource.Resource {
+	return &DatasetRecordsResource{}
+}
+
+// DatasetRecordsResource defines the resource implementation.
+type DatasetRecordsResource struct {
+	client *client.Client
+}
+
+// DatasetRecordsResourceModel describes the resource data model.
+type DatasetRecordsResourceModel struct {
+	Manifest  types.Map    `tfsdk:"manifest"`
+	ID        types.String `tfsdk:"id"`
+	DatasetID types.String `tfsdk:"dataset_id"`
+	Source    types.String `tfsdk:"source"`
+	Format    types.String `tfsdk:"format"`
+	IDField   types.String `tfsdk:"id_field"`
+	BatchSize types.Int64  `tfsdk:"batch_size"`
+	RowCount  types.Int64  `tfsdk:"row_count"`
+}
+
+// datasetRecordsRow is a single record parsed from a source file.
+type datasetRecordsRow struct {
+	Input    interface{}
+	Expected interface{}
+	Metadata map[string]interface{}
+	ID       string
+	Hash     string
+	Tags     []string
+}
+
+// Metadata implements resource.Resource.
+func (r *DatasetRecordsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dataset_records"
+}
+
+// Schema implements resource.Resource.
+func (r *DatasetRecordsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the records of a Braintrust dataset from a local JSONL or CSV file. " +
+			"Each row gets a stable ID and a content hash; only new or changed rows are upserted, in batches, and rows removed from the file are deleted. " +
+			"Only the ID-to-hash manifest is stored in state, never the row contents. Records in the dataset that are not listed in the manifest are left untouched.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the managed dataset.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dataset_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the dataset whose records are managed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "Path to the local source file. JSONL files hold one JSON object per line with `input`, `expected`, `metadata` and `tags` keys plus the ID key. " +
+					"CSV files have a header row with the same column names; cells that hold valid JSON are decoded and other cells are used as plain strings, and `tags` may be a JSON array or a comma-separated list. " +
+					"The file is read at plan time, so content changes show up as manifest changes.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"format": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The source file format: `jsonl` or `csv`. Inferred from the file extension (`.jsonl`, `.ndjson`, `.csv`) when omitted.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(datasetRecordsFormatJSONL, datasetRecordsFormatCSV),
+				},
+			},
+			"id_field": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("id"),
+				MarkdownDescription: "The key or column that holds each row's stable ID. Rows without an ID get one derived from a hash of their `input`, so editing `expected`, `metadata` or `tags` updates the row in place. Defaults to `id`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"batch_size": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(datasetRecordsDefaultBatchSize),
+				MarkdownDescription: "The maximum number of rows sent in a single insert or delete call. Defaults to 100.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 1000),
+				},
+			},
+			"manifest": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Map of managed record IDs to the SHA-256 hash of their content.",
+			},
+			"row_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of managed records.",
+			},
+		},
+	}
+}
+
+// Configure implements resource.Resource.
+func (r *DatasetRecordsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan by reading the source
+// file and planning the manifest, so that edits to the file produce a diff
+// even when the source path is unchanged.
+func (r *DatasetRecordsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan DatasetRecordsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Source.IsUnknown() || plan.Format.IsUnknown() || plan.IDField.IsUnknown() {
+		return
+	}
+
+	rows, err := readDatasetRecordsSource(plan.Source.ValueString(), plan.Format.ValueString(), plan.IDField.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source"),
+			"Invalid Dataset Records Source",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setDatasetRecordsManifest(ctx, &plan, datasetRecordsManifest(rows))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Create implements resource.Resource.
+func (r *DatasetRecordsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DatasetRecordsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rows, err := readDatasetRecordsSource(data.Source.ValueString(), data.Format.ValueString(), data.IDField.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source"),
+			"Invalid Dataset Records Source",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := r.upsertDatasetRecords(ctx, data.DatasetID.ValueString(), rows, datasetRecordsBatchSize(data)); err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to create dataset records, got error: %s", err),
+		)
+		return
+	}
+
+	data.ID = data.DatasetID
+	resp.Diagnostics.Append(setDatasetRecordsManifest(ctx, &data, datasetRecordsManifest(rows))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read implements resource.Resource.
+func (r *DatasetRecordsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DatasetRecordsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateManifest, diags := datasetRecordsManifestFromState(ctx, data.Manifest)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteManifest, err := fetchDatasetRecordsManifest(ctx, r.client, data.DatasetID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read dataset records, got error: %s", err),
+		)
+		return
+	}
+
+	// Only records tracked in the manifest are managed. Records that were
+	// changed or deleted remotely get their remote hash (or are dropped), so
+	// the next plan upserts them again.
+	manifest := make(map[string]string, len(stateManifest))
+	for id := range stateManifest {
+		if hash, ok := remoteManifest[id]; ok {
+			manifest[id] = hash
+		}
+	}
+
+	resp.Diagnostics.Append(setDatasetRecordsManifest(ctx, &data, manifest)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource.
+func (r *DatasetRecordsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DatasetRecordsResourceModel
+	var state DatasetRecordsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rows, err := readDatasetRecordsSource(plan.Source.ValueString(), plan.Format.ValueString(), plan.IDField.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source"),
+			"Invalid Dataset Records Source",
+			err.Error(),
+		)
+		return
+	}
+
+	stateManifest, diags := datasetRecordsManifestFromState(ctx, state.Manifest)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changed, removed := diffDatasetRecords(rows, stateManifest)
+	batchSize := datasetRecordsBatchSize(plan)
+	datasetID := state.DatasetID.ValueString()
+
+	if err := r.upsertDatasetRecords(ctx, datasetID, changed, batchSize); err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to update dataset records, got error: %s", err),
+		)
+		return
+	}
+
+	if err := r.deleteDatasetRecords(ctx, datasetID, removed, batchSize); err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to delete removed dataset records, got error: %s", err),
+		)
+		return
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(setDatasetRecordsManifest(ctx, &plan, datasetRecordsManifest(rows))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete implements resource.Resource.
+func (r *DatasetRecordsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DatasetRecordsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	manifest, diags := datasetRecordsManifestFromState(ctx, data.Manifest)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := make([]string, 0, len(manifest))
+	for id := range manifest {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	err := r.deleteDatasetRecords(ctx, data.DatasetID.ValueString(), ids, datasetRecordsBatchSize(data))
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to delete dataset records, got error: %s", err),
+		)
+	}
+}
+
+func (r *DatasetRecordsResource) upsertDatasetRecords(ctx context.Context, datasetID string, rows []datasetRecordsRow, batchSize int) error {
+	for start := 0; start < len(rows); start += batchSize {
+		end := min(start+batchSize, len(rows))
+
+		events := make([]client.InsertDatasetEvent, 0, end-start)
+		for i := start; i < end; i++ {
+			events = append(events, datasetRecordsInsertEvent(rows[i]))
+		}
+
+		if _, err := r.client.InsertDatasetEvents(ctx, datasetID, &client.InsertDatasetEventsRequest{Events: events}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *DatasetRecordsResource) deleteDatasetRecords(ctx context.Context, datasetID string, ids []string, batchSize int) error {
+	for start := 0; start < len(ids); start += batchSize {
+		end := min(start+batchSize, len(ids))
+		if err := r.client.DeleteDatasetEvents(ctx, datasetID, ids[start:end]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func datasetRecordsBatchSize(model DatasetRecordsResourceModel) int {
+	if model.BatchSize.IsNull() || model.BatchSize.IsUnknown() || model.BatchSize.ValueInt64() < 1 {
+		return datasetRecordsDefaultBatchSize
+	}
+	return int(model.BatchSize.ValueInt64())
+}
+
+// datasetRecordsInsertEvent builds a full (non-merge) insert event, so fields
+// missing from the row are cleared on the stored record.
+func datasetRecordsInsertEvent(row datasetRecordsRow) client.InsertDatasetEvent {
+	event := client.InsertDatasetEvent{ID: row.ID}
+	if row.Input != nil {
+		input := row.Input
+		event.Input = &input
+	}
+	if row.Expected != nil {
+		expected := row.Expected
+		event.Expected = &expected
+	}
+	if len(row.Metadata) > 0 {
+		metadata := row.Metadata
+		event.Metadata = &metadata
+	}
+	if len(row.Tags) > 0 {
+		tags := row.Tags
+		event.Tags = &tags
+	}
+	return event
+}
+
+// diffDatasetRecords returns the rows whose hash differs from the manifest and
+// the sorted IDs that are in the manifest but no longer in the rows.
+func diffDatasetRecords(rows []datasetRecordsRow, manifest map[string]string) ([]datasetRecordsRow, []string) {
+	var changed []datasetRecordsRow
+	seen := make(map[string]struct{}, len(rows))
+	for _, row := range rows {
+		seen[row.ID] = struct{}{}
+		if manifest[row.ID] != row.Hash {
+			changed = append(changed, row)
+		}
+	}
+
+	var removed []string
+	for id := range manifest {
+		if _, ok := seen[id]; !ok {
+			removed = append(removed, id)
+		}
+	}
+	sort.Strings(removed)
+
+	return changed, removed
+}
+
+func datasetRecordsManifest(rows []datasetRecordsRow) map[string]string {
+	manifest := make(map[string]string, len(rows))
+	for _, row := range rows {
+		manifest[row.ID] = row.Hash
+	}
+	return manifest
+}
+
+func datasetRecordsManifestFromState(ctx context.Context, value types.Map) (map[string]string, diag.Diagnostics) {
+	manifest := map[string]string{}
+	if value.IsNull() || value.IsUnknown() {
+		return manifest, nil
+	}
+
+	diags := value.ElementsAs(ctx, &manifest, false)
+	return manifest, diags
+}
+
+func setDatasetRecordsManifest(ctx context.Context, model *DatasetRecordsResourceModel, manifest map[string]string) diag.Diagnostics {
+	value, diags := types.MapValueFrom(ctx, types.StringType, manifest)
+	if diags.HasError() {
+		return diags
+	}
+
+	model.Manifest = value
+	model.RowCount = types.Int64Value(int64(len(manifest)))
+	return diags
+}
+
+// fetchDatasetRecordsManifest pages through every record in the dataset and
+// returns the content hash of each one.
+func fetchDatasetRecordsManifest(ctx context.Context, c *client.Client, datasetID string) (map[string]string, error) {
+	manifest := map[string]string{}
+	cursor := ""
+
+	for {
+		page, err := c.FetchDatasetEvents(ctx, datasetID, &client.FetchDatasetEventsRequest{
+			Cursor: cursor,
+			Limit:  datasetRecordsFetchPageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, event := range page.Events {
+			hash, err := datasetRecordHash(event.Input, event.Expected, event.Metadata, event.Tags)
+			if err != nil {
+				return nil, fmt.Errorf("hash record %s: %w", event.ID, err)
+			}
+			manifest[event.ID] = hash
+		}
+
+		if page.Cursor == "" || page.Cursor == cursor || len(page.Events) == 0 {
+			return manifest, nil
+		}
+		cursor = page.Cursor
+	}
+}
+
+// datasetRecordContent returns the canonical form of a record's content. Empty
+// fields are dropped and tags are sorted, so a row read from a file and the
+// same record fetched from the API produce the same hash.
+func datasetRecordContent(input, expected interface{}, metadata map[string]interface{}, tags []string) map[string]interface{} {
+	content := map[string]interface{}{}
+	if input != nil {
+		content["input"] = input
+	}
+	if expected != nil {
+		content["expected"] = expected
+	}
+	if len(metadata) > 0 {
+		content["metadata"] = metadata
+	}
+	if len(tags) > 0 {
+		sorted := append([]string(nil), tags...)
+		sort.Strings(sorted)
+		content["tags"] = sorted
+	}
+	return content
+}
+
+func datasetRecordHash(input, expected interface{}, metadata map[string]interface{}, tags []string) (string, error) {
+	return datasetRecordsSHA256(datasetRecordContent(input, expected, metadata, tags))
+}
+
+func datasetRecordsSHA256(v interface{}) (string, error) {
+	// encoding/json sorts map keys, which makes the encoding canonical.
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func resolveDatasetRecordsFormat(source, format string) (string, error) {
+	if format != "" {
+		return format, nil
+	}
+
+	switch strings.ToLower(filepath.Ext(source)) {
+	case ".jsonl", ".ndjson":
+		return datasetRecordsFormatJSONL, nil
+	case ".csv":
+		return datasetRecordsFormatCSV, nil
+	default:
+		return "", fmt.Errorf("cannot infer format from %q; set format to %q or %q", source, datasetRecordsFormatJSONL, datasetRecordsFormatCSV)
+	}
+}
+
+// readDatasetRecordsSource parses the source file and assigns each row its ID
+// and content hash.
+func readDatasetRecordsSource(source, format, idField string) ([]datasetRecordsRow, error) {
+	format, err := resolveDatasetRecordsFormat(source, format)
+	if err != nil {
+		return nil, err
+	}
+
+	// #nosec G304 -- Reading a user-configured local file is the purpose of this resource.
+	f, err := os.Open(source)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	var rows []datasetRecordsRow
+	switch format {
+	case datasetRecordsFormatCSV:
+		rows, err = parseDatasetRecordsCSV(f, idField)
+	default:
+		rows, err = parseDatasetRecordsJSONL(f, idField)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", source, err)
+	}
+
+	seen := make(map[string]int, len(rows))
+	for i := range rows {
+		row := &rows[i]
+
+		row.Hash, err = datasetRecordHash(row.Input, row.Expected, row.Metadata, row.Tags)
+		if err != nil {
+			return nil, fmt.Errorf("%s: row %d: %w", source, i+1, err)
+		}
+
+		if row.ID == "" {
+			row.ID, err = deriveDatasetRecordID(*row)
+			if err != nil {
+				return nil, fmt.Errorf("%s: row %d: %w", source, i+1, err)
+			}
+		}
+
+		if previous, ok := seen[row.ID]; ok {
+			return nil, fmt.Errorf("%s: rows %d and %d have the same ID %q", source, previous, i+1, row.ID)
+		}
+		seen[row.ID] = i + 1
+	}
+
+	return rows, nil
+}
+
+// deriveDatasetRecordID derives an ID from the row input, falling back to the
+// whole content when the row has no input.
+func deriveDatasetRecordID(row datasetRecordsRow) (string, error) {
+	if row.Input == nil {
+		return row.Hash[:32], nil
+	}
+
+	hash, err := datasetRecordsSHA256(map[string]interface{}{"input": row.Input})
+	if err != nil {
+		return "", err
+	}
+	return hash[:32], nil
+}
+
+func parseDatasetRecordsJSONL(r io.Reader, idField string) ([]datasetRecordsRow, error) {
+	var rows []datasetRecordsRow
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), datasetRecordsMaxLineBytes)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		var fields map[string]interface{}
+		if err := json.Unmarshal([]byte(text), &fields); err != nil {
+			return nil, fmt.Errorf("line %d: expected a JSON object: %w", line, err)
+		}
+
+		row, err := datasetRecordsRowFromFields(fields, idField)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		rows = append(rows, row)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+func parseDatasetRecordsCSV(r io.Reader, idField string) ([]datasetRecordsRow, error) {
+	reader := csv.NewReader(r)
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i := range header {
+		header[i] = strings.TrimSpace(header[i])
+	}
+
+	var rows []datasetRecordsRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		fields := make(map[string]interface{}, len(header))
+		for i, column := range header {
+			value, err := parseDatasetRecordsCSVCell(column, idField, record[i])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+			if value != nil {
+				fields[column] = value
+			}
+		}
+
+		row, err := datasetRecordsRowFromFields(fields, idField)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func parseDatasetRecordsCSVCell(column, idField, cell string) (interface{}, error) {
+	if strings.TrimSpace(cell) == "" {
+		return nil, nil
+	}
+
+	if column == idField {
+		return strings.TrimSpace(cell), nil
+	}
+
+	if column == "tags" && !strings.HasPrefix(strings.TrimSpace(cell), "[") {
+		var tags []interface{}
+		for _, tag := range strings.Split(cell, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				tags = append(tags, tag)
+			}
+		}
+		return tags, nil
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(cell), &decoded); err == nil {
+		return decoded, nil
+	}
+	if column == "metadata" || column == "tags" {
+		return nil, fmt.Errorf("%s must be valid JSON", column)
+	}
+
+	return cell, nil
+}
+
+func datasetRecordsRowFromFields(fields map[string]interface{}, idField string) (datasetRecordsRow, error) {
+	var row datasetRecordsRow
+
+	for key, value := range fields {
+		switch key {
+		case idField:
+			if value == nil {
+				continue
+			}
+			id, ok := value.(string)
+			if !ok || strings.TrimSpace(id) == "" {
+				return row, fmt.Errorf("%s must be a non-empty string", idField)
+			}
+			row.ID = strings.TrimSpace(id)
+		case "input":
+			row.Input = value
+		case "expected":
+			row.Expected = value
+		case "metadata":
+			if value == nil {
+				continue
+			}
+			metadata, ok := value.(map[string]interface{})
+			if !ok {
+				return row, fmt.Errorf("metadata must be a JSON object")
+			}
+			row.Metadata = metadata
+		case "tags":
+			if value == nil {
+				continue
+			}
+			values, ok := value.([]interface{})
+			if !ok {
+				return row, fmt.Errorf("tags must be a list of strings")
+			}
+			for _, v := range values {
+				tag, ok := v.(string)
+				if !ok {
+					return row, fmt.Errorf("tags must be a list of strings")
+				}
+				row.Tags = append(row.Tags, tag)
+			}
+		default:
+			return row, fmt.Errorf("unsupported field %q; expected %s, input, expected, metadata or tags", key, idField)
+		}
+	}
+
+	return row, nil
+}
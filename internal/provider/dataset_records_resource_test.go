@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatasetRecordsResource(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "golden.jsonl")
+	writeSource := func(content string) func() {
+		return func() {
+			if err := os.WriteFile(source, []byte(content), 0o600); err != nil {
+				t.Fatalf("write source: %v", err)
+			}
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: writeSource(`{"id":"case-1","input":"2+2","expected":"4"}
+{"id":"case-2","input":"3+3","expected":"6","tags":["math"]}
+`),
+				Config: testAccDatasetRecordsResourceConfig(source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("braintrustdata_dataset_records.test", "row_count", "2"),
+					resource.TestCheckResourceAttrSet("braintrustdata_dataset_records.test", "manifest.case-1"),
+					resource.TestCheckResourceAttrSet("braintrustdata_dataset_records.test", "manifest.case-2"),
+					resource.TestCheckResourceAttrPair("braintrustdata_dataset_records.test", "id", "braintrustdata_dataset.test", "id"),
+				),
+			},
+			{
+				PreConfig: writeSource(`{"id":"case-1","input":"2+2","expected":"four"}
+{"id":"case-3","input":"4+4","expected":"8"}
+`),
+				Config: testAccDatasetRecordsResourceConfig(source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("braintrustdata_dataset_records.test", "row_count", "2"),
+					resource.TestCheckResourceAttrSet("braintrustdata_dataset_records.test", "manifest.case-3"),
+					resource.TestCheckNoResourceAttr("braintrustdata_dataset_records.test", "manifest.case-2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatasetRecordsResourceConfig(source string) string {
+	return fmt.Sprintf(`
+resource "braintrustdata_project" "test" {
+  name = "test-project-for-dataset-records"
+}
+
+resource "braintrustdata_dataset" "test" {
+  project_id = braintrustdata_project.test.id
+  name       = "test-dataset-for-dataset-records"
+}
+
+resource "braintrustdata_dataset_records" "test" {
+  dataset_id = braintrustdata_dataset.test.id
+  source     = %[1]q
+  batch_size = 1
+}
+`, source)
+}
@@ -0,0 +1,249 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func writeDatasetRecordsSource(t *testing.T, name, content string) string {
+	t.Helper()
+
+	source := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(source, []byte(content), 0o600); err != nil {
+		t.Fatalf("write source: %v", err)
+	}
+	return source
+}
+
+func TestReadDatasetRecordsSource_JSONL(t *testing.T) {
+	t.Parallel()
+
+	source := writeDatasetRecordsSource(t, "golden.jsonl", strings.Join([]string{
+		`{"id":"case-1","input":{"question":"2+2"},"expected":"4","metadata":{"source":"golden"},"tags":["math","easy"]}`,
+		``,
+		`{"input":"capital of France","expected":"Paris"}`,
+	}, "\n"))
+
+	rows, err := readDatasetRecordsSource(source, "", "id")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(rows))
+	}
+
+	if rows[0].ID != "case-1" {
+		t.Fatalf("expected id case-1, got %q", rows[0].ID)
+	}
+	if !reflect.DeepEqual(rows[0].Metadata, map[string]interface{}{"source": "golden"}) {
+		t.Fatalf("unexpected metadata: %#v", rows[0].Metadata)
+	}
+	if len(rows[1].ID) != 32 {
+		t.Fatalf("expected derived 32-character id, got %q", rows[1].ID)
+	}
+
+	// The derived ID depends only on the input, so editing expected keeps it.
+	edited := writeDatasetRecordsSource(t, "golden.jsonl", `{"input":"capital of France","expected":"Paris, France"}`)
+	editedRows, err := readDatasetRecordsSource(edited, "", "id")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if editedRows[0].ID != rows[1].ID {
+		t.Fatalf("expected derived id to be stable, got %q and %q", rows[1].ID, editedRows[0].ID)
+	}
+	if editedRows[0].Hash == rows[1].Hash {
+		t.Fatal("expected content hash to change when expected changes")
+	}
+}
+
+func TestReadDatasetRecordsSource_CSV(t *testing.T) {
+	t.Parallel()
+
+	source := writeDatasetRecordsSource(t, "golden.csv", strings.Join([]string{
+		`key,input,expected,metadata,tags`,
+		`case-1,"{""question"":""2+2""}",4,"{""source"":""golden""}","math, easy"`,
+		`case-2,plain text,,,"[""geo""]"`,
+	}, "\n"))
+
+	rows, err := readDatasetRecordsSource(source, "", "key")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(rows))
+	}
+
+	if rows[0].ID != "case-1" || !reflect.DeepEqual(rows[0].Input, map[string]interface{}{"question": "2+2"}) {
+		t.Fatalf("unexpected first row: %#v", rows[0])
+	}
+	if rows[0].Expected != float64(4) {
+		t.Fatalf("expected JSON cell to be decoded, got %#v", rows[0].Expected)
+	}
+	if !reflect.DeepEqual(rows[0].Tags, []string{"math", "easy"}) {
+		t.Fatalf("unexpected tags: %#v", rows[0].Tags)
+	}
+	if rows[1].Input != "plain text" || rows[1].Expected != nil || rows[1].Metadata != nil {
+		t.Fatalf("unexpected second row: %#v", rows[1])
+	}
+	if !reflect.DeepEqual(rows[1].Tags, []string{"geo"}) {
+		t.Fatalf("unexpected tags: %#v", rows[1].Tags)
+	}
+}
+
+func TestReadDatasetRecordsSource_Errors(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		file    string
+		content string
+		format  string
+		wantErr string
+	}{
+		{
+			name:    "unknown_extension",
+			file:    "golden.txt",
+			content: `{"id":"a"}`,
+			wantErr: "cannot infer format",
+		},
+		{
+			name:    "duplicate_ids",
+			file:    "golden.jsonl",
+			content: "{\"id\":\"a\",\"input\":1}\n{\"id\":\"a\",\"input\":2}",
+			wantErr: `same ID "a"`,
+		},
+		{
+			name:    "unsupported_field",
+			file:    "golden.jsonl",
+			content: `{"id":"a","output":1}`,
+			wantErr: `unsupported field "output"`,
+		},
+		{
+			name:    "metadata_not_object",
+			file:    "golden.jsonl",
+			content: `{"id":"a","metadata":[1]}`,
+			wantErr: "metadata must be a JSON object",
+		},
+		{
+			name:    "invalid_json_line",
+			file:    "golden.jsonl",
+			content: `not json`,
+			wantErr: "line 1",
+		},
+		{
+			name:    "explicit_format_overrides_extension",
+			file:    "golden.txt",
+			content: "id,unknown\na,b",
+			format:  datasetRecordsFormatCSV,
+			wantErr: `unsupported field "unknown"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := writeDatasetRecordsSource(t, tc.file, tc.content)
+			_, err := readDatasetRecordsSource(source, tc.format, "id")
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestDatasetRecordHash_MatchesFetchedEvent(t *testing.T) {
+	t.Parallel()
+
+	source := writeDatasetRecordsSource(t, "golden.jsonl", `{"id":"a","input":{"n":1},"tags":["b","a"],"metadata":{}}`)
+	rows, err := readDatasetRecordsSource(source, "", "id")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The API returns tags in its own order and omits empty metadata.
+	hash, err := datasetRecordHash(map[string]interface{}{"n": float64(1)}, nil, nil, []string{"a", "b"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rows[0].Hash != hash {
+		t.Fatalf("hash mismatch: file=%s api=%s", rows[0].Hash, hash)
+	}
+}
+
+func TestDiffDatasetRecords(t *testing.T) {
+	t.Parallel()
+
+	rows := []datasetRecordsRow{
+		{ID: "unchanged", Hash: "h1"},
+		{ID: "changed", Hash: "h2-new"},
+		{ID: "added", Hash: "h3"},
+	}
+	manifest := map[string]string{
+		"unchanged": "h1",
+		"changed":   "h2",
+		"removed-b": "h4",
+		"removed-a": "h5",
+	}
+
+	changed, removed := diffDatasetRecords(rows, manifest)
+
+	var changedIDs []string
+	for _, row := range changed {
+		changedIDs = append(changedIDs, row.ID)
+	}
+	if !reflect.DeepEqual(changedIDs, []string{"changed", "added"}) {
+		t.Fatalf("unexpected changed rows: %v", changedIDs)
+	}
+	if !reflect.DeepEqual(removed, []string{"removed-a", "removed-b"}) {
+		t.Fatalf("unexpected removed ids: %v", removed)
+	}
+}
+
+func TestDatasetRecordsInsertEvent(t *testing.T) {
+	t.Parallel()
+
+	event := datasetRecordsInsertEvent(datasetRecordsRow{
+		ID:       "case-1",
+		Input:    "question",
+		Metadata: map[string]interface{}{},
+	})
+
+	if event.ID != "case-1" || event.IsMerge {
+		t.Fatalf("expected full insert event for case-1, got %#v", event)
+	}
+	if event.Input == nil || *event.Input != "question" {
+		t.Fatalf("expected input to be set, got %#v", event.Input)
+	}
+	if event.Expected != nil || event.Metadata != nil || event.Tags != nil {
+		t.Fatalf("expected empty fields to be omitted, got %#v", event)
+	}
+}
+
+func TestSetDatasetRecordsManifest(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var model DatasetRecordsResourceModel
+	diags := setDatasetRecordsManifest(ctx, &model, map[string]string{"a": "h1", "b": "h2"})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if model.RowCount.ValueInt64() != 2 {
+		t.Fatalf("expected row_count 2, got %s", model.RowCount)
+	}
+
+	manifest, diags := datasetRecordsManifestFromState(ctx, model.Manifest)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !reflect.DeepEqual(manifest, map[string]string{"a": "h1", "b": "h2"}) {
+		t.Fatalf("unexpected manifest: %v", manifest)
+	}
+}
@@ -37,6 +37,7 @@ type DatasetResourceModel struct {
 	Created     types.String `tfsdk:"created"`
 	UserID      types.String `tfsdk:"user_id"`
 	OrgID       types.String `tfsdk:"org_id"`
+	Version     types.String `tfsdk:"version"`
 }
 
 // Metadata implements resource.Resource.
@@ -98,6 +99,10 @@ func (r *DatasetResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The latest transaction ID (`_xact_id`) among the dataset's records. Null while the dataset is empty. Pass it to consumers that read the dataset at a pinned version.",
+			},
 		},
 	}
 }
@@ -181,6 +186,7 @@ func (r *DatasetResource) Create(ctx context.Context, req resource.CreateRequest
 		data.UserID = types.StringNull()
 	}
 	data.OrgID = types.StringValue(dataset.OrgID)
+	data.Version = types.StringNull()
 
 	// Convert metadata from Go map to Terraform Map
 	if len(dataset.Metadata) > 0 {
@@ -245,6 +251,13 @@ func (r *DatasetResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 	data.OrgID = types.StringValue(dataset.OrgID)
 
+	version, err := r.client.GetDatasetVersion(ctx, dataset.ID, "")
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read dataset version, got error: %s", err))
+		return
+	}
+	data.Version = stringOrNull(version)
+
 	// Convert metadata from Go map to Terraform Map
 	if len(dataset.Metadata) > 0 {
 		metadataStrings := make(map[string]string)
@@ -355,6 +368,13 @@ func (r *DatasetResource) Update(ctx context.Context, req resource.UpdateRequest
 	data.UserID = state.UserID
 	data.OrgID = state.OrgID
 
+	version, err := r.client.GetDatasetVersion(ctx, data.ID.ValueString(), "")
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read dataset version, got error: %s", err))
+		return
+	}
+	data.Version = stringOrNull(version)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
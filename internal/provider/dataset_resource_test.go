@@ -22,6 +22,7 @@ func TestAccDatasetResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("braintrustdata_dataset.test", "id"),
 					resource.TestCheckResourceAttrSet("braintrustdata_dataset.test", "project_id"),
 					resource.TestCheckResourceAttrSet("braintrustdata_dataset.test", "created"),
+					resource.TestCheckNoResourceAttr("braintrustdata_dataset.test", "version"),
 				),
 			},
 			// ImportState testing
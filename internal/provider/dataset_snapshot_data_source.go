@@ -0,0 +1,244 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DatasetSnapshotDataSource{}
+
+var datasetVersionPattern = regexp.MustCompile(`^[0-9]+$`)
+
+// NewDatasetSnapshotDataSource creates a new dataset snapshot data source instance.
+func NewDatasetSnapshotDataSource() datasource.DataSource {
+	return &DatasetSnapshotDataSource{}
+}
+
+// DatasetSnapshotDataSource defines the data source implementation.
+type DatasetSnapshotDataSource struct {
+	client *client.Client
+}
+
+// DatasetSnapshotDataSourceModel describes the data source data model.
+type DatasetSnapshotDataSourceModel struct {
+	DatasetID     types.String `tfsdk:"dataset_id"`
+	Version       types.String `tfsdk:"version"`
+	Timestamp     types.String `tfsdk:"timestamp"`
+	Tag           types.String `tfsdk:"tag"`
+	LatestVersion types.String `tfsdk:"latest_version"`
+}
+
+// Metadata implements datasource.DataSource.
+func (d *DatasetSnapshotDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dataset_snapshot"
+}
+
+// Schema implements datasource.DataSource.
+func (d *DatasetSnapshotDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resolves a Braintrust dataset version (`_xact_id`) to pin consumers such as experiments to. Specify at most one of `version`, `timestamp` or `tag`; the latest version is resolved when none is set.",
+		Attributes: map[string]schema.Attribute{
+			"dataset_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the dataset.",
+			},
+			"version": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The resolved dataset version. When set, it is checked against the dataset's latest version and returned unchanged.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(datasetVersionPattern, "must be a numeric transaction ID"),
+					stringvalidator.ConflictsWith(path.MatchRoot("timestamp"), path.MatchRoot("tag")),
+				},
+			},
+			"timestamp": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "RFC 3339 timestamp to resolve the version as of, for example `2026-01-31T00:00:00Z`. When the dataset's versions do not encode their write time, the version of the newest record created at or before the timestamp is used.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("tag")),
+				},
+			},
+			"tag": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Record tag to resolve. The version is the latest transaction among records carrying the tag.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"latest_version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The dataset's latest version at read time.",
+			},
+		},
+	}
+}
+
+// Configure implements datasource.DataSource.
+func (d *DatasetSnapshotDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DatasetSnapshotDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DatasetSnapshotDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	datasetID := data.DatasetID.ValueString()
+
+	latest, err := d.client.GetDatasetVersion(ctx, datasetID, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Dataset Version",
+			fmt.Sprintf("Could not read version of dataset ID %s: %s", datasetID, err.Error()),
+		)
+		return
+	}
+	if latest == "" {
+		resp.Diagnostics.AddError(
+			"Dataset Has No Versions",
+			fmt.Sprintf("Dataset ID %s has no records to resolve a version from.", datasetID),
+		)
+		return
+	}
+
+	var version string
+	switch {
+	case !data.Version.IsNull():
+		version = data.Version.ValueString()
+		cmp, err := compareDatasetVersions(version, latest)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("version"), "Invalid Dataset Version", err.Error())
+			return
+		}
+		if cmp > 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("version"),
+				"Dataset Version Not Found",
+				fmt.Sprintf("Version %s is newer than the latest version %s of dataset ID %s.", version, latest, datasetID),
+			)
+			return
+		}
+	case !data.Timestamp.IsNull():
+		at, err := time.Parse(time.RFC3339, data.Timestamp.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("timestamp"),
+				"Invalid Timestamp",
+				fmt.Sprintf("Expected an RFC 3339 timestamp, got error: %s", err),
+			)
+			return
+		}
+		version, err = datasetVersionAsOf(at, latest)
+		if errors.Is(err, client.ErrUnrecognizedVersion) {
+			// Without a write time in the version, fall back to record creation times.
+			version, err = d.client.GetDatasetVersion(ctx, datasetID, "created <= "+client.BTQLStringLiteral(at.UTC().Format(time.RFC3339)))
+			if err == nil && version == "" {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("timestamp"),
+					"Dataset Version Not Found",
+					fmt.Sprintf("Dataset ID %s has no records created at or before %s.", datasetID, data.Timestamp.ValueString()),
+				)
+				return
+			}
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Error Resolving Dataset Version", err.Error())
+			return
+		}
+	case !data.Tag.IsNull():
+		version, err = d.client.GetDatasetVersion(ctx, datasetID, "tags includes "+client.BTQLStringLiteral(data.Tag.ValueString()))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Dataset Version",
+				fmt.Sprintf("Could not resolve tag %q of dataset ID %s: %s", data.Tag.ValueString(), datasetID, err.Error()),
+			)
+			return
+		}
+		if version == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("tag"),
+				"Dataset Tag Not Found",
+				fmt.Sprintf("No record of dataset ID %s carries tag %q.", datasetID, data.Tag.ValueString()),
+			)
+			return
+		}
+	default:
+		version = latest
+	}
+
+	data.Version = types.StringValue(version)
+	data.LatestVersion = types.StringValue(latest)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// compareDatasetVersions compares two transaction IDs numerically, returning
+// -1, 0 or 1.
+func compareDatasetVersions(a, b string) (int, error) {
+	x, err := strconv.ParseUint(a, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid dataset version %q: %w", a, err)
+	}
+	y, err := strconv.ParseUint(b, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid dataset version %q: %w", b, err)
+	}
+
+	switch {
+	case x < y:
+		return -1, nil
+	case x > y:
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
+// datasetVersionAsOf returns the version to read the dataset at as of at.
+// Timestamps after the latest write resolve to the latest version itself so
+// the pin stays stable until the dataset changes. It returns
+// client.ErrUnrecognizedVersion when latest does not carry its write time.
+func datasetVersionAsOf(at time.Time, latest string) (string, error) {
+	if _, err := client.VersionTime(latest); err != nil {
+		return "", err
+	}
+	bound := client.DatasetVersionAt(at)
+
+	cmp, err := compareDatasetVersions(bound, latest)
+	if err != nil {
+		return "", err
+	}
+	if cmp > 0 {
+		return latest, nil
+	}
+
+	return bound, nil
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatasetSnapshotDataSource(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "golden.jsonl")
+	content := `{"id":"case-1","input":"2+2","expected":"4","tags":["release"]}
+{"id":"case-2","input":"3+3","expected":"6"}
+`
+	if err := os.WriteFile(source, []byte(content), 0o600); err != nil {
+		t.Fatalf("write source: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasetRecordsResourceConfig(source) + `
+data "braintrustdata_dataset_snapshot" "latest" {
+  dataset_id = braintrustdata_dataset.test.id
+  depends_on = [braintrustdata_dataset_records.test]
+}
+
+data "braintrustdata_dataset_snapshot" "pinned" {
+  dataset_id = braintrustdata_dataset.test.id
+  version    = data.braintrustdata_dataset_snapshot.latest.version
+}
+
+data "braintrustdata_dataset_snapshot" "tagged" {
+  dataset_id = braintrustdata_dataset.test.id
+  tag        = "release"
+  depends_on = [braintrustdata_dataset_records.test]
+}
+
+data "braintrustdata_dataset_snapshot" "now" {
+  dataset_id = braintrustdata_dataset.test.id
+  timestamp  = "2100-01-01T00:00:00Z"
+  depends_on = [braintrustdata_dataset_records.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.braintrustdata_dataset_snapshot.latest", "version"),
+					resource.TestCheckResourceAttrPair("data.braintrustdata_dataset_snapshot.latest", "version", "data.braintrustdata_dataset_snapshot.latest", "latest_version"),
+					resource.TestCheckResourceAttrPair("data.braintrustdata_dataset_snapshot.pinned", "version", "data.braintrustdata_dataset_snapshot.latest", "version"),
+					resource.TestCheckResourceAttrSet("data.braintrustdata_dataset_snapshot.tagged", "version"),
+					resource.TestCheckResourceAttrPair("data.braintrustdata_dataset_snapshot.now", "version", "data.braintrustdata_dataset_snapshot.latest", "version"),
+				),
+			},
+		},
+	})
+}
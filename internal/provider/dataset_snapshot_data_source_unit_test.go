@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+)
+
+func TestCompareDatasetVersions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "1000192656880881099", b: "1000192656880881099", want: 0},
+		{a: "999", b: "1000192656880881099", want: -1},
+		{a: "1000192656880893951", b: "1000192656880881099", want: 1},
+	}
+
+	for _, tt := range tests {
+		got, err := compareDatasetVersions(tt.a, tt.b)
+		if err != nil {
+			t.Fatalf("compareDatasetVersions(%q, %q) unexpected error: %v", tt.a, tt.b, err)
+		}
+		if got != tt.want {
+			t.Errorf("compareDatasetVersions(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+
+	if _, err := compareDatasetVersions("latest", "1"); err == nil {
+		t.Fatal("expected error for non-numeric version")
+	}
+}
+
+func TestDatasetVersionAsOf(t *testing.T) {
+	t.Parallel()
+
+	latest := "1000192656880881099" // 2024-03-09T07:48:38Z
+
+	version, err := datasetVersionAsOf(time.Date(2024, 3, 9, 7, 48, 37, 0, time.UTC), latest)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if version != "1000192656880828415" {
+		t.Errorf("expected bound before latest write, got %s", version)
+	}
+
+	version, err = datasetVersionAsOf(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), latest)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if version != latest {
+		t.Errorf("expected latest version %s, got %s", latest, version)
+	}
+
+	if _, err := datasetVersionAsOf(time.Now(), "12345"); !errors.Is(err, client.ErrUnrecognizedVersion) {
+		t.Errorf("expected ErrUnrecognizedVersion, got %v", err)
+	}
+}
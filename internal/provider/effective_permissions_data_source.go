@@ -0,0 +1,534 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &EffectivePermissionsDataSource{}
+
+// NewEffectivePermissionsDataSource creates a new effective permissions data source instance.
+func NewEffectivePermissionsDataSource() datasource.DataSource {
+	return &EffectivePermissionsDataSource{}
+}
+
+// EffectivePermissionsDataSource defines the data source implementation.
+type EffectivePermissionsDataSource struct {
+	client *client.Client
+}
+
+// EffectivePermissionsDataSourceModel describes the data source data model.
+type EffectivePermissionsDataSourceModel struct {
+	ID          types.String                          `tfsdk:"id"`
+	UserID      types.String                          `tfsdk:"user_id"`
+	GroupID     types.String                          `tfsdk:"group_id"`
+	ObjectID    types.String                          `tfsdk:"object_id"`
+	ObjectType  types.String                          `tfsdk:"object_type"`
+	ProjectID   types.String                          `tfsdk:"project_id"`
+	Grants      []EffectivePermissionsDataSourceGrant `tfsdk:"grants"`
+	Permissions []string                              `tfsdk:"permissions"`
+}
+
+// EffectivePermissionsDataSourceGrant describes how a single permission was granted.
+type EffectivePermissionsDataSourceGrant struct {
+	Permission    types.String `tfsdk:"permission"`
+	ACLID         types.String `tfsdk:"acl_id"`
+	ACLObjectType types.String `tfsdk:"acl_object_type"`
+	ACLObjectID   types.String `tfsdk:"acl_object_id"`
+	Path          []string     `tfsdk:"path"`
+}
+
+// effectivePermissionGrant is a resolved permission together with the chain
+// of objects, groups, and roles it was granted through.
+type effectivePermissionGrant struct {
+	Permission    string
+	ACLID         string
+	ACLObjectType string
+	ACLObjectID   string
+	Path          []string
+}
+
+// effectivePermissionsScope is an object whose ACLs can apply to the target object.
+type effectivePermissionsScope struct {
+	ObjectType string
+	ObjectID   string
+}
+
+// effectivePermissionsResolver walks groups and roles, caching every lookup
+// so shared groups and roles are only fetched once per read.
+type effectivePermissionsResolver struct {
+	getGroup func(ctx context.Context, id string) (*client.Group, error)
+	getRole  func(ctx context.Context, id string) (*client.Role, error)
+	groups   map[string]*client.Group
+	roles    map[string]*client.Role
+}
+
+// Metadata implements datasource.DataSource.
+func (d *EffectivePermissionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_effective_permissions"
+}
+
+// Schema implements datasource.DataSource.
+func (d *EffectivePermissionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resolves the permissions a user or group effectively holds on a Braintrust object. " +
+			"ACLs on the organization, the parent project, and the object itself are expanded through nested groups and inherited roles, " +
+			"honoring `restrict_object_type`. Each resolved permission is returned with the path it was granted through.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of this lookup in the format `<object_type>,<object_id>,<user|group>,<principal_id>`.",
+			},
+			"user_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The ID of the user to resolve permissions for. Exactly one of user_id or group_id must be specified.",
+			},
+			"group_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The ID of the group to resolve permissions for. Exactly one of user_id or group_id must be specified.",
+			},
+			"object_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the object to resolve permissions on.",
+			},
+			"object_type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The type of object. Valid values: organization, project, experiment, dataset, prompt, prompt_session, group, role, org_member, project_log, org_project.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"organization",
+						"project",
+						"experiment",
+						"dataset",
+						"prompt",
+						"prompt_session",
+						"group",
+						"role",
+						"org_member",
+						"project_log",
+						"org_project",
+					),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The ID of the project containing the object. Defaults to the project looked up from the object. Ignored when `object_type` is `organization` or `project`.",
+			},
+			"permissions": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The sorted, de-duplicated set of permissions the principal holds on the object.",
+			},
+			"grants": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Every path through which a permission is granted.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"permission": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The granted permission.",
+						},
+						"acl_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the ACL the grant originates from.",
+						},
+						"acl_object_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of object the originating ACL is attached to.",
+						},
+						"acl_object_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the object the originating ACL is attached to.",
+						},
+						"path": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The grant path, from the ACL through nested groups to the principal and through inherited roles to the permission, e.g. `[\"project:<id>\", \"acl:<id>\", \"group:<id>\", \"user:<id>\", \"role:<id>\", \"permission:read\"]`.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure implements datasource.DataSource.
+func (d *EffectivePermissionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *EffectivePermissionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EffectivePermissionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasUser := !data.UserID.IsNull() && data.UserID.ValueString() != ""
+	hasGroup := !data.GroupID.IsNull() && data.GroupID.ValueString() != ""
+	if hasUser == hasGroup {
+		resp.Diagnostics.AddError(
+			"Invalid Configuration",
+			"Exactly one of user_id or group_id must be specified.",
+		)
+		return
+	}
+
+	principalType := groupMemberTypeUser
+	principalID := data.UserID.ValueString()
+	if hasGroup {
+		principalType = groupMemberTypeGroup
+		principalID = data.GroupID.ValueString()
+	}
+
+	objectType := data.ObjectType.ValueString()
+	objectID := data.ObjectID.ValueString()
+
+	scopes, err := d.effectivePermissionsScopes(ctx, objectType, objectID, data.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Effective Permissions",
+			fmt.Sprintf("Could not resolve the parents of %s %s: %s", objectType, objectID, err.Error()),
+		)
+		return
+	}
+
+	resolver := newEffectivePermissionsResolver(d.client)
+
+	var grants []effectivePermissionGrant
+	for _, scope := range scopes {
+		acls, err := listAllObjectACLs(ctx, d.client, scope.ObjectID, scope.ObjectType)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Effective Permissions",
+				fmt.Sprintf("Could not list ACLs for %s %s: %s", scope.ObjectType, scope.ObjectID, err.Error()),
+			)
+			return
+		}
+
+		for i := range acls {
+			aclGrants, err := resolver.grantsFromACL(ctx, &acls[i], objectType, principalType, principalID)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error Reading Effective Permissions",
+					fmt.Sprintf("Could not expand ACL %s: %s", acls[i].ID, err.Error()),
+				)
+				return
+			}
+			grants = append(grants, aclGrants...)
+		}
+	}
+
+	data.ID = types.StringValue(strings.Join([]string{objectType, objectID, principalType, principalID}, ","))
+	data.Permissions = effectivePermissionSet(grants)
+	data.Grants = make([]EffectivePermissionsDataSourceGrant, 0, len(grants))
+	for _, grant := range grants {
+		data.Grants = append(data.Grants, EffectivePermissionsDataSourceGrant{
+			Permission:    types.StringValue(grant.Permission),
+			ACLID:         types.StringValue(grant.ACLID),
+			ACLObjectType: types.StringValue(grant.ACLObjectType),
+			ACLObjectID:   types.StringValue(grant.ACLObjectID),
+			Path:          grant.Path,
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// effectivePermissionsScopes returns the organization, the parent project, and
+// the object itself, in that order. The parent project is looked up when
+// projectID is empty, and an organization that cannot be determined is an
+// error, so that no inherited ACLs are skipped.
+func (d *EffectivePermissionsDataSource) effectivePermissionsScopes(ctx context.Context, objectType, objectID, projectID string) ([]effectivePermissionsScope, error) {
+	if objectType == string(client.ACLObjectTypeOrganization) {
+		return []effectivePermissionsScope{{ObjectType: objectType, ObjectID: objectID}}, nil
+	}
+
+	if projectID == "" {
+		var err error
+		projectID, err = d.effectivePermissionsParentProject(ctx, objectType, objectID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	orgID := d.client.OrgID()
+	if projectID != "" {
+		project, err := d.client.GetProject(ctx, projectID)
+		if err != nil {
+			return nil, err
+		}
+		if project.OrgID != "" {
+			orgID = project.OrgID
+		}
+	}
+
+	return buildEffectivePermissionsScopes(orgID, projectID, objectType, objectID)
+}
+
+// effectivePermissionsParentProject returns the ID of the project that
+// contains the object, or an empty string for objects that belong directly to
+// the organization.
+func (d *EffectivePermissionsDataSource) effectivePermissionsParentProject(ctx context.Context, objectType, objectID string) (string, error) {
+	switch client.ACLObjectType(objectType) {
+	case client.ACLObjectTypeProject, client.ACLObjectTypeProjectLog:
+		return objectID, nil
+	case client.ACLObjectTypeExperiment:
+		experiment, err := d.client.GetExperiment(ctx, objectID)
+		if err != nil {
+			return "", err
+		}
+		return experiment.ProjectID, nil
+	case client.ACLObjectTypeDataset:
+		dataset, err := d.client.GetDataset(ctx, objectID)
+		if err != nil {
+			return "", err
+		}
+		return dataset.ProjectID, nil
+	case client.ACLObjectTypePrompt:
+		prompt, err := d.client.GetPrompt(ctx, objectID)
+		if err != nil {
+			return "", err
+		}
+		return prompt.ProjectID, nil
+	case client.ACLObjectTypePromptSession:
+		session, err := d.client.GetPromptSession(ctx, objectID)
+		if err != nil {
+			return "", err
+		}
+		return session.ProjectID, nil
+	default:
+		return "", nil
+	}
+}
+
+func buildEffectivePermissionsScopes(orgID, projectID, objectType, objectID string) ([]effectivePermissionsScope, error) {
+	if orgID == "" {
+		return nil, fmt.Errorf("the organization ID is unknown; set organization_id on the provider so organization-level ACLs can be included")
+	}
+	if effectivePermissionsProjectScoped(objectType) && projectID == "" {
+		return nil, fmt.Errorf("could not determine the project containing %s %s", objectType, objectID)
+	}
+
+	scopes := []effectivePermissionsScope{{ObjectType: string(client.ACLObjectTypeOrganization), ObjectID: orgID}}
+	if projectID != "" && objectType != string(client.ACLObjectTypeProject) {
+		scopes = append(scopes, effectivePermissionsScope{ObjectType: string(client.ACLObjectTypeProject), ObjectID: projectID})
+	}
+	return append(scopes, effectivePermissionsScope{ObjectType: objectType, ObjectID: objectID}), nil
+}
+
+// effectivePermissionsProjectScoped reports whether objects of the given type
+// always belong to a project.
+func effectivePermissionsProjectScoped(objectType string) bool {
+	switch client.ACLObjectType(objectType) {
+	case client.ACLObjectTypeProject,
+		client.ACLObjectTypeProjectLog,
+		client.ACLObjectTypeExperiment,
+		client.ACLObjectTypeDataset,
+		client.ACLObjectTypePrompt,
+		client.ACLObjectTypePromptSession:
+		return true
+	default:
+		return false
+	}
+}
+
+func newEffectivePermissionsResolver(c *client.Client) *effectivePermissionsResolver {
+	return &effectivePermissionsResolver{
+		getGroup: c.GetGroup,
+		getRole:  c.GetRole,
+		groups:   map[string]*client.Group{},
+		roles:    map[string]*client.Role{},
+	}
+}
+
+// grantsFromACL returns the grants an ACL confers on the principal for an
+// object of the given type, or nil when the ACL does not apply.
+func (r *effectivePermissionsResolver) grantsFromACL(ctx context.Context, acl *client.ACL, objectType, principalType, principalID string) ([]effectivePermissionGrant, error) {
+	if !restrictionApplies(string(acl.RestrictObjectType), objectType) {
+		return nil, nil
+	}
+
+	var principalPath []string
+	switch {
+	case acl.UserID != "":
+		if principalType != groupMemberTypeUser || acl.UserID != principalID {
+			return nil, nil
+		}
+		principalPath = []string{"user:" + acl.UserID}
+	case acl.GroupID != "":
+		path, ok, err := r.groupPath(ctx, acl.GroupID, principalType, principalID, map[string]bool{})
+		if err != nil || !ok {
+			return nil, err
+		}
+		principalPath = path
+	default:
+		return nil, nil
+	}
+
+	prefix := append([]string{string(acl.ObjectType) + ":" + acl.ObjectID, "acl:" + acl.ID}, principalPath...)
+	newGrant := func(permission string, rolePath []string) effectivePermissionGrant {
+		path := slices.Concat(prefix, rolePath, []string{"permission:" + permission})
+		return effectivePermissionGrant{
+			Permission:    permission,
+			ACLID:         acl.ID,
+			ACLObjectType: string(acl.ObjectType),
+			ACLObjectID:   acl.ObjectID,
+			Path:          path,
+		}
+	}
+
+	if acl.Permission != "" {
+		return []effectivePermissionGrant{newGrant(string(acl.Permission), nil)}, nil
+	}
+	if acl.RoleID == "" {
+		return nil, nil
+	}
+
+	var grants []effectivePermissionGrant
+	err := r.walkRole(ctx, acl.RoleID, objectType, nil, map[string]bool{}, func(permission string, rolePath []string) {
+		grants = append(grants, newGrant(permission, rolePath))
+	})
+	return grants, err
+}
+
+// groupPath reports whether the principal belongs to the group, directly or
+// through member_groups, and returns the chain of groups leading to it.
+func (r *effectivePermissionsResolver) groupPath(ctx context.Context, groupID, principalType, principalID string, visited map[string]bool) ([]string, bool, error) {
+	if principalType == groupMemberTypeGroup && groupID == principalID {
+		return []string{"group:" + groupID}, true, nil
+	}
+	if visited[groupID] {
+		return nil, false, nil
+	}
+	visited[groupID] = true
+
+	group, err := r.group(ctx, groupID)
+	if err != nil || group == nil {
+		return nil, false, err
+	}
+
+	if principalType == groupMemberTypeUser && slices.Contains(group.MemberUsers, principalID) {
+		return []string{"group:" + groupID, "user:" + principalID}, true, nil
+	}
+
+	for _, memberGroupID := range group.MemberGroups {
+		path, ok, err := r.groupPath(ctx, memberGroupID, principalType, principalID, visited)
+		if err != nil {
+			return nil, false, err
+		}
+		if ok {
+			return append([]string{"group:" + groupID}, path...), true, nil
+		}
+	}
+
+	return nil, false, nil
+}
+
+// walkRole calls grant for every permission the role confers on an object of
+// the given type, following member_roles and skipping roles already visited.
+func (r *effectivePermissionsResolver) walkRole(ctx context.Context, roleID, objectType string, path []string, visited map[string]bool, grant func(permission string, rolePath []string)) error {
+	if visited[roleID] {
+		return nil
+	}
+	visited[roleID] = true
+
+	role, err := r.role(ctx, roleID)
+	if err != nil || role == nil {
+		return err
+	}
+
+	rolePath := append(slices.Clone(path), "role:"+roleID)
+	for _, memberPermission := range role.MemberPermissions {
+		if restrictionApplies(memberPermission.RestrictObjectType, objectType) {
+			grant(memberPermission.Permission, rolePath)
+		}
+	}
+	for _, memberRoleID := range role.MemberRoles {
+		if err := r.walkRole(ctx, memberRoleID, objectType, rolePath, visited, grant); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// group returns the group with the given ID, or nil if it no longer exists.
+func (r *effectivePermissionsResolver) group(ctx context.Context, id string) (*client.Group, error) {
+	if group, ok := r.groups[id]; ok {
+		return group, nil
+	}
+
+	group, err := r.getGroup(ctx, id)
+	if err != nil {
+		if !client.IsNotFound(err) {
+			return nil, err
+		}
+		group = nil
+	} else if group.DeletedAt != "" {
+		group = nil
+	}
+
+	r.groups[id] = group
+	return group, nil
+}
+
+// role returns the role with the given ID, or nil if it no longer exists.
+func (r *effectivePermissionsResolver) role(ctx context.Context, id string) (*client.Role, error) {
+	if role, ok := r.roles[id]; ok {
+		return role, nil
+	}
+
+	role, err := r.getRole(ctx, id)
+	if err != nil {
+		if !client.IsNotFound(err) {
+			return nil, err
+		}
+		role = nil
+	} else if role.DeletedAt != "" {
+		role = nil
+	}
+
+	r.roles[id] = role
+	return role, nil
+}
+
+// restrictionApplies reports whether a grant restricted to restrictObjectType
+// applies to an object of objectType. Unrestricted grants always apply.
+func restrictionApplies(restrictObjectType, objectType string) bool {
+	return restrictObjectType == "" || restrictObjectType == objectType
+}
+
+func effectivePermissionSet(grants []effectivePermissionGrant) []string {
+	permissions := make([]string, 0, len(grants))
+	for _, grant := range grants {
+		if !slices.Contains(permissions, grant.Permission) {
+			permissions = append(permissions, grant.Permission)
+		}
+	}
+	sort.Strings(permissions)
+	return permissions
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEffectivePermissionsDataSource_NestedGroupRole(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEffectivePermissionsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.braintrustdata_effective_permissions.test", "id"),
+					resource.TestCheckTypeSetElemAttr("data.braintrustdata_effective_permissions.test", "permissions.*", "read"),
+					resource.TestCheckTypeSetElemAttr("data.braintrustdata_effective_permissions.test", "permissions.*", "update"),
+					resource.TestCheckResourceAttrPair("data.braintrustdata_effective_permissions.test", "grants.0.acl_id", "braintrustdata_acl.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEffectivePermissionsDataSourceConfig() string {
+	return `
+resource "braintrustdata_project" "test" {
+  name        = "test-effective-permissions-project"
+  description = "Project for effective permissions testing"
+}
+
+resource "braintrustdata_group" "child" {
+  name        = "test-effective-permissions-child"
+  description = "Group nested in the granted group"
+}
+
+resource "braintrustdata_group" "parent" {
+  name          = "test-effective-permissions-parent"
+  description   = "Group granted a role on the project"
+  member_groups = [braintrustdata_group.child.id]
+}
+
+resource "braintrustdata_role" "viewer" {
+  name               = "test-effective-permissions-viewer"
+  member_permissions = [{ permission = "read" }]
+}
+
+resource "braintrustdata_role" "editor" {
+  name               = "test-effective-permissions-editor"
+  member_permissions = [{ permission = "update" }]
+  member_roles       = [braintrustdata_role.viewer.id]
+}
+
+resource "braintrustdata_acl" "test" {
+  object_id   = braintrustdata_project.test.id
+  object_type = "project"
+  group_id    = braintrustdata_group.parent.id
+  role_id     = braintrustdata_role.editor.id
+}
+
+data "braintrustdata_effective_permissions" "test" {
+  group_id    = braintrustdata_group.child.id
+  object_id   = braintrustdata_project.test.id
+  object_type = "project"
+
+  depends_on = [braintrustdata_acl.test]
+}
+`
+}
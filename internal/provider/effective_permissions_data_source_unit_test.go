@@ -0,0 +1,258 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+)
+
+func testEffectivePermissionsResolver(groups map[string]*client.Group, roles map[string]*client.Role) *effectivePermissionsResolver {
+	notFound := &client.APIError{StatusCode: 404, Message: "not found"}
+	return &effectivePermissionsResolver{
+		getGroup: func(_ context.Context, id string) (*client.Group, error) {
+			if group, ok := groups[id]; ok {
+				return group, nil
+			}
+			return nil, notFound
+		},
+		getRole: func(_ context.Context, id string) (*client.Role, error) {
+			if role, ok := roles[id]; ok {
+				return role, nil
+			}
+			return nil, notFound
+		},
+		groups: map[string]*client.Group{},
+		roles:  map[string]*client.Role{},
+	}
+}
+
+func TestEffectivePermissionsResolverGrantsFromACL(t *testing.T) {
+	t.Parallel()
+
+	groups := map[string]*client.Group{
+		"group-parent": {ID: "group-parent", MemberGroups: []string{"group-child"}},
+		"group-child":  {ID: "group-child", MemberUsers: []string{"user-1"}},
+		"group-cycle":  {ID: "group-cycle", MemberGroups: []string{"group-cycle"}},
+	}
+	roles := map[string]*client.Role{
+		"role-editor": {
+			ID: "role-editor",
+			MemberPermissions: []client.RoleMemberPermission{
+				{Permission: "update"},
+				{Permission: "delete", RestrictObjectType: "dataset"},
+			},
+			MemberRoles: []string{"role-viewer"},
+		},
+		"role-viewer": {
+			ID:                "role-viewer",
+			MemberPermissions: []client.RoleMemberPermission{{Permission: "read"}},
+			MemberRoles:       []string{"role-editor"},
+		},
+	}
+
+	testCases := map[string]struct {
+		acl           client.ACL
+		objectType    string
+		principalType string
+		principalID   string
+		want          []effectivePermissionGrant
+	}{
+		"direct user permission": {
+			acl:           client.ACL{ID: "acl-1", ObjectType: "project", ObjectID: "project-1", UserID: "user-1", Permission: "read"},
+			objectType:    "project",
+			principalType: groupMemberTypeUser,
+			principalID:   "user-1",
+			want: []effectivePermissionGrant{
+				{
+					Permission: "read", ACLID: "acl-1", ACLObjectType: "project", ACLObjectID: "project-1",
+					Path: []string{"project:project-1", "acl:acl-1", "user:user-1", "permission:read"},
+				},
+			},
+		},
+		"other user": {
+			acl:           client.ACL{ID: "acl-1", ObjectType: "project", ObjectID: "project-1", UserID: "user-2", Permission: "read"},
+			objectType:    "project",
+			principalType: groupMemberTypeUser,
+			principalID:   "user-1",
+		},
+		"nested group with inherited roles": {
+			acl:           client.ACL{ID: "acl-2", ObjectType: "organization", ObjectID: "org-1", GroupID: "group-parent", RoleID: "role-editor"},
+			objectType:    "project",
+			principalType: groupMemberTypeUser,
+			principalID:   "user-1",
+			want: []effectivePermissionGrant{
+				{
+					Permission: "update", ACLID: "acl-2", ACLObjectType: "organization", ACLObjectID: "org-1",
+					Path: []string{"organization:org-1", "acl:acl-2", "group:group-parent", "group:group-child", "user:user-1", "role:role-editor", "permission:update"},
+				},
+				{
+					Permission: "read", ACLID: "acl-2", ACLObjectType: "organization", ACLObjectID: "org-1",
+					Path: []string{"organization:org-1", "acl:acl-2", "group:group-parent", "group:group-child", "user:user-1", "role:role-editor", "role:role-viewer", "permission:read"},
+				},
+			},
+		},
+		"group principal nested in granted group": {
+			acl:           client.ACL{ID: "acl-3", ObjectType: "project", ObjectID: "project-1", GroupID: "group-parent", Permission: "read"},
+			objectType:    "project",
+			principalType: groupMemberTypeGroup,
+			principalID:   "group-child",
+			want: []effectivePermissionGrant{
+				{
+					Permission: "read", ACLID: "acl-3", ACLObjectType: "project", ACLObjectID: "project-1",
+					Path: []string{"project:project-1", "acl:acl-3", "group:group-parent", "group:group-child", "permission:read"},
+				},
+			},
+		},
+		"acl restricted to another object type": {
+			acl:           client.ACL{ID: "acl-4", ObjectType: "project", ObjectID: "project-1", UserID: "user-1", Permission: "read", RestrictObjectType: "dataset"},
+			objectType:    "experiment",
+			principalType: groupMemberTypeUser,
+			principalID:   "user-1",
+		},
+		"role permission restricted to matching object type": {
+			acl:           client.ACL{ID: "acl-5", ObjectType: "project", ObjectID: "project-1", UserID: "user-1", RoleID: "role-editor"},
+			objectType:    "dataset",
+			principalType: groupMemberTypeUser,
+			principalID:   "user-1",
+			want: []effectivePermissionGrant{
+				{
+					Permission: "update", ACLID: "acl-5", ACLObjectType: "project", ACLObjectID: "project-1",
+					Path: []string{"project:project-1", "acl:acl-5", "user:user-1", "role:role-editor", "permission:update"},
+				},
+				{
+					Permission: "delete", ACLID: "acl-5", ACLObjectType: "project", ACLObjectID: "project-1",
+					Path: []string{"project:project-1", "acl:acl-5", "user:user-1", "role:role-editor", "permission:delete"},
+				},
+				{
+					Permission: "read", ACLID: "acl-5", ACLObjectType: "project", ACLObjectID: "project-1",
+					Path: []string{"project:project-1", "acl:acl-5", "user:user-1", "role:role-editor", "role:role-viewer", "permission:read"},
+				},
+			},
+		},
+		"group cycle without the principal": {
+			acl:           client.ACL{ID: "acl-6", ObjectType: "project", ObjectID: "project-1", GroupID: "group-cycle", Permission: "read"},
+			objectType:    "project",
+			principalType: groupMemberTypeUser,
+			principalID:   "user-1",
+		},
+		"missing group": {
+			acl:           client.ACL{ID: "acl-7", ObjectType: "project", ObjectID: "project-1", GroupID: "group-missing", Permission: "read"},
+			objectType:    "project",
+			principalType: groupMemberTypeUser,
+			principalID:   "user-1",
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resolver := testEffectivePermissionsResolver(groups, roles)
+			got, err := resolver.grantsFromACL(context.Background(), &tc.acl, tc.objectType, tc.principalType, tc.principalID)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("grants mismatch:\ngot  %#v\nwant %#v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBuildEffectivePermissionsScopes(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		orgID      string
+		projectID  string
+		objectType string
+		objectID   string
+		want       []effectivePermissionsScope
+		wantErr    bool
+	}{
+		"project": {
+			orgID:      "org-1",
+			projectID:  "project-1",
+			objectType: "project",
+			objectID:   "project-1",
+			want: []effectivePermissionsScope{
+				{ObjectType: "organization", ObjectID: "org-1"},
+				{ObjectType: "project", ObjectID: "project-1"},
+			},
+		},
+		"dataset in project": {
+			orgID:      "org-1",
+			projectID:  "project-1",
+			objectType: "dataset",
+			objectID:   "dataset-1",
+			want: []effectivePermissionsScope{
+				{ObjectType: "organization", ObjectID: "org-1"},
+				{ObjectType: "project", ObjectID: "project-1"},
+				{ObjectType: "dataset", ObjectID: "dataset-1"},
+			},
+		},
+		"org-level object": {
+			orgID:      "org-1",
+			objectType: "group",
+			objectID:   "group-1",
+			want: []effectivePermissionsScope{
+				{ObjectType: "organization", ObjectID: "org-1"},
+				{ObjectType: "group", ObjectID: "group-1"},
+			},
+		},
+		"unknown organization": {
+			projectID:  "project-1",
+			objectType: "dataset",
+			objectID:   "dataset-1",
+			wantErr:    true,
+		},
+		"unknown project": {
+			orgID:      "org-1",
+			objectType: "dataset",
+			objectID:   "dataset-1",
+			wantErr:    true,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := buildEffectivePermissionsScopes(tc.orgID, tc.projectID, tc.objectType, tc.objectID)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got scopes %#v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("scopes mismatch: got %#v, want %#v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestEffectivePermissionSet(t *testing.T) {
+	t.Parallel()
+
+	got := effectivePermissionSet([]effectivePermissionGrant{
+		{Permission: "update"},
+		{Permission: "read"},
+		{Permission: "update"},
+	})
+	want := []string{"read", "update"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+
+	if got := effectivePermissionSet(nil); len(got) != 0 {
+		t.Fatalf("expected empty permission set, got %#v", got)
+	}
+}
@@ -0,0 +1,264 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &EnvironmentResource{}
+var _ resource.ResourceWithImportState = &EnvironmentResource{}
+
+// NewEnvironmentResource creates a new environment resource instance.
+func NewEnvironmentResource() resource.Resource {
+	return &EnvironmentResource{}
+}
+
+// EnvironmentResource defines the resource implementation.
+type EnvironmentResource struct {
+	client *client.Client
+}
+
+// EnvironmentResourceModel describes the resource data model.
+type EnvironmentResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Slug        types.String `tfsdk:"slug"`
+	Description types.String `tfsdk:"description"`
+	OrgID       types.String `tfsdk:"org_id"`
+	Created     types.String `tfsdk:"created"`
+}
+
+// Metadata implements resource.Resource.
+func (r *EnvironmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment"
+}
+
+// Schema implements resource.Resource.
+func (r *EnvironmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Braintrust environment, such as `dev`, `staging` or `production`. Use `braintrustdata_prompt_environment_binding` to point an environment at a prompt or function version.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the environment.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The environment name.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"slug": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The environment slug, unique within the organization. Bindings and SDK lookups refer to the environment by slug.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A description of the environment.",
+			},
+			"org_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the organization the environment belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The timestamp when the environment was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure implements resource.Resource.
+func (r *EnvironmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// Create implements resource.Resource.
+func (r *EnvironmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data EnvironmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environment, err := r.client.CreateEnvironment(ctx, buildCreateEnvironmentRequest(data))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to create environment, got error: %s", err),
+		)
+		return
+	}
+
+	setEnvironmentResourceModel(&data, environment)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read implements resource.Resource.
+func (r *EnvironmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EnvironmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environment, err := r.client.GetEnvironment(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read environment, got error: %s", err),
+		)
+		return
+	}
+	if environment.DeletedAt != "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setEnvironmentResourceModel(&data, environment)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource.
+func (r *EnvironmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan EnvironmentResourceModel
+	var state EnvironmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq := buildUpdateEnvironmentRequest(plan, state)
+	if hasEnvironmentUpdateChanges(updateReq) {
+		if _, err := r.client.UpdateEnvironment(ctx, state.ID.ValueString(), updateReq); err != nil {
+			if client.IsNotFound(err) {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to update environment, got error: %s", err),
+			)
+			return
+		}
+	}
+
+	environment, err := r.client.GetEnvironment(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read environment after update, got error: %s", err),
+		)
+		return
+	}
+
+	setEnvironmentResourceModel(&plan, environment)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete implements resource.Resource.
+func (r *EnvironmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data EnvironmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteEnvironment(ctx, data.ID.ValueString()); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to delete environment, got error: %s", err),
+		)
+	}
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (r *EnvironmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func buildCreateEnvironmentRequest(model EnvironmentResourceModel) *client.CreateEnvironmentRequest {
+	req := &client.CreateEnvironmentRequest{
+		Name: model.Name.ValueString(),
+		Slug: model.Slug.ValueString(),
+	}
+	if !model.Description.IsNull() && !model.Description.IsUnknown() {
+		req.Description = model.Description.ValueString()
+	}
+
+	return req
+}
+
+func buildUpdateEnvironmentRequest(plan, state EnvironmentResourceModel) *client.UpdateEnvironmentRequest {
+	req := &client.UpdateEnvironmentRequest{}
+
+	if !plan.Name.IsUnknown() && !plan.Name.Equal(state.Name) {
+		v := plan.Name.ValueString()
+		req.Name = &v
+	}
+	if !plan.Slug.IsUnknown() && !plan.Slug.Equal(state.Slug) {
+		v := plan.Slug.ValueString()
+		req.Slug = &v
+	}
+	req.Description = changedStringPointer(plan.Description, state.Description)
+
+	return req
+}
+
+func hasEnvironmentUpdateChanges(req *client.UpdateEnvironmentRequest) bool {
+	return req.Name != nil || req.Slug != nil || req.Description != nil
+}
+
+func setEnvironmentResourceModel(model *EnvironmentResourceModel, environment *client.Environment) {
+	model.ID = stringOrNull(environment.ID)
+	model.Name = stringOrNull(environment.Name)
+	model.Slug = stringOrNull(environment.Slug)
+	model.Description = stringOrNull(environment.Description)
+	model.OrgID = stringOrNull(environment.OrgID)
+	model.Created = stringOrNull(environment.Created)
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEnvironmentResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentResourceConfig("Test Staging", `description = "Pre-production checks"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("braintrustdata_environment.test", "name", "Test Staging"),
+					resource.TestCheckResourceAttr("braintrustdata_environment.test", "slug", "tf-acc-staging"),
+					resource.TestCheckResourceAttr("braintrustdata_environment.test", "description", "Pre-production checks"),
+					resource.TestCheckResourceAttrSet("braintrustdata_environment.test", "id"),
+					resource.TestCheckResourceAttrSet("braintrustdata_environment.test", "created"),
+				),
+			},
+			{
+				Config: testAccEnvironmentResourceConfig("Test Staging Renamed", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("braintrustdata_environment.test", "name", "Test Staging Renamed"),
+					resource.TestCheckNoResourceAttr("braintrustdata_environment.test", "description"),
+				),
+			},
+			{
+				ResourceName:      "braintrustdata_environment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccEnvironmentResourceConfig(name, extra string) string {
+	return fmt.Sprintf(`
+resource "braintrustdata_environment" "test" {
+  name = %q
+  slug = "tf-acc-staging"
+  %s
+}
+`, name, extra)
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBuildCreateEnvironmentRequest(t *testing.T) {
+	t.Parallel()
+
+	req := buildCreateEnvironmentRequest(EnvironmentResourceModel{
+		Name:        types.StringValue("Production"),
+		Slug:        types.StringValue("production"),
+		Description: types.StringNull(),
+	})
+
+	if req.Name != "Production" || req.Slug != "production" {
+		t.Fatalf("unexpected name/slug: %q/%q", req.Name, req.Slug)
+	}
+	if req.Description != "" {
+		t.Fatalf("expected empty description, got %q", req.Description)
+	}
+}
+
+func TestBuildUpdateEnvironmentRequest(t *testing.T) {
+	t.Parallel()
+
+	state := EnvironmentResourceModel{
+		Name:        types.StringValue("Production"),
+		Slug:        types.StringValue("production"),
+		Description: types.StringValue("Live traffic"),
+	}
+
+	req := buildUpdateEnvironmentRequest(state, state)
+	if hasEnvironmentUpdateChanges(req) {
+		t.Fatalf("expected no changes, got %+v", req)
+	}
+
+	plan := state
+	plan.Slug = types.StringValue("prod")
+	plan.Description = types.StringNull()
+
+	req = buildUpdateEnvironmentRequest(plan, state)
+	if req.Name != nil {
+		t.Fatalf("expected name to be unchanged, got %q", *req.Name)
+	}
+	if req.Slug == nil || *req.Slug != "prod" {
+		t.Fatalf("expected slug prod, got %v", req.Slug)
+	}
+	if req.Description == nil || *req.Description != "" {
+		t.Fatalf("expected description to be cleared, got %v", req.Description)
+	}
+}
+
+func TestSetEnvironmentResourceModel(t *testing.T) {
+	t.Parallel()
+
+	var model EnvironmentResourceModel
+	setEnvironmentResourceModel(&model, &client.Environment{
+		ID:      "env-123",
+		OrgID:   "org-123",
+		Name:    "Production",
+		Slug:    "production",
+		Created: "2026-01-01T00:00:00Z",
+	})
+
+	if model.ID.ValueString() != "env-123" || model.OrgID.ValueString() != "org-123" {
+		t.Fatalf("unexpected id/org_id: %s/%s", model.ID, model.OrgID)
+	}
+	if !model.Description.IsNull() {
+		t.Fatalf("expected description to be null, got %s", model.Description)
+	}
+}
@@ -0,0 +1,410 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ExperimentCopyResource{}
+
+// experimentCopyPageSize is the number of events fetched and inserted per
+// request when copying an experiment.
+const experimentCopyPageSize = 200
+
+// NewExperimentCopyResource creates a new experiment copy resource instance.
+func NewExperimentCopyResource() resource.Resource {
+	return &ExperimentCopyResource{}
+}
+
+// ExperimentCopyResource defines the resource implementation.
+type ExperimentCopyResource struct {
+	client *client.Client
+}
+
+// ExperimentCopyResourceModel describes the resource data model.
+type ExperimentCopyResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	SourceExperimentID types.String `tfsdk:"source_experiment_id"`
+	SourceVersion      types.String `tfsdk:"source_version"`
+	ProjectID          types.String `tfsdk:"project_id"`
+	Name               types.String `tfsdk:"name"`
+	Description        types.String `tfsdk:"description"`
+	Created            types.String `tfsdk:"created"`
+	EventCount         types.Int64  `tfsdk:"event_count"`
+}
+
+// Metadata implements resource.Resource.
+func (r *ExperimentCopyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_experiment_copy"
+}
+
+// Schema implements resource.Resource.
+func (r *ExperimentCopyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates a frozen copy of a Braintrust experiment, for example to keep the current production experiment as the baseline of a prompt branch. The copy takes the source's metadata, tags, repo_info and dataset, and its events are copied once on create. Changing the source forces a new copy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the copied experiment.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_experiment_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the experiment to copy.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_version": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The version (`_xact_id`) of the source experiment to copy. Defaults to the latest version when the copy starts, which is recorded here.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(datasetVersionPattern, "must be a numeric transaction ID"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the project to create the copy in. Defaults to the source experiment's project.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the copied experiment. It must not already be taken in the project.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A description of the copied experiment.",
+			},
+			"created": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The timestamp when the copy was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"event_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of events copied from the source experiment.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure implements resource.Resource.
+func (r *ExperimentCopyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create implements resource.Resource.
+func (r *ExperimentCopyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ExperimentCopyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	source, err := r.client.GetExperiment(ctx, data.SourceExperimentID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read source experiment, got error: %s", err),
+		)
+		return
+	}
+
+	// Pin the copy to one version of the source, so events written while the
+	// copy runs are not mixed into it.
+	version := data.SourceVersion.ValueString()
+	if data.SourceVersion.IsUnknown() || data.SourceVersion.IsNull() {
+		version, err = r.client.GetExperimentVersion(ctx, source.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to read the latest version of the source experiment, got error: %s", err),
+			)
+			return
+		}
+	}
+
+	experiment, err := r.client.CreateExperiment(ctx, buildCreateExperimentCopyRequest(data, source))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to create experiment copy, got error: %s", err),
+		)
+		return
+	}
+
+	// ensure_new renames the copy instead of failing when the name is taken.
+	if experiment.Name != data.Name.ValueString() {
+		r.deleteIncompleteCopy(ctx, experiment.ID, resp)
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Experiment Name Taken",
+			fmt.Sprintf("An experiment named %q already exists in project %s.", data.Name.ValueString(), experiment.ProjectID),
+		)
+		return
+	}
+
+	count, err := r.copyExperimentEvents(ctx, source.ID, experiment.ID, version)
+	if err != nil {
+		r.deleteIncompleteCopy(ctx, experiment.ID, resp)
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to copy events of experiment %s, got error: %s", source.ID, err),
+		)
+		return
+	}
+
+	experiment, err = r.client.GetExperiment(ctx, experiment.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read experiment copy after creation, got error: %s", err),
+		)
+		return
+	}
+
+	setExperimentCopyResourceModel(&data, experiment)
+	data.EventCount = types.Int64Value(int64(count))
+	data.SourceVersion = stringOrNull(version)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read implements resource.Resource.
+func (r *ExperimentCopyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ExperimentCopyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	experiment, err := r.client.GetExperiment(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read experiment copy, got error: %s", err),
+		)
+		return
+	}
+
+	if experiment.DeletedAt != "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setExperimentCopyResourceModel(&data, experiment)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource. Only the name and description of the
+// copy can change in place.
+func (r *ExperimentCopyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ExperimentCopyResourceModel
+	var state ExperimentCopyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Name.Equal(state.Name) || !plan.Description.Equal(state.Description) {
+		_, err := r.client.UpdateExperiment(ctx, state.ID.ValueString(), &client.UpdateExperimentRequest{
+			Name:        plan.Name.ValueString(),
+			Description: changedStringPointer(plan.Description, state.Description),
+		})
+		if err != nil {
+			if client.IsNotFound(err) {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to update experiment copy, got error: %s", err),
+			)
+			return
+		}
+	}
+
+	experiment, err := r.client.GetExperiment(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read experiment copy after update, got error: %s", err),
+		)
+		return
+	}
+
+	setExperimentCopyResourceModel(&plan, experiment)
+	plan.SourceVersion = state.SourceVersion
+	plan.EventCount = state.EventCount
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete implements resource.Resource.
+func (r *ExperimentCopyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ExperimentCopyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteExperiment(ctx, data.ID.ValueString()); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to delete experiment copy, got error: %s", err),
+		)
+	}
+}
+
+// copyExperimentEvents copies the events of the source experiment, as of
+// version when set, into the target experiment page by page. It returns the
+// number of copied events.
+func (r *ExperimentCopyResource) copyExperimentEvents(ctx context.Context, sourceID, targetID, version string) (int, error) {
+	count := 0
+	cursor := ""
+
+	for {
+		page, err := r.client.FetchExperimentEvents(ctx, sourceID, &client.FetchExperimentEventsRequest{
+			Cursor:  cursor,
+			Version: version,
+			Limit:   experimentCopyPageSize,
+		})
+		if err != nil {
+			return count, err
+		}
+
+		if len(page.Events) > 0 {
+			events := make([]client.InsertExperimentEvent, 0, len(page.Events))
+			for i := range page.Events {
+				events = append(events, experimentCopyInsertEvent(&page.Events[i]))
+			}
+
+			if _, err := r.client.InsertExperimentEvents(ctx, targetID, &client.InsertExperimentEventsRequest{Events: events}); err != nil {
+				return count, err
+			}
+			count += len(events)
+		}
+
+		if page.Cursor == "" || page.Cursor == cursor || len(page.Events) == 0 {
+			return count, nil
+		}
+		cursor = page.Cursor
+	}
+}
+
+// deleteIncompleteCopy removes a copy that could not be completed, so a failed
+// create does not leave a partial experiment behind.
+func (r *ExperimentCopyResource) deleteIncompleteCopy(ctx context.Context, id string, resp *resource.CreateResponse) {
+	if err := r.client.DeleteExperiment(ctx, id); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddWarning(
+			"Incomplete Experiment Copy",
+			fmt.Sprintf("Unable to delete incomplete experiment copy %s, got error: %s", id, err),
+		)
+	}
+}
+
+func buildCreateExperimentCopyRequest(data ExperimentCopyResourceModel, source *client.Experiment) *client.CreateExperimentRequest {
+	projectID := source.ProjectID
+	if !data.ProjectID.IsNull() && !data.ProjectID.IsUnknown() {
+		projectID = data.ProjectID.ValueString()
+	}
+
+	return &client.CreateExperimentRequest{
+		ProjectID:      projectID,
+		Name:           data.Name.ValueString(),
+		Description:    data.Description.ValueString(),
+		Metadata:       source.Metadata,
+		Tags:           source.Tags,
+		RepoInfo:       source.RepoInfo,
+		DatasetID:      source.DatasetID,
+		DatasetVersion: source.DatasetVersion,
+		EnsureNew:      true,
+	}
+}
+
+// experimentCopyInsertEvent returns the insert event that recreates event,
+// keeping its IDs so traces stay linked in the copy.
+func experimentCopyInsertEvent(event *client.ExperimentEvent) client.InsertExperimentEvent {
+	return client.InsertExperimentEvent{
+		Input:           event.Input,
+		Output:          event.Output,
+		Expected:        event.Expected,
+		Error:           event.Error,
+		Scores:          event.Scores,
+		Metadata:        event.Metadata,
+		Metrics:         event.Metrics,
+		Context:         event.Context,
+		SpanAttributes:  event.SpanAttributes,
+		ID:              event.ID,
+		Created:         event.Created,
+		DatasetRecordID: event.DatasetRecordID,
+		SpanID:          event.SpanID,
+		RootSpanID:      event.RootSpanID,
+		SpanParents:     event.SpanParents,
+		Tags:            event.Tags,
+	}
+}
+
+func setExperimentCopyResourceModel(data *ExperimentCopyResourceModel, experiment *client.Experiment) {
+	data.ID = types.StringValue(experiment.ID)
+	data.ProjectID = types.StringValue(experiment.ProjectID)
+	data.Name = types.StringValue(experiment.Name)
+	data.Description = stringOrNull(experiment.Description)
+	data.Created = stringOrNull(experiment.Created)
+}
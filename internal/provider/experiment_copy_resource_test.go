@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccExperimentCopyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExperimentCopyResourceConfig("production", "test-experiment-copy"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("braintrustdata_experiment_copy.test", "name", "test-experiment-copy"),
+					resource.TestCheckResourceAttrPair("braintrustdata_experiment_copy.test", "source_experiment_id", "braintrustdata_experiment.production", "id"),
+					resource.TestCheckResourceAttrPair("braintrustdata_experiment_copy.test", "project_id", "braintrustdata_project.test", "id"),
+					resource.TestCheckResourceAttr("braintrustdata_experiment_copy.test", "event_count", "0"),
+					resource.TestCheckResourceAttrSet("braintrustdata_experiment_copy.test", "id"),
+					resource.TestCheckResourceAttrSet("braintrustdata_experiment_copy.test", "created"),
+				),
+			},
+			{
+				Config: testAccExperimentCopyResourceConfig("production", "test-experiment-copy-renamed"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("braintrustdata_experiment_copy.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("braintrustdata_experiment_copy.test", "name", "test-experiment-copy-renamed"),
+			},
+			{
+				Config: testAccExperimentCopyResourceConfig("candidate", "test-experiment-copy-renamed"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("braintrustdata_experiment_copy.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.TestCheckResourceAttrPair("braintrustdata_experiment_copy.test", "source_experiment_id", "braintrustdata_experiment.candidate", "id"),
+			},
+		},
+	})
+}
+
+func testAccExperimentCopyResourceConfig(source, name string) string {
+	return fmt.Sprintf(`
+resource "braintrustdata_project" "test" {
+  name = "test-project-for-experiment-copy"
+}
+
+resource "braintrustdata_experiment" "production" {
+  project_id = braintrustdata_project.test.id
+  name       = "test-experiment-copy-production"
+  tags       = ["production"]
+}
+
+resource "braintrustdata_experiment" "candidate" {
+  project_id = braintrustdata_project.test.id
+  name       = "test-experiment-copy-candidate"
+}
+
+resource "braintrustdata_experiment_copy" "test" {
+  source_experiment_id = braintrustdata_experiment.%[1]s.id
+  name                 = %[2]q
+}
+`, source, name)
+}
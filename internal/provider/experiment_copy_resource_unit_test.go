@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBuildCreateExperimentCopyRequest(t *testing.T) {
+	t.Parallel()
+
+	branch := "main"
+	source := &client.Experiment{
+		ID:             "experiment-prod",
+		ProjectID:      "project-123",
+		Name:           "production",
+		Description:    "Production experiment",
+		Metadata:       map[string]interface{}{"model": "gpt-4o"},
+		Tags:           []string{"production"},
+		RepoInfo:       &client.RepoInfo{Branch: &branch},
+		DatasetID:      "dataset-123",
+		DatasetVersion: "1000192656880881099",
+		BaseExpID:      "experiment-older",
+		Public:         true,
+	}
+
+	req := buildCreateExperimentCopyRequest(ExperimentCopyResourceModel{
+		Name:        types.StringValue("production-baseline"),
+		Description: types.StringNull(),
+		ProjectID:   types.StringUnknown(),
+	}, source)
+
+	want := &client.CreateExperimentRequest{
+		ProjectID:      "project-123",
+		Name:           "production-baseline",
+		Metadata:       source.Metadata,
+		Tags:           source.Tags,
+		RepoInfo:       source.RepoInfo,
+		DatasetID:      "dataset-123",
+		DatasetVersion: "1000192656880881099",
+		EnsureNew:      true,
+	}
+	if !reflect.DeepEqual(req, want) {
+		t.Fatalf("unexpected request:\n got: %#v\nwant: %#v", req, want)
+	}
+
+	req = buildCreateExperimentCopyRequest(ExperimentCopyResourceModel{
+		Name:      types.StringValue("production-baseline"),
+		ProjectID: types.StringValue("project-456"),
+	}, source)
+	if req.ProjectID != "project-456" {
+		t.Errorf("expected configured project ID, got %q", req.ProjectID)
+	}
+}
+
+func TestExperimentCopyInsertEvent(t *testing.T) {
+	t.Parallel()
+
+	accuracy := 1.0
+	event := &client.ExperimentEvent{
+		ID:           "span-2",
+		XactID:       "1000192656880881099",
+		ExperimentID: "experiment-prod",
+		ProjectID:    "project-123",
+		Created:      "2024-03-09T07:48:38Z",
+		SpanID:       "span-2",
+		RootSpanID:   "span-1",
+		SpanParents:  []string{"span-1"},
+		Input:        "2+2",
+		Output:       "4",
+		Scores:       map[string]*float64{"accuracy": &accuracy},
+		Tags:         []string{"math"},
+	}
+
+	want := client.InsertExperimentEvent{
+		ID:          "span-2",
+		Created:     "2024-03-09T07:48:38Z",
+		SpanID:      "span-2",
+		RootSpanID:  "span-1",
+		SpanParents: []string{"span-1"},
+		Input:       "2+2",
+		Output:      "4",
+		Scores:      map[string]*float64{"accuracy": &accuracy},
+		Tags:        []string{"math"},
+	}
+	if got := experimentCopyInsertEvent(event); !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected insert event:\n got: %#v\nwant: %#v", got, want)
+	}
+}
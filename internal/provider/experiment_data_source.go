@@ -24,14 +24,17 @@ type ExperimentDataSource struct {
 
 // ExperimentDataSourceModel describes the data source data model.
 type ExperimentDataSourceModel struct {
-	Tags        types.Set    `tfsdk:"tags"`
-	Metadata    types.Map    `tfsdk:"metadata"`
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	ProjectID   types.String `tfsdk:"project_id"`
-	Description types.String `tfsdk:"description"`
-	Created     types.String `tfsdk:"created"`
-	Public      types.Bool   `tfsdk:"public"`
+	Tags             types.Set    `tfsdk:"tags"`
+	Metadata         types.Map    `tfsdk:"metadata"`
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	ProjectID        types.String `tfsdk:"project_id"`
+	Description      types.String `tfsdk:"description"`
+	Created          types.String `tfsdk:"created"`
+	DatasetID        types.String `tfsdk:"dataset_id"`
+	DatasetVersion   types.String `tfsdk:"dataset_version"`
+	BaseExperimentID types.String `tfsdk:"base_experiment_id"`
+	Public           types.Bool   `tfsdk:"public"`
 }
 
 // Metadata implements datasource.DataSource.
@@ -82,6 +85,18 @@ func (d *ExperimentDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 				Computed:            true,
 				MarkdownDescription: "Tags associated with the experiment.",
 			},
+			"dataset_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the dataset the experiment is evaluated on.",
+			},
+			"dataset_version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The dataset version (`_xact_id`) the experiment is pinned to.",
+			},
+			"base_experiment_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the experiment compared against by default.",
+			},
 		},
 	}
 }
@@ -205,6 +220,9 @@ func (d *ExperimentDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	data.Description = types.StringValue(experiment.Description)
 	data.Created = types.StringValue(experiment.Created)
 	data.Public = types.BoolValue(experiment.Public)
+	data.DatasetID = stringOrNull(experiment.DatasetID)
+	data.DatasetVersion = stringOrNull(experiment.DatasetVersion)
+	data.BaseExperimentID = stringOrNull(experiment.BaseExpID)
 
 	if len(experiment.Metadata) > 0 {
 		metadataMap := make(map[string]string)
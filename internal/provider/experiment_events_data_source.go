@@ -0,0 +1,305 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ExperimentEventsDataSource{}
+
+var defaultExperimentEventsFields = []string{"input", "output", "expected", "scores", "metadata"}
+
+// experimentEventsFetchPageSize is the page size used when reading experiment events.
+const experimentEventsFetchPageSize = 1000
+
+// NewExperimentEventsDataSource creates a new experiment events data source instance.
+func NewExperimentEventsDataSource() datasource.DataSource {
+	return &ExperimentEventsDataSource{}
+}
+
+// ExperimentEventsDataSource defines the data source implementation.
+type ExperimentEventsDataSource struct {
+	client *client.Client
+}
+
+// ExperimentEventsDataSourceModel describes the data source data model.
+type ExperimentEventsDataSourceModel struct {
+	Fields       types.List                        `tfsdk:"fields"`
+	MinScores    types.Map                         `tfsdk:"min_scores"`
+	MaxScores    types.Map                         `tfsdk:"max_scores"`
+	ExperimentID types.String                      `tfsdk:"experiment_id"`
+	Filter       types.String                      `tfsdk:"filter"`
+	Events       []ExperimentEventsDataSourceEvent `tfsdk:"events"`
+	IDs          []string                          `tfsdk:"ids"`
+	Limit        types.Int64                       `tfsdk:"limit"`
+}
+
+// ExperimentEventsDataSourceEvent represents a single experiment event in the list.
+type ExperimentEventsDataSourceEvent struct {
+	ID         types.String `tfsdk:"id"`
+	SpanID     types.String `tfsdk:"span_id"`
+	RootSpanID types.String `tfsdk:"root_span_id"`
+	XactID     types.String `tfsdk:"xact_id"`
+	Created    types.String `tfsdk:"created"`
+	Data       types.String `tfsdk:"data"`
+}
+
+// Metadata implements datasource.DataSource.
+func (d *ExperimentEventsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_experiment_events"
+}
+
+// Schema implements datasource.DataSource.
+func (d *ExperimentEventsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the logged events (spans) of a Braintrust experiment, for example the rows that failed a score. Pages are fetched automatically until `limit` matching events are read or the experiment is exhausted.",
+		Attributes: map[string]schema.Attribute{
+			"experiment_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the experiment to read.",
+			},
+			"filter": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional BTQL filter expression, for example `metadata.split = 'test'`. When set, events are read with a BTQL query instead of the fetch endpoint.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"min_scores": schema.MapAttribute{
+				ElementType:         types.Float64Type,
+				Optional:            true,
+				MarkdownDescription: "Only return events whose score is at or above the given value, keyed by score name. Events without the score are excluded.",
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"max_scores": schema.MapAttribute{
+				ElementType:         types.Float64Type,
+				Optional:            true,
+				MarkdownDescription: "Only return events whose score is at or below the given value, keyed by score name, for example `{ accuracy = 0 }` for failing rows. Events without the score are excluded.",
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"fields": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Top-level event fields to include in each event's `data`. Defaults to `input`, `output`, `expected`, `scores` and `metadata`.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Optional max number of events to return. All matching events are returned when omitted.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "List of returned event IDs.",
+			},
+			"events": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of experiment events.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The event ID.",
+						},
+						"span_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The span ID of the event.",
+						},
+						"root_span_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The span ID of the root of the event's trace.",
+						},
+						"xact_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The transaction ID (`_xact_id`) of the event's latest version.",
+						},
+						"created": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The timestamp when the event was created.",
+						},
+						"data": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The projected event fields as a JSON-encoded object.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure implements datasource.DataSource.
+func (d *ExperimentEventsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ExperimentEventsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ExperimentEventsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fields, diags := experimentEventsProjectionFields(ctx, data.Fields)
+	resp.Diagnostics.Append(diags...)
+	minScores, diags := experimentEventsScoreThresholds(ctx, data.MinScores)
+	resp.Diagnostics.Append(diags...)
+	maxScores, diags := experimentEventsScoreThresholds(ctx, data.MaxScores)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limit := 0
+	if !data.Limit.IsNull() {
+		limit = int(data.Limit.ValueInt64())
+	}
+
+	events, err := d.fetchAllExperimentEvents(ctx, data, limit, func(event *client.ExperimentEvent) bool {
+		return experimentEventMatchesScores(event.Scores, minScores, maxScores)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Experiment Events",
+			fmt.Sprintf("Could not read events of experiment ID %s: %s", data.ExperimentID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	data.Events = make([]ExperimentEventsDataSourceEvent, 0, len(events))
+	data.IDs = make([]string, 0, len(events))
+
+	for i := range events {
+		encoded, err := encodeProjectedFields(&events[i], fields)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Encoding Experiment Event",
+				fmt.Sprintf("Could not encode experiment event %s: %s", events[i].ID, err.Error()),
+			)
+			return
+		}
+
+		data.Events = append(data.Events, ExperimentEventsDataSourceEvent{
+			ID:         stringOrNull(events[i].ID),
+			SpanID:     stringOrNull(events[i].SpanID),
+			RootSpanID: stringOrNull(events[i].RootSpanID),
+			XactID:     stringOrNull(events[i].XactID),
+			Created:    stringOrNull(events[i].Created),
+			Data:       types.StringValue(encoded),
+		})
+		data.IDs = append(data.IDs, events[i].ID)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// fetchAllExperimentEvents follows cursors until limit matching events are
+// read (or all matching events when limit is zero).
+func (d *ExperimentEventsDataSource) fetchAllExperimentEvents(ctx context.Context, data ExperimentEventsDataSourceModel, limit int, match func(*client.ExperimentEvent) bool) ([]client.ExperimentEvent, error) {
+	var events []client.ExperimentEvent
+	cursor := ""
+
+	for {
+		var page *client.FetchExperimentEventsResponse
+		var err error
+		if !data.Filter.IsNull() {
+			page, err = d.client.QueryExperimentEvents(ctx, data.ExperimentID.ValueString(), data.Filter.ValueString(), experimentEventsFetchPageSize, cursor)
+		} else {
+			page, err = d.client.FetchExperimentEvents(ctx, data.ExperimentID.ValueString(), &client.FetchExperimentEventsRequest{
+				Cursor: cursor,
+				Limit:  experimentEventsFetchPageSize,
+			})
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range page.Events {
+			if !match(&page.Events[i]) {
+				continue
+			}
+			events = append(events, page.Events[i])
+			if limit > 0 && len(events) >= limit {
+				return events, nil
+			}
+		}
+		if page.Cursor == "" || page.Cursor == cursor || len(page.Events) == 0 {
+			return events, nil
+		}
+		cursor = page.Cursor
+	}
+}
+
+func experimentEventsProjectionFields(ctx context.Context, value types.List) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if value.IsNull() || value.IsUnknown() {
+		return defaultExperimentEventsFields, diags
+	}
+
+	var fields []string
+	diags.Append(value.ElementsAs(ctx, &fields, false)...)
+	return fields, diags
+}
+
+func experimentEventsScoreThresholds(ctx context.Context, value types.Map) (map[string]float64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if value.IsNull() || value.IsUnknown() {
+		return nil, diags
+	}
+
+	thresholds := make(map[string]float64)
+	diags.Append(value.ElementsAs(ctx, &thresholds, false)...)
+	return thresholds, diags
+}
+
+// experimentEventMatchesScores reports whether every thresholded score is set
+// and within its inclusive bound.
+func experimentEventMatchesScores(scores map[string]*float64, minScores, maxScores map[string]float64) bool {
+	for name, bound := range minScores {
+		score := scores[name]
+		if score == nil || *score < bound {
+			return false
+		}
+	}
+	for name, bound := range maxScores {
+		score := scores[name]
+		if score == nil || *score > bound {
+			return false
+		}
+	}
+	return true
+}
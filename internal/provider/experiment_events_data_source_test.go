@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccExperimentEventsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExperimentResourceConfig("test-experiment-events", "Events test") + `
+data "braintrustdata_experiment_events" "all" {
+  experiment_id = braintrustdata_experiment.test.id
+}
+
+data "braintrustdata_experiment_events" "failing" {
+  experiment_id = braintrustdata_experiment.test.id
+  filter        = "metadata.split = 'test'"
+  max_scores    = { accuracy = 0 }
+  limit         = 10
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.braintrustdata_experiment_events.all", "events.#", "0"),
+					resource.TestCheckResourceAttr("data.braintrustdata_experiment_events.failing", "ids.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestExperimentEventMatchesScores(t *testing.T) {
+	t.Parallel()
+
+	zero := 0.0
+	half := 0.5
+	scores := map[string]*float64{
+		"accuracy":   &zero,
+		"relevance":  &half,
+		"factuality": nil,
+	}
+
+	tests := []struct {
+		minScores map[string]float64
+		maxScores map[string]float64
+		name      string
+		want      bool
+	}{
+		{name: "no_thresholds", want: true},
+		{name: "at_max", maxScores: map[string]float64{"accuracy": 0}, want: true},
+		{name: "above_max", maxScores: map[string]float64{"relevance": 0.4}, want: false},
+		{name: "at_min", minScores: map[string]float64{"relevance": 0.5}, want: true},
+		{name: "below_min", minScores: map[string]float64{"accuracy": 0.1}, want: false},
+		{name: "null_score", maxScores: map[string]float64{"factuality": 1}, want: false},
+		{name: "missing_score", minScores: map[string]float64{"toxicity": 0}, want: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := experimentEventMatchesScores(scores, tt.minScores, tt.maxScores); got != tt.want {
+				t.Errorf("experimentEventMatchesScores() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExperimentEventsScoreThresholds(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	thresholds, diags := experimentEventsScoreThresholds(ctx, types.MapNull(types.Float64Type))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if thresholds != nil {
+		t.Fatalf("expected nil thresholds, got %v", thresholds)
+	}
+
+	thresholds, diags = experimentEventsScoreThresholds(ctx, types.MapValueMust(types.Float64Type, map[string]attr.Value{
+		"accuracy": types.Float64Value(0.85),
+	}))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !reflect.DeepEqual(thresholds, map[string]float64{"accuracy": 0.85}) {
+		t.Fatalf("unexpected thresholds: %v", thresholds)
+	}
+}
+
+func TestExperimentEventsProjection(t *testing.T) {
+	t.Parallel()
+
+	fields, diags := experimentEventsProjectionFields(context.Background(), types.ListNull(types.StringType))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	accuracy := 0.0
+	encoded, err := encodeProjectedFields(&client.ExperimentEvent{
+		ID:       "span-1",
+		Input:    "2+2",
+		Output:   "5",
+		Expected: "4",
+		Scores:   map[string]*float64{"accuracy": &accuracy},
+		Metrics:  map[string]interface{}{"tokens": 12},
+	}, fields)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := `{"expected":"4","input":"2+2","output":"5","scores":{"accuracy":0}}`
+	if encoded != want {
+		t.Errorf("unexpected data:\n got: %s\nwant: %s", encoded, want)
+	}
+}
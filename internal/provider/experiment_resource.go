@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
@@ -35,17 +37,20 @@ type ExperimentResource struct {
 
 // ExperimentResourceModel describes the resource data model.
 type ExperimentResourceModel struct {
-	Tags        types.Set    `tfsdk:"tags"`
-	Metadata    types.Map    `tfsdk:"metadata"`
-	RepoInfo    types.Object `tfsdk:"repo_info"`
-	ID          types.String `tfsdk:"id"`
-	ProjectID   types.String `tfsdk:"project_id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Created     types.String `tfsdk:"created"`
-	UserID      types.String `tfsdk:"user_id"`
-	OrgID       types.String `tfsdk:"org_id"`
-	Public      types.Bool   `tfsdk:"public"`
+	Tags             types.Set    `tfsdk:"tags"`
+	Metadata         types.Map    `tfsdk:"metadata"`
+	RepoInfo         types.Object `tfsdk:"repo_info"`
+	ID               types.String `tfsdk:"id"`
+	ProjectID        types.String `tfsdk:"project_id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	Created          types.String `tfsdk:"created"`
+	UserID           types.String `tfsdk:"user_id"`
+	OrgID            types.String `tfsdk:"org_id"`
+	DatasetID        types.String `tfsdk:"dataset_id"`
+	DatasetVersion   types.String `tfsdk:"dataset_version"`
+	BaseExperimentID types.String `tfsdk:"base_experiment_id"`
+	Public           types.Bool   `tfsdk:"public"`
 }
 
 var experimentRepoInfoAttributeTypes = map[string]attr.Type{
@@ -188,6 +193,28 @@ func (r *ExperimentResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Optional:            true,
 				MarkdownDescription: "Tags associated with the experiment.",
 			},
+			"dataset_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The ID of the dataset the experiment is evaluated on, for example `braintrustdata_dataset.golden.id`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"dataset_version": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The dataset version (`_xact_id`) the experiment is pinned to, for example `data.braintrustdata_dataset_snapshot.release.version`. Requires `dataset_id`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(datasetVersionPattern, "must be a numeric transaction ID"),
+					stringvalidator.AlsoRequires(path.MatchRoot("dataset_id")),
+				},
+			},
+			"base_experiment_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The ID of the experiment to compare against by default, for example `braintrustdata_experiment.baseline.id`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
 			"created": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The timestamp when the experiment was created.",
@@ -285,13 +312,16 @@ func (r *ExperimentResource) Create(ctx context.Context, req resource.CreateRequ
 
 	// Create experiment via API
 	experiment, err := r.client.CreateExperiment(ctx, &client.CreateExperimentRequest{
-		ProjectID:   data.ProjectID.ValueString(),
-		Name:        data.Name.ValueString(),
-		Description: data.Description.ValueString(),
-		Public:      publicPtr,
-		Metadata:    metadata,
-		Tags:        tags,
-		RepoInfo:    repoInfoPtr,
+		ProjectID:      data.ProjectID.ValueString(),
+		Name:           data.Name.ValueString(),
+		Description:    data.Description.ValueString(),
+		Public:         publicPtr,
+		Metadata:       metadata,
+		Tags:           tags,
+		RepoInfo:       repoInfoPtr,
+		DatasetID:      data.DatasetID.ValueString(),
+		DatasetVersion: data.DatasetVersion.ValueString(),
+		BaseExpID:      data.BaseExperimentID.ValueString(),
 	})
 
 	if err != nil {
@@ -323,6 +353,7 @@ func (r *ExperimentResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 	data.OrgID = types.StringValue(experiment.OrgID)
 	data.Public = types.BoolValue(experiment.Public)
+	setExperimentLineage(&data, experiment)
 
 	repoInfoValue, repoInfoValueDiags := repoInfoToObject(experiment.RepoInfo)
 	resp.Diagnostics.Append(repoInfoValueDiags...)
@@ -406,6 +437,7 @@ func (r *ExperimentResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 	data.OrgID = types.StringValue(experiment.OrgID)
 	data.Public = types.BoolValue(experiment.Public)
+	setExperimentLineage(&data, experiment)
 
 	repoInfoValue, repoInfoValueDiags := repoInfoToObject(experiment.RepoInfo)
 	resp.Diagnostics.Append(repoInfoValueDiags...)
@@ -484,6 +516,7 @@ func (r *ExperimentResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 	applyRepoInfoConfigToUpdateRequest(updateReq, repoInfoConfigured)
+	applyExperimentLineageChangesToUpdateRequest(updateReq, data, state)
 
 	// Update experiment via API
 	experiment, err := r.client.UpdateExperiment(ctx, data.ID.ValueString(), updateReq)
@@ -501,6 +534,7 @@ func (r *ExperimentResource) Update(ctx context.Context, req resource.UpdateRequ
 		data.Description = types.StringNull()
 	}
 	data.Public = types.BoolValue(experiment.Public)
+	setExperimentLineage(&data, experiment)
 
 	repoInfoValue, repoInfoValueDiags := repoInfoToObject(experiment.RepoInfo)
 	resp.Diagnostics.Append(repoInfoValueDiags...)
@@ -560,7 +594,7 @@ func buildExperimentUpdateRequestWithState(ctx context.Context, data ExperimentR
 	var diags diag.Diagnostics
 	req := &client.UpdateExperimentRequest{
 		Name:        data.Name.ValueString(),
-		Description: data.Description.ValueString(),
+		Description: stringPointerFromValue(data.Description),
 	}
 
 	// Convert metadata from Terraform Map to Go map.
@@ -604,9 +638,45 @@ func buildExperimentUpdateRequestWithState(ctx context.Context, data ExperimentR
 		req.RepoInfo = repoInfo
 	}
 
+	req.DatasetID = experimentLineageUpdateValue(data.DatasetID)
+	req.DatasetVersion = experimentLineageUpdateValue(data.DatasetVersion)
+	req.BaseExpID = experimentLineageUpdateValue(data.BaseExperimentID)
+
 	return req, repoInfoState, diags
 }
 
+// experimentLineageUpdateValue returns the update value of a dataset or
+// baseline reference: omitted while unknown, cleared when null.
+func experimentLineageUpdateValue(value types.String) *string {
+	if value.IsUnknown() {
+		return nil
+	}
+	v := value.ValueString()
+	return &v
+}
+
+// applyExperimentLineageChangesToUpdateRequest only sends dataset and baseline
+// references that differ from state. Read records lineage changed outside
+// Terraform in state, so such changes show up as drift and the next apply
+// reverts them.
+func applyExperimentLineageChangesToUpdateRequest(req *client.UpdateExperimentRequest, plan, state ExperimentResourceModel) {
+	if plan.DatasetID.Equal(state.DatasetID) {
+		req.DatasetID = nil
+	}
+	if plan.DatasetVersion.Equal(state.DatasetVersion) {
+		req.DatasetVersion = nil
+	}
+	if plan.BaseExperimentID.Equal(state.BaseExperimentID) {
+		req.BaseExpID = nil
+	}
+}
+
+func setExperimentLineage(data *ExperimentResourceModel, experiment *client.Experiment) {
+	data.DatasetID = stringOrNull(experiment.DatasetID)
+	data.DatasetVersion = stringOrNull(experiment.DatasetVersion)
+	data.BaseExperimentID = stringOrNull(experiment.BaseExpID)
+}
+
 func isRepoInfoConfigured(value types.Object) bool {
 	// isRepoInfoConfigured reports whether repo_info was explicitly set in config.
 	return !value.IsNull() && !value.IsUnknown()
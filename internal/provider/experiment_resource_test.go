@@ -2,6 +2,8 @@ package provider
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -43,6 +45,41 @@ func TestAccExperimentResource(t *testing.T) {
 	})
 }
 
+func TestAccExperimentResource_Lineage(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "golden.jsonl")
+	if err := os.WriteFile(source, []byte(`{"id":"case-1","input":"2+2","expected":"4"}`+"\n"), 0o600); err != nil {
+		t.Fatalf("write source: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExperimentResourceConfigWithLineage(source, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("braintrustdata_experiment.test", "dataset_id", "braintrustdata_dataset.test", "id"),
+					resource.TestCheckResourceAttrPair("braintrustdata_experiment.test", "dataset_version", "data.braintrustdata_dataset_snapshot.test", "version"),
+					resource.TestCheckResourceAttrPair("braintrustdata_experiment.test", "base_experiment_id", "braintrustdata_experiment.baseline", "id"),
+				),
+			},
+			{
+				ResourceName:      "braintrustdata_experiment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Remove the baseline to verify clearing works
+				Config: testAccExperimentResourceConfigWithLineage(source, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("braintrustdata_experiment.test", "dataset_id", "braintrustdata_dataset.test", "id"),
+					resource.TestCheckNoResourceAttr("braintrustdata_experiment.test", "base_experiment_id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccExperimentResource_WithMetadataAndTags(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -262,3 +299,30 @@ resource "braintrustdata_experiment" "test" {
 `
 }
 */
+
+func testAccExperimentResourceConfigWithLineage(source string, withBaseline bool) string {
+	baseline := ""
+	if withBaseline {
+		baseline = "base_experiment_id = braintrustdata_experiment.baseline.id"
+	}
+
+	return testAccDatasetRecordsResourceConfig(source) + fmt.Sprintf(`
+data "braintrustdata_dataset_snapshot" "test" {
+  dataset_id = braintrustdata_dataset.test.id
+  depends_on = [braintrustdata_dataset_records.test]
+}
+
+resource "braintrustdata_experiment" "baseline" {
+  project_id = braintrustdata_project.test.id
+  name       = "test-experiment-baseline"
+}
+
+resource "braintrustdata_experiment" "test" {
+  project_id      = braintrustdata_project.test.id
+  name            = "test-experiment-lineage"
+  dataset_id      = braintrustdata_dataset.test.id
+  dataset_version = data.braintrustdata_dataset_snapshot.test.version
+  %[1]s
+}
+`, baseline)
+}
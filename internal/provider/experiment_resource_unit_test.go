@@ -559,3 +559,83 @@ func TestApplyRepoInfoConfigToUpdateRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildExperimentUpdateRequestLineage(t *testing.T) {
+	t.Parallel()
+
+	req, diags := buildExperimentUpdateRequest(context.Background(), ExperimentResourceModel{
+		Name:             types.StringValue("experiment"),
+		Metadata:         types.MapNull(types.StringType),
+		Tags:             types.SetNull(types.StringType),
+		RepoInfo:         types.ObjectNull(experimentRepoInfoAttributeTypes),
+		DatasetID:        types.StringValue("dataset-123"),
+		DatasetVersion:   types.StringNull(),
+		BaseExperimentID: types.StringUnknown(),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if req.DatasetID == nil || *req.DatasetID != "dataset-123" {
+		t.Fatalf("expected dataset_id to be sent, got %v", req.DatasetID)
+	}
+	if req.DatasetVersion == nil || *req.DatasetVersion != "" {
+		t.Fatalf("expected null dataset_version to be cleared, got %v", req.DatasetVersion)
+	}
+	if req.BaseExpID != nil {
+		t.Fatalf("expected unknown base_experiment_id to be omitted, got %q", *req.BaseExpID)
+	}
+}
+
+func TestApplyExperimentLineageChangesToUpdateRequest(t *testing.T) {
+	t.Parallel()
+
+	state := ExperimentResourceModel{
+		DatasetID:        types.StringValue("dataset-123"),
+		DatasetVersion:   types.StringValue("1000192656880881099"),
+		BaseExperimentID: types.StringNull(),
+	}
+	plan := ExperimentResourceModel{
+		DatasetID:        types.StringValue("dataset-123"),
+		DatasetVersion:   types.StringNull(),
+		BaseExperimentID: types.StringNull(),
+	}
+
+	datasetID, datasetVersion, baseExpID := "dataset-123", "", ""
+	req := &client.UpdateExperimentRequest{
+		DatasetID:      &datasetID,
+		DatasetVersion: &datasetVersion,
+		BaseExpID:      &baseExpID,
+	}
+	applyExperimentLineageChangesToUpdateRequest(req, plan, state)
+
+	if req.DatasetID != nil {
+		t.Errorf("expected unchanged dataset_id to be omitted, got %q", *req.DatasetID)
+	}
+	if req.DatasetVersion == nil || *req.DatasetVersion != "" {
+		t.Errorf("expected removed dataset_version to be cleared, got %v", req.DatasetVersion)
+	}
+	if req.BaseExpID != nil {
+		t.Errorf("expected unset base_experiment_id to be omitted, got %q", *req.BaseExpID)
+	}
+}
+
+func TestSetExperimentLineage(t *testing.T) {
+	t.Parallel()
+
+	var data ExperimentResourceModel
+	setExperimentLineage(&data, &client.Experiment{
+		DatasetID:      "dataset-123",
+		DatasetVersion: "1000192656880881099",
+	})
+
+	if data.DatasetID.ValueString() != "dataset-123" {
+		t.Errorf("unexpected dataset_id: %s", data.DatasetID)
+	}
+	if data.DatasetVersion.ValueString() != "1000192656880881099" {
+		t.Errorf("unexpected dataset_version: %s", data.DatasetVersion)
+	}
+	if !data.BaseExperimentID.IsNull() {
+		t.Errorf("expected null base_experiment_id, got %s", data.BaseExperimentID)
+	}
+}
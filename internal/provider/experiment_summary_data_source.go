@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ExperimentSummaryDataSource{}
+
+// NewExperimentSummaryDataSource creates a new experiment summary data source instance.
+func NewExperimentSummaryDataSource() datasource.DataSource {
+	return &ExperimentSummaryDataSource{}
+}
+
+// ExperimentSummaryDataSource defines the data source implementation.
+type ExperimentSummaryDataSource struct {
+	client *client.Client
+}
+
+// ExperimentSummaryDataSourceModel describes the data source data model.
+type ExperimentSummaryDataSourceModel struct {
+	Scores                   map[string]ExperimentSummaryScoreModel  `tfsdk:"scores"`
+	Metrics                  map[string]ExperimentSummaryMetricModel `tfsdk:"metrics"`
+	ExperimentID             types.String                            `tfsdk:"experiment_id"`
+	ComparisonExperimentID   types.String                            `tfsdk:"comparison_experiment_id"`
+	ProjectName              types.String                            `tfsdk:"project_name"`
+	ExperimentName           types.String                            `tfsdk:"experiment_name"`
+	ProjectURL               types.String                            `tfsdk:"project_url"`
+	ExperimentURL            types.String                            `tfsdk:"experiment_url"`
+	ComparisonExperimentName types.String                            `tfsdk:"comparison_experiment_name"`
+}
+
+// ExperimentSummaryScoreModel describes a summarized score.
+type ExperimentSummaryScoreModel struct {
+	Score        types.Float64 `tfsdk:"score"`
+	Diff         types.Float64 `tfsdk:"diff"`
+	Improvements types.Int64   `tfsdk:"improvements"`
+	Regressions  types.Int64   `tfsdk:"regressions"`
+}
+
+// ExperimentSummaryMetricModel describes a summarized metric.
+type ExperimentSummaryMetricModel struct {
+	Metric       types.Float64 `tfsdk:"metric"`
+	Diff         types.Float64 `tfsdk:"diff"`
+	Unit         types.String  `tfsdk:"unit"`
+	Improvements types.Int64   `tfsdk:"improvements"`
+	Regressions  types.Int64   `tfsdk:"regressions"`
+}
+
+// Metadata implements datasource.DataSource.
+func (d *ExperimentSummaryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_experiment_summary"
+}
+
+// Schema implements datasource.DataSource.
+func (d *ExperimentSummaryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the summarized scores and metrics of a Braintrust experiment, compared against a baseline experiment. Use it in `check` blocks or `postcondition`s to gate changes on eval results.",
+		Attributes: map[string]schema.Attribute{
+			"experiment_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the experiment to summarize.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"comparison_experiment_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The ID of the experiment to compare against. Defaults to the experiment's base experiment, or the most recent earlier experiment in the project.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"project_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the project the experiment belongs to.",
+			},
+			"experiment_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the experiment.",
+			},
+			"project_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "URL of the project in the Braintrust app.",
+			},
+			"experiment_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "URL of the experiment in the Braintrust app.",
+			},
+			"comparison_experiment_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the experiment compared against. Null when there is no comparison.",
+			},
+			"scores": schema.MapNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Summarized scores keyed by score name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"score": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The mean score, between 0 and 1.",
+						},
+						"diff": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The difference from the comparison experiment's mean score.",
+						},
+						"improvements": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Number of rows that scored higher than in the comparison experiment.",
+						},
+						"regressions": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Number of rows that scored lower than in the comparison experiment.",
+						},
+					},
+				},
+			},
+			"metrics": schema.MapNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Summarized metrics such as `duration`, `prompt_tokens`, `completion_tokens`, `total_tokens` and `estimated_cost`, keyed by metric name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"metric": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The mean metric value.",
+						},
+						"unit": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unit of the metric, for example `s` or `$`.",
+						},
+						"diff": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The difference from the comparison experiment's mean metric.",
+						},
+						"improvements": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Number of rows that improved on the comparison experiment.",
+						},
+						"regressions": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Number of rows that regressed from the comparison experiment.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure implements datasource.DataSource.
+func (d *ExperimentSummaryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ExperimentSummaryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ExperimentSummaryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	summary, err := d.client.SummarizeExperiment(ctx, data.ExperimentID.ValueString(), data.ComparisonExperimentID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Summarizing Experiment",
+			fmt.Sprintf("Could not summarize experiment ID %s: %s", data.ExperimentID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	setExperimentSummaryDataSourceModel(&data, summary)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func setExperimentSummaryDataSourceModel(data *ExperimentSummaryDataSourceModel, summary *client.ExperimentSummary) {
+	data.ProjectName = types.StringValue(summary.ProjectName)
+	data.ExperimentName = types.StringValue(summary.ExperimentName)
+	data.ProjectURL = stringOrNull(summary.ProjectURL)
+	data.ExperimentURL = stringOrNull(summary.ExperimentURL)
+	data.ComparisonExperimentName = stringOrNull(summary.ComparisonExperimentName)
+
+	data.Scores = make(map[string]ExperimentSummaryScoreModel, len(summary.Scores))
+	for name, score := range summary.Scores {
+		data.Scores[name] = ExperimentSummaryScoreModel{
+			Score:        types.Float64Value(score.Score),
+			Diff:         types.Float64PointerValue(score.Diff),
+			Improvements: types.Int64PointerValue(score.Improvements),
+			Regressions:  types.Int64PointerValue(score.Regressions),
+		}
+	}
+
+	data.Metrics = make(map[string]ExperimentSummaryMetricModel, len(summary.Metrics))
+	for name, metric := range summary.Metrics {
+		data.Metrics[name] = ExperimentSummaryMetricModel{
+			Metric:       types.Float64Value(metric.Metric),
+			Unit:         stringOrNull(metric.Unit),
+			Diff:         types.Float64PointerValue(metric.Diff),
+			Improvements: types.Int64PointerValue(metric.Improvements),
+			Regressions:  types.Int64PointerValue(metric.Regressions),
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccExperimentSummaryDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExperimentResourceConfig("test-experiment-summary", "Summary test") + `
+data "braintrustdata_experiment_summary" "test" {
+  experiment_id = braintrustdata_experiment.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.braintrustdata_experiment_summary.test", "experiment_name", "test-experiment-summary"),
+					resource.TestCheckResourceAttr("data.braintrustdata_experiment_summary.test", "project_name", "test-project-for-experiment"),
+					resource.TestCheckResourceAttr("data.braintrustdata_experiment_summary.test", "scores.%", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+)
+
+func TestSetExperimentSummaryDataSourceModel(t *testing.T) {
+	t.Parallel()
+
+	diff := 0.05
+	improvements := int64(3)
+	regressions := int64(1)
+
+	var data ExperimentSummaryDataSourceModel
+	setExperimentSummaryDataSourceModel(&data, &client.ExperimentSummary{
+		ProjectName:              "project",
+		ExperimentName:           "candidate",
+		ComparisonExperimentName: "baseline",
+		Scores: map[string]client.ExperimentScoreSummary{
+			"accuracy": {Name: "accuracy", Score: 0.9, Diff: &diff, Improvements: &improvements, Regressions: &regressions},
+		},
+		Metrics: map[string]client.ExperimentMetricSummary{
+			"duration": {Name: "duration", Metric: 1.25, Unit: "s"},
+		},
+	})
+
+	accuracy, ok := data.Scores["accuracy"]
+	if !ok {
+		t.Fatalf("expected accuracy score, got %#v", data.Scores)
+	}
+	if accuracy.Score.ValueFloat64() != 0.9 || accuracy.Diff.ValueFloat64() != 0.05 {
+		t.Errorf("unexpected accuracy score: %#v", accuracy)
+	}
+	if accuracy.Improvements.ValueInt64() != 3 || accuracy.Regressions.ValueInt64() != 1 {
+		t.Errorf("unexpected accuracy counts: %#v", accuracy)
+	}
+
+	duration := data.Metrics["duration"]
+	if duration.Metric.ValueFloat64() != 1.25 || duration.Unit.ValueString() != "s" {
+		t.Errorf("unexpected duration metric: %#v", duration)
+	}
+	if !duration.Diff.IsNull() || !duration.Improvements.IsNull() {
+		t.Errorf("expected null comparison fields without a comparison, got %#v", duration)
+	}
+
+	if data.ComparisonExperimentName.ValueString() != "baseline" {
+		t.Errorf("unexpected comparison experiment name: %s", data.ComparisonExperimentName)
+	}
+	if !data.ProjectURL.IsNull() {
+		t.Errorf("expected null project_url, got %s", data.ProjectURL)
+	}
+}
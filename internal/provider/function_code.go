@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const (
+	functionRuntimeNode   = "node"
+	functionRuntimePython = "python"
+)
+
+// functionRuntimeVersionPatterns lists the runtimes Braintrust executes code
+// functions in, and the version format each accepts.
+var functionRuntimeVersionPatterns = map[string]*regexp.Regexp{
+	functionRuntimeNode:   regexp.MustCompile(`^[0-9]+$`),
+	functionRuntimePython: regexp.MustCompile(`^3\.[0-9]+$`),
+}
+
+var functionCodeAttributeTypes = map[string]attr.Type{
+	"runtime": types.StringType,
+	"version": types.StringType,
+	"source":  types.StringType,
+}
+
+// FunctionCodeModel describes inline function code.
+type FunctionCodeModel struct {
+	Runtime types.String `tfsdk:"runtime"`
+	Version types.String `tfsdk:"version"`
+	Source  types.String `tfsdk:"source"`
+}
+
+// validateFunctionRuntimeVersion checks that runtime is supported and that
+// version is in the form that runtime expects, such as `20` or `3.12`.
+func validateFunctionRuntimeVersion(runtime, version string) error {
+	pattern, ok := functionRuntimeVersionPatterns[runtime]
+	if !ok {
+		return fmt.Errorf("runtime %q is not supported; use %q or %q", runtime, functionRuntimeNode, functionRuntimePython)
+	}
+	if !pattern.MatchString(strings.TrimSpace(version)) {
+		switch runtime {
+		case functionRuntimeNode:
+			return fmt.Errorf("node version %q must be a major version such as \"20\"", version)
+		default:
+			return fmt.Errorf("python version %q must be a 3.x version such as \"3.12\"", version)
+		}
+	}
+	return nil
+}
+
+func functionUsesInlineCode(data FunctionResourceModel) bool {
+	return !data.Code.IsNull()
+}
+
+// functionInlineCode reads the code attribute. It returns nil when the code or
+// any of its fields is not yet known.
+func functionInlineCode(ctx context.Context, value types.Object) (*FunctionCodeModel, diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() {
+		return nil, nil
+	}
+
+	var code FunctionCodeModel
+	diags := value.As(ctx, &code, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+	if code.Runtime.IsUnknown() || code.Version.IsUnknown() || code.Source.IsUnknown() {
+		return nil, diags
+	}
+
+	return &code, diags
+}
+
+func functionCodeHash(source string) string {
+	sum := sha256.Sum256([]byte(source))
+	return hex.EncodeToString(sum[:])
+}
+
+// inlineCodeFunctionData builds the function_data of a function whose code is
+// stored inline.
+func inlineCodeFunctionData(code *FunctionCodeModel) (string, error) {
+	encoded, err := json.Marshal(map[string]interface{}{
+		"type": "code",
+		"data": map[string]interface{}{
+			"type": "inline",
+			"runtime_context": map[string]interface{}{
+				"runtime": code.Runtime.ValueString(),
+				"version": strings.TrimSpace(code.Version.ValueString()),
+			},
+			"code": code.Source.ValueString(),
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return string(encoded), nil
+}
@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// functionCodeBundleModTime is stamped on every archive entry so that the
+// archive depends only on file names and contents.
+var functionCodeBundleModTime = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// functionCodeBundleSkipDirs are directories that hold VCS metadata or
+// interpreter caches rather than sources.
+var functionCodeBundleSkipDirs = map[string]bool{
+	".git":        true,
+	"__pycache__": true,
+}
+
+// functionCodeBundle is a zipped source tree ready to upload.
+type functionCodeBundle struct {
+	// hash is the SHA-256 of the bundled file names and contents.
+	hash       string
+	entrypoint string
+	preview    string
+	archive    []byte
+}
+
+type functionCodeBundleFile struct {
+	name    string
+	content []byte
+}
+
+// buildFunctionCodeBundle zips sourceDir, or the single sourceFile,
+// deterministically. Files are sorted by path and stored with a fixed
+// modification time and mode, so unchanged sources always produce the same
+// archive and hash. For a single file the entrypoint defaults to its name; a
+// directory without an entrypoint is bundled without a preview.
+func buildFunctionCodeBundle(sourceDir, sourceFile, entrypoint string) (*functionCodeBundle, error) {
+	var files []functionCodeBundleFile
+	var err error
+	switch {
+	case sourceFile != "":
+		name := filepath.Base(sourceFile)
+		if entrypoint == "" {
+			entrypoint = name
+		}
+		if entrypoint != name {
+			return nil, fmt.Errorf("entrypoint %q must be the name of source_file (%q)", entrypoint, name)
+		}
+
+		// #nosec G304 -- Reading a user-configured local file is the purpose of this attribute.
+		content, readErr := os.ReadFile(sourceFile)
+		if readErr != nil {
+			return nil, readErr
+		}
+		files = []functionCodeBundleFile{{name: name, content: content}}
+	case sourceDir != "":
+		if entrypoint != "" {
+			entrypoint = path.Clean(filepath.ToSlash(entrypoint))
+		}
+
+		files, err = readFunctionCodeBundleDir(sourceDir)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("one of source_dir or source_file must be set")
+	}
+
+	bundle := &functionCodeBundle{entrypoint: entrypoint}
+	found := entrypoint == ""
+	for _, file := range files {
+		if entrypoint != "" && file.name == entrypoint {
+			bundle.preview = string(file.content)
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("entrypoint %q not found in %s", entrypoint, sourceDir)
+	}
+
+	hasher := sha256.New()
+	var archive bytes.Buffer
+	zw := zip.NewWriter(&archive)
+	for _, file := range files {
+		_, _ = fmt.Fprintf(hasher, "%s\x00%d\x00", file.name, len(file.content))
+		_, _ = hasher.Write(file.content)
+
+		header := &zip.FileHeader{
+			Name:     file.name,
+			Method:   zip.Deflate,
+			Modified: functionCodeBundleModTime,
+		}
+		header.SetMode(0o644)
+
+		w, err := zw.CreateHeader(header)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(file.content); err != nil {
+			return nil, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	bundle.archive = archive.Bytes()
+	bundle.hash = hex.EncodeToString(hasher.Sum(nil))
+	return bundle, nil
+}
+
+func readFunctionCodeBundleDir(sourceDir string) ([]functionCodeBundleFile, error) {
+	var files []functionCodeBundleFile
+	err := filepath.WalkDir(sourceDir, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if filePath != sourceDir && functionCodeBundleSkipDirs[d.Name()] {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		// Symlinks are followed to regular files; anything else is skipped.
+		info, err := os.Stat(filePath)
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(sourceDir, filePath)
+		if err != nil {
+			return err
+		}
+		// #nosec G304 -- Reading the user-configured source directory is the purpose of this attribute.
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+
+		files = append(files, functionCodeBundleFile{name: filepath.ToSlash(rel), content: content})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("source_dir %s contains no files", sourceDir)
+	}
+
+	sort.Slice(files, func(i, j int) bool { return files[i].name < files[j].name })
+	return files, nil
+}
+
+// codeBundleFunctionData builds the function_data of a function backed by an
+// uploaded bundle. Bundle locations only address functions by the order the
+// bundle registers them in, not by file, so the bundle must register exactly
+// one function, referenced by index 0.
+func codeBundleFunctionData(bundleID, runtime, runtimeVersion, preview string) (string, error) {
+	data := map[string]interface{}{
+		"type":      "bundle",
+		"bundle_id": bundleID,
+		"runtime_context": map[string]interface{}{
+			"runtime": runtime,
+			"version": strings.TrimSpace(runtimeVersion),
+		},
+		"location": map[string]interface{}{
+			"type":  "function",
+			"index": 0,
+		},
+	}
+	if preview != "" {
+		data["preview"] = preview
+	}
+
+	encoded, err := json.Marshal(map[string]interface{}{
+		"type": "code",
+		"data": data,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return string(encoded), nil
+}
@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func writeFunctionSourceFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		filePath := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filePath), 0o750); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filePath, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestBuildFunctionCodeBundle_Deterministic(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeFunctionSourceFiles(t, dir, map[string]string{
+		"scorer.py":                    "def handler(output, expected):\n    return output == expected\n",
+		"lib/util.py":                  "X = 1\n",
+		".git/HEAD":                    "ref: refs/heads/main\n",
+		"lib/__pycache__/util.cpython": "compiled",
+	})
+
+	first, err := buildFunctionCodeBundle(dir, "", "scorer.py")
+	if err != nil {
+		t.Fatalf("buildFunctionCodeBundle() error = %v", err)
+	}
+
+	later := time.Now().Add(time.Hour)
+	if err := os.Chtimes(filepath.Join(dir, "scorer.py"), later, later); err != nil {
+		t.Fatal(err)
+	}
+	second, err := buildFunctionCodeBundle(dir, "", "./scorer.py")
+	if err != nil {
+		t.Fatalf("buildFunctionCodeBundle() error = %v", err)
+	}
+
+	if first.hash != second.hash || !bytes.Equal(first.archive, second.archive) {
+		t.Fatal("expected identical bundles for unchanged sources")
+	}
+	if second.entrypoint != "scorer.py" {
+		t.Errorf("expected cleaned entrypoint scorer.py, got %q", second.entrypoint)
+	}
+	if first.preview != "def handler(output, expected):\n    return output == expected\n" {
+		t.Errorf("unexpected preview: %q", first.preview)
+	}
+
+	reader, err := zip.NewReader(bytes.NewReader(first.archive), int64(len(first.archive)))
+	if err != nil {
+		t.Fatalf("invalid archive: %v", err)
+	}
+	var names []string
+	for _, file := range reader.File {
+		names = append(names, file.Name)
+	}
+	if want := []string{"lib/util.py", "scorer.py"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("archive entries = %v, want %v", names, want)
+	}
+
+	writeFunctionSourceFiles(t, dir, map[string]string{"lib/util.py": "X = 2\n"})
+	changed, err := buildFunctionCodeBundle(dir, "", "scorer.py")
+	if err != nil {
+		t.Fatalf("buildFunctionCodeBundle() error = %v", err)
+	}
+	if changed.hash == first.hash {
+		t.Error("expected hash to change with file contents")
+	}
+}
+
+func TestBuildFunctionCodeBundle_SourceFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeFunctionSourceFiles(t, dir, map[string]string{"scorer.ts": "export default () => 1;\n"})
+	sourceFile := filepath.Join(dir, "scorer.ts")
+
+	bundle, err := buildFunctionCodeBundle("", sourceFile, "")
+	if err != nil {
+		t.Fatalf("buildFunctionCodeBundle() error = %v", err)
+	}
+	if bundle.entrypoint != "scorer.ts" {
+		t.Errorf("expected default entrypoint scorer.ts, got %q", bundle.entrypoint)
+	}
+
+	if _, err := buildFunctionCodeBundle("", sourceFile, "other.ts"); err == nil {
+		t.Error("expected error for entrypoint that does not match source_file")
+	}
+}
+
+func TestBuildFunctionCodeBundle_DirWithoutEntrypoint(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeFunctionSourceFiles(t, dir, map[string]string{"scorer.py": "pass\n"})
+
+	bundle, err := buildFunctionCodeBundle(dir, "", "")
+	if err != nil {
+		t.Fatalf("buildFunctionCodeBundle() error = %v", err)
+	}
+	if bundle.entrypoint != "" || bundle.preview != "" {
+		t.Errorf("expected no entrypoint or preview, got %q and %q", bundle.entrypoint, bundle.preview)
+	}
+	if len(bundle.archive) == 0 {
+		t.Error("expected a non-empty archive")
+	}
+}
+
+func TestBuildFunctionCodeBundle_Errors(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeFunctionSourceFiles(t, dir, map[string]string{"scorer.py": "pass\n"})
+
+	if _, err := buildFunctionCodeBundle(dir, "", "missing.py"); err == nil {
+		t.Error("expected error for entrypoint not in source_dir")
+	}
+	if _, err := buildFunctionCodeBundle(t.TempDir(), "", "scorer.py"); err == nil {
+		t.Error("expected error for empty source_dir")
+	}
+}
+
+func TestCodeBundleFunctionData(t *testing.T) {
+	t.Parallel()
+
+	encoded, err := codeBundleFunctionData("bundle-123", "python", " 3.12 ", "pass\n")
+	if err != nil {
+		t.Fatalf("codeBundleFunctionData() error = %v", err)
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal([]byte(encoded), &got); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	want := map[string]any{
+		"type": "code",
+		"data": map[string]any{
+			"type":            "bundle",
+			"bundle_id":       "bundle-123",
+			"runtime_context": map[string]any{"runtime": "python", "version": "3.12"},
+			"location":        map[string]any{"type": "function", "index": float64(0)},
+			"preview":         "pass\n",
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("codeBundleFunctionData() = %#v, want %#v", got, want)
+	}
+
+	encoded, err = codeBundleFunctionData("bundle-123", "python", "3.12", "")
+	if err != nil {
+		t.Fatalf("codeBundleFunctionData() error = %v", err)
+	}
+	if strings.Contains(encoded, "preview") {
+		t.Errorf("expected no preview without an entrypoint, got %s", encoded)
+	}
+}
+
+func TestFunctionCodeBundleChanged(t *testing.T) {
+	t.Parallel()
+
+	state := FunctionResourceModel{
+		SourceHash:     types.StringValue("abc"),
+		Runtime:        types.StringValue("node"),
+		RuntimeVersion: types.StringValue("20"),
+		Entrypoint:     types.StringValue("index.js"),
+		FunctionData:   types.StringValue(`{"type":"code"}`),
+	}
+
+	if functionCodeBundleChanged(state, state) {
+		t.Error("expected unchanged bundle")
+	}
+
+	plan := state
+	plan.SourceHash = types.StringValue("def")
+	if !functionCodeBundleChanged(plan, state) {
+		t.Error("expected changed hash to require upload")
+	}
+
+	plan = state
+	plan.RuntimeVersion = types.StringValue("22")
+	if !functionCodeBundleChanged(plan, state) {
+		t.Error("expected changed runtime version to require upload")
+	}
+
+	imported := state
+	imported.FunctionData = types.StringNull()
+	if !functionCodeBundleChanged(state, imported) {
+		t.Error("expected missing function_data to require upload")
+	}
+}
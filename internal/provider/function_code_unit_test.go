@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testFunctionCodeObject(runtime, version string, source attr.Value) types.Object {
+	return types.ObjectValueMust(functionCodeAttributeTypes, map[string]attr.Value{
+		"runtime": types.StringValue(runtime),
+		"version": types.StringValue(version),
+		"source":  source,
+	})
+}
+
+func TestValidateFunctionRuntimeVersion(t *testing.T) {
+	t.Parallel()
+
+	valid := [][2]string{
+		{"node", "20"},
+		{"node", "22"},
+		{"python", "3.12"},
+		{"python", " 3.11 "},
+	}
+	for _, tc := range valid {
+		if err := validateFunctionRuntimeVersion(tc[0], tc[1]); err != nil {
+			t.Errorf("validateFunctionRuntimeVersion(%q, %q) error = %v", tc[0], tc[1], err)
+		}
+	}
+
+	invalid := [][2]string{
+		{"ruby", "3.3"},
+		{"node", "20.1"},
+		{"node", "v20"},
+		{"python", "2.7"},
+		{"python", "3"},
+	}
+	for _, tc := range invalid {
+		if err := validateFunctionRuntimeVersion(tc[0], tc[1]); err == nil {
+			t.Errorf("validateFunctionRuntimeVersion(%q, %q) expected error", tc[0], tc[1])
+		}
+	}
+}
+
+func TestInlineCodeFunctionData(t *testing.T) {
+	t.Parallel()
+
+	code, diags := functionInlineCode(context.Background(), testFunctionCodeObject("python", "3.12", types.StringValue("def handler():\n    return 1\n")))
+	if diags.HasError() || code == nil {
+		t.Fatalf("unexpected result: %v, %v", code, diags)
+	}
+
+	encoded, err := inlineCodeFunctionData(code)
+	if err != nil {
+		t.Fatalf("inlineCodeFunctionData() error = %v", err)
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal([]byte(encoded), &got); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	want := map[string]any{
+		"type": "code",
+		"data": map[string]any{
+			"type":            "inline",
+			"runtime_context": map[string]any{"runtime": "python", "version": "3.12"},
+			"code":            "def handler():\n    return 1\n",
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("inlineCodeFunctionData() = %#v, want %#v", got, want)
+	}
+}
+
+func TestPlanFunctionInlineCode(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	code := testFunctionCodeObject("node", "20", types.StringValue("export default () => 1;"))
+	state := &FunctionResourceModel{
+		Code:         code,
+		CodeHash:     types.StringValue(functionCodeHash("export default () => 1;")),
+		FunctionData: types.StringValue(`{"type":"code"}`),
+	}
+
+	plan := &FunctionResourceModel{Code: code, FunctionData: types.StringUnknown()}
+	if diags := planFunctionInlineCode(ctx, plan, state); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !plan.FunctionData.Equal(state.FunctionData) {
+		t.Errorf("expected unchanged code to keep function_data, got %v", plan.FunctionData)
+	}
+	if !plan.CodeHash.Equal(state.CodeHash) {
+		t.Errorf("expected code_hash %v, got %v", state.CodeHash, plan.CodeHash)
+	}
+
+	plan = &FunctionResourceModel{Code: testFunctionCodeObject("node", "20", types.StringValue("export default () => 0;"))}
+	if diags := planFunctionInlineCode(ctx, plan, state); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !plan.FunctionData.IsUnknown() {
+		t.Errorf("expected changed code to plan unknown function_data, got %v", plan.FunctionData)
+	}
+	if plan.CodeHash.Equal(state.CodeHash) {
+		t.Error("expected code_hash to change with the source")
+	}
+
+	plan = &FunctionResourceModel{Code: testFunctionCodeObject("node", "20", types.StringUnknown())}
+	if diags := planFunctionInlineCode(ctx, plan, nil); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !plan.CodeHash.IsUnknown() || !plan.FunctionData.IsUnknown() {
+		t.Errorf("expected unknown source to plan unknown values, got %v and %v", plan.CodeHash, plan.FunctionData)
+	}
+
+	plan = &FunctionResourceModel{Code: testFunctionCodeObject("python", "2.7", types.StringValue("pass"))}
+	if diags := planFunctionInlineCode(ctx, plan, nil); !diags.HasError() {
+		t.Error("expected unsupported runtime version to fail")
+	}
+}
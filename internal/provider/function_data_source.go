@@ -49,6 +49,7 @@ type FunctionDataSourceModel struct {
 	ProjectID      types.String `tfsdk:"project_id"`
 	PromptData     types.String `tfsdk:"prompt_data"`
 	Slug           types.String `tfsdk:"slug"`
+	Version        types.String `tfsdk:"version"`
 }
 
 // Metadata implements datasource.DataSource.
@@ -59,7 +60,7 @@ func (d *FunctionDataSource) Metadata(_ context.Context, req datasource.Metadata
 // Schema implements datasource.DataSource.
 func (d *FunctionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Reads a Braintrust function by `id` or by searchable attributes (`project_id` + `name` or `project_id` + `slug`).",
+		MarkdownDescription: "Reads a Braintrust function by `id` or by searchable attributes (`project_id` + `name` or `project_id` + `slug`), optionally pinned to a specific `version`.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Optional:            true,
@@ -81,6 +82,10 @@ func (d *FunctionDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Computed:            true,
 				MarkdownDescription: "The function slug. Must be specified with `project_id` when `id` is not provided and `name` is not used.",
 			},
+			"version": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The function version (transaction ID) to read. The function is located by `id`, `name` or `slug` as it is now and then read as of this version. Defaults to the latest version, reported in `xact_id`.",
+			},
 			"xact_id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The transactional ID associated with the function.",
@@ -236,6 +241,25 @@ func (d *FunctionDataSource) Read(ctx context.Context, req datasource.ReadReques
 		function = selectedFunction
 	}
 
+	if version := strings.TrimSpace(data.Version.ValueString()); !data.Version.IsNull() && version != "" {
+		versionedFunction, err := d.client.GetFunctionVersion(ctx, function.ID, version)
+		if errors.Is(err, client.ErrVersionNotFound) {
+			resp.Diagnostics.AddError(
+				"Function Version Not Found",
+				fmt.Sprintf("Function %s has no version %s", function.ID, version),
+			)
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Function",
+				fmt.Sprintf("Could not read function ID %s at version %s: %s", function.ID, version, err.Error()),
+			)
+			return
+		}
+		function = versionedFunction
+	}
+
 	resp.Diagnostics.Append(populateFunctionDataSourceModel(ctx, &data, function)...)
 	if resp.Diagnostics.HasError() {
 		return
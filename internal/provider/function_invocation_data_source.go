@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &FunctionInvocationDataSource{}
+
+// NewFunctionInvocationDataSource creates a new function invocation data source instance.
+func NewFunctionInvocationDataSource() datasource.DataSource {
+	return &FunctionInvocationDataSource{}
+}
+
+// FunctionInvocationDataSource defines the data source implementation.
+type FunctionInvocationDataSource struct {
+	client *client.Client
+}
+
+// FunctionInvocationDataSourceModel describes the data source data model.
+type FunctionInvocationDataSourceModel struct {
+	FunctionID types.String `tfsdk:"function_id"`
+	Input      types.String `tfsdk:"input"`
+	Version    types.String `tfsdk:"version"`
+	Mode       types.String `tfsdk:"mode"`
+	Output     types.String `tfsdk:"output"`
+	Error      types.String `tfsdk:"error"`
+}
+
+// Metadata implements datasource.DataSource.
+func (d *FunctionInvocationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_function_invocation"
+}
+
+// Schema implements datasource.DataSource.
+func (d *FunctionInvocationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Invokes a Braintrust function, such as a tool or scorer, with a known input and exposes its output. Use it in `postcondition`s or `check` blocks to smoke-test a function deployed in the same apply. The function runs every time the data source is read, including during plan.",
+		Attributes: map[string]schema.Attribute{
+			"function_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the function to invoke.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"input": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The function input as a JSON-encoded string. Use `jsonencode()` for structured input.",
+			},
+			"version": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The function version (transaction ID) to invoke, such as `braintrustdata_function.example.xact_id`. Defaults to the latest version.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"mode": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "How the result is returned: `auto`, `parallel`, `json` or `text`. Defaults to `auto` on the server.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("auto", "parallel", "json", "text"),
+				},
+			},
+			"output": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The function output as a JSON-encoded string. Use `jsondecode()` to inspect it. Text output is encoded as a JSON string. Null when the invocation failed.",
+			},
+			"error": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The error raised by the function, or null when the invocation succeeded. Errors raised while running the function are reported here rather than failing the read, so that conditions can assert on them. Any other API error, such as an unknown version or an unavailable service, fails the read.",
+			},
+		},
+	}
+}
+
+// Configure implements datasource.DataSource.
+func (d *FunctionInvocationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *FunctionInvocationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FunctionInvocationDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, err := decodeFunctionJSONField("input", data.Input)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("input"), "Invalid input", err.Error())
+		return
+	}
+
+	output, err := d.client.InvokeFunction(ctx, data.FunctionID.ValueString(), &client.InvokeFunctionRequest{
+		Input:   input,
+		Version: data.Version.ValueString(),
+		Mode:    data.Mode.ValueString(),
+	})
+	if err != nil && !isFunctionInvocationError(err) {
+		resp.Diagnostics.AddError(
+			"Error Invoking Function",
+			fmt.Sprintf("Could not invoke function ID %s: %s", data.FunctionID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setFunctionInvocationResult(&data, output, err)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// isFunctionInvocationError reports whether err was raised by the function
+// itself. The API reports those as a 500; rejected requests (4xx) and gateway
+// or availability errors (other 5xx) are not the function's result.
+func isFunctionInvocationError(err error) bool {
+	apiErr := &client.APIError{}
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	return apiErr.StatusCode == 500
+}
+
+func setFunctionInvocationResult(data *FunctionInvocationDataSourceModel, output json.RawMessage, invokeErr error) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if invokeErr != nil {
+		data.Output = types.StringNull()
+		data.Error = types.StringValue(functionInvocationErrorMessage(invokeErr))
+		return diags
+	}
+
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, output); err != nil {
+		diags.AddError(
+			"Error Invoking Function",
+			fmt.Sprintf("Could not decode function output: %s", err.Error()),
+		)
+		return diags
+	}
+
+	data.Output = types.StringValue(compacted.String())
+	data.Error = types.StringNull()
+	return diags
+}
+
+func functionInvocationErrorMessage(err error) string {
+	apiErr := &client.APIError{}
+	if errors.As(err, &apiErr) && apiErr.Message != "" {
+		return apiErr.Message
+	}
+	return err.Error()
+}
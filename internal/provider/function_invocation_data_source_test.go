@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFunctionInvocationDataSource(t *testing.T) {
+	testAccFunctionResourceRequiresAPIKey(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionResourceInlineCodeConfig("def handler(output, expected):\n    return 1.0 if output == expected else 0.0\n") + `
+data "braintrustdata_function_invocation" "test" {
+  function_id = braintrustdata_function.test.id
+  input = jsonencode({
+    output   = "4"
+    expected = "4"
+  })
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.braintrustdata_function_invocation.test", "function_id", "braintrustdata_function.test", "id"),
+					resource.TestCheckResourceAttrSet("data.braintrustdata_function_invocation.test", "output"),
+					resource.TestCheckNoResourceAttr("data.braintrustdata_function_invocation.test", "error"),
+				),
+			},
+		},
+	})
+}
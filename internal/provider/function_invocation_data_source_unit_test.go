@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+)
+
+func TestIsFunctionInvocationError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"function raised": {
+			err:  &client.APIError{StatusCode: 500, Message: "ZeroDivisionError: division by zero"},
+			want: true,
+		},
+		"invalid version": {
+			err:  &client.APIError{StatusCode: 400, Message: "version not found"},
+			want: false,
+		},
+		"bad gateway": {
+			err:  &client.APIError{StatusCode: 502, Message: "Bad Gateway"},
+			want: false,
+		},
+		"function not found": {
+			err:  &client.APIError{StatusCode: 400, Message: "Function does not exist or you do not have access"},
+			want: false,
+		},
+		"not found": {
+			err:  &client.APIError{StatusCode: 404, Message: "not found"},
+			want: false,
+		},
+		"unauthorized": {
+			err:  &client.APIError{StatusCode: 401, Message: "invalid API key"},
+			want: false,
+		},
+		"transport error": {
+			err:  errors.New("connection refused"),
+			want: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := isFunctionInvocationError(tc.err); got != tc.want {
+				t.Fatalf("isFunctionInvocationError() = %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSetFunctionInvocationResult(t *testing.T) {
+	t.Parallel()
+
+	var data FunctionInvocationDataSourceModel
+	diags := setFunctionInvocationResult(&data, json.RawMessage("{\n  \"score\": 1\n}"), nil)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if data.Output.ValueString() != `{"score":1}` {
+		t.Errorf("expected compacted output, got %q", data.Output.ValueString())
+	}
+	if !data.Error.IsNull() {
+		t.Errorf("expected null error, got %v", data.Error)
+	}
+
+	diags = setFunctionInvocationResult(&data, nil, &client.APIError{StatusCode: 500, Message: "boom"})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !data.Output.IsNull() {
+		t.Errorf("expected null output, got %v", data.Output)
+	}
+	if data.Error.ValueString() != "boom" {
+		t.Errorf("expected error boom, got %q", data.Error.ValueString())
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"strings"
 
 	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -16,11 +18,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ resource.Resource = &FunctionResource{}
 var _ resource.ResourceWithImportState = &FunctionResource{}
+var _ resource.ResourceWithModifyPlan = &FunctionResource{}
 
 // NewFunctionResource creates a new function resource instance.
 func NewFunctionResource() resource.Resource {
@@ -36,9 +40,12 @@ type FunctionResource struct {
 type FunctionResourceModel struct {
 	Metadata       types.Map    `tfsdk:"metadata"`
 	Tags           types.Set    `tfsdk:"tags"`
+	Code           types.Object `tfsdk:"code"`
+	CodeHash       types.String `tfsdk:"code_hash"`
 	XactID         types.String `tfsdk:"xact_id"`
 	Created        types.String `tfsdk:"created"`
 	Description    types.String `tfsdk:"description"`
+	Entrypoint     types.String `tfsdk:"entrypoint"`
 	FunctionData   types.String `tfsdk:"function_data"`
 	FunctionSchema types.String `tfsdk:"function_schema"`
 	FunctionType   types.String `tfsdk:"function_type"`
@@ -49,7 +56,12 @@ type FunctionResourceModel struct {
 	Origin         types.String `tfsdk:"origin"`
 	ProjectID      types.String `tfsdk:"project_id"`
 	PromptData     types.String `tfsdk:"prompt_data"`
+	Runtime        types.String `tfsdk:"runtime"`
+	RuntimeVersion types.String `tfsdk:"runtime_version"`
 	Slug           types.String `tfsdk:"slug"`
+	SourceDir      types.String `tfsdk:"source_dir"`
+	SourceFile     types.String `tfsdk:"source_file"`
+	SourceHash     types.String `tfsdk:"source_hash"`
 }
 
 // Metadata implements resource.Resource.
@@ -105,9 +117,93 @@ func (r *FunctionResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 			},
 			"function_data": schema.StringAttribute{
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
 				Sensitive:           true,
-				MarkdownDescription: "The function data as a JSON-encoded string. Use `jsonencode()` for structured content. Avoid embedding secrets; prefer `braintrustdata_environment_variable` for secret material.",
+				MarkdownDescription: "The function data as a JSON-encoded string. Use `jsonencode()` for structured content. Avoid embedding secrets; prefer `braintrustdata_environment_variable` for secret material. Exactly one of `function_data`, `code`, `source_dir` or `source_file` must be set; with `code` or a source, it is computed.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("code"), path.MatchRoot("source_dir"), path.MatchRoot("source_file")),
+				},
+			},
+			"code": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Inline code for small code functions such as scorers, stored in a computed `function_data` of type `code` without uploading a bundle.",
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRoot("runtime"), path.MatchRoot("runtime_version"), path.MatchRoot("entrypoint")),
+				},
+				Attributes: map[string]schema.Attribute{
+					"runtime": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "Runtime that executes the code: `node` or `python`.",
+						Validators: []validator.String{
+							stringvalidator.OneOf(functionRuntimeNode, functionRuntimePython),
+						},
+					},
+					"version": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "Version of `runtime`: a major version such as `20` for Node.js, or a 3.x version such as `3.12` for Python.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"source": schema.StringAttribute{
+						Required:            true,
+						Sensitive:           true,
+						MarkdownDescription: "The function's source code, for example `file(\"scorer.py\")`. Changes show up in the plan through `code_hash`.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+				},
+			},
+			"code_hash": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "SHA-256 hash of `code.source`, shown in plans in place of the sensitive source.",
+			},
+			"source_dir": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Local directory to bundle as the function's code. The directory is zipped, uploaded, and referenced from a computed `function_data` of type `code`. `.git` and `__pycache__` directories are skipped. The bundled code must register exactly one function, since the function is referenced by its position in the bundle. Requires `runtime` and `runtime_version`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("runtime"), path.MatchRoot("runtime_version")),
+				},
+			},
+			"source_file": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Local file to bundle as the function's code, as an alternative to `source_dir`. Requires `runtime` and `runtime_version`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("runtime"), path.MatchRoot("runtime_version")),
+				},
+			},
+			"runtime": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Runtime that executes the bundled code: `node` or `python`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(functionRuntimeNode, functionRuntimePython),
+					stringvalidator.AlsoRequires(path.MatchRoot("runtime_version")),
+				},
+			},
+			"runtime_version": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Version of `runtime`, for example `20` for Node.js or `3.12` for Python.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("runtime")),
+				},
+			},
+			"entrypoint": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Path of a file relative to `source_dir` whose source is shown as the code preview in the Braintrust UI. It does not select which function runs. Defaults to the file name of `source_file`; a `source_dir` bundle without it has no preview.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("function_data")),
+				},
+			},
+			"source_hash": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "SHA-256 hash of the bundled file names and contents. The bundle is re-uploaded only when this hash, `runtime`, `runtime_version` or `entrypoint` changes.",
 			},
 			"function_schema": schema.StringAttribute{
 				Optional:            true,
@@ -199,6 +295,106 @@ func (r *FunctionResource) Configure(_ context.Context, req resource.ConfigureRe
 	r.client = c
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan by hashing inline code
+// and local sources, so that edits to them produce a diff and unchanged code
+// keeps the previously computed function_data.
+func (r *FunctionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan FunctionResourceModel
+	var config FunctionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state *FunctionResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &FunctionResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// entrypoint is computed for source_file, so unknown in the plan only
+	// means "not yet known" when it is unknown in the configuration.
+	plan.Entrypoint = config.Entrypoint
+	plan.CodeHash = types.StringNull()
+	plan.SourceHash = types.StringNull()
+
+	switch {
+	case functionUsesInlineCode(plan):
+		resp.Diagnostics.Append(planFunctionInlineCode(ctx, &plan, state)...)
+	case functionUsesCodeBundle(plan):
+		resp.Diagnostics.Append(planFunctionCodeBundle(&plan, state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func planFunctionInlineCode(ctx context.Context, plan, state *FunctionResourceModel) diag.Diagnostics {
+	code, diags := functionInlineCode(ctx, plan.Code)
+	if diags.HasError() {
+		return diags
+	}
+	if code == nil {
+		plan.CodeHash = types.StringUnknown()
+		plan.FunctionData = types.StringUnknown()
+		return diags
+	}
+
+	if err := validateFunctionRuntimeVersion(code.Runtime.ValueString(), code.Version.ValueString()); err != nil {
+		diags.AddAttributeError(path.Root("code").AtName("version"), "Unsupported Function Runtime", err.Error())
+		return diags
+	}
+
+	plan.CodeHash = types.StringValue(functionCodeHash(code.Source.ValueString()))
+	plan.FunctionData = types.StringUnknown()
+	if state != nil && plan.Code.Equal(state.Code) && !state.FunctionData.IsNull() {
+		plan.FunctionData = state.FunctionData
+	}
+
+	return diags
+}
+
+func planFunctionCodeBundle(plan, state *FunctionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !plan.Runtime.IsUnknown() && !plan.RuntimeVersion.IsUnknown() {
+		if err := validateFunctionRuntimeVersion(plan.Runtime.ValueString(), plan.RuntimeVersion.ValueString()); err != nil {
+			diags.AddAttributeError(path.Root("runtime_version"), "Unsupported Function Runtime", err.Error())
+			return diags
+		}
+	}
+
+	if plan.SourceDir.IsUnknown() || plan.SourceFile.IsUnknown() || plan.Entrypoint.IsUnknown() {
+		plan.SourceHash = types.StringUnknown()
+		plan.FunctionData = types.StringUnknown()
+		return diags
+	}
+
+	bundle, diags := buildFunctionResourceCodeBundle(*plan)
+	if diags.HasError() {
+		return diags
+	}
+
+	plan.SourceHash = types.StringValue(bundle.hash)
+	plan.Entrypoint = stringOrNull(bundle.entrypoint)
+	plan.FunctionData = types.StringUnknown()
+	if state != nil && !functionCodeBundleChanged(*plan, *state) {
+		plan.FunctionData = state.FunctionData
+	}
+
+	return diags
+}
+
 // Create implements resource.Resource.
 func (r *FunctionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data FunctionResourceModel
@@ -207,6 +403,16 @@ func (r *FunctionResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	switch {
+	case functionUsesInlineCode(data):
+		resp.Diagnostics.Append(setFunctionInlineCodeData(ctx, &data)...)
+	case functionUsesCodeBundle(data):
+		resp.Diagnostics.Append(r.uploadFunctionCodeBundle(ctx, &data)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createReq, diags := buildCreateFunctionRequest(ctx, data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -279,6 +485,18 @@ func (r *FunctionResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	if plan.FunctionData.IsUnknown() {
+		switch {
+		case functionUsesInlineCode(plan):
+			resp.Diagnostics.Append(setFunctionInlineCodeData(ctx, &plan)...)
+		case functionUsesCodeBundle(plan):
+			resp.Diagnostics.Append(r.uploadFunctionCodeBundle(ctx, &plan)...)
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	updateReq, diags := buildUpdateFunctionRequest(ctx, plan, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -342,6 +560,108 @@ func (r *FunctionResource) ImportState(ctx context.Context, req resource.ImportS
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// setFunctionInlineCodeData sets function_data from the code attribute.
+func setFunctionInlineCodeData(ctx context.Context, data *FunctionResourceModel) diag.Diagnostics {
+	code, diags := functionInlineCode(ctx, data.Code)
+	if diags.HasError() {
+		return diags
+	}
+	if code == nil {
+		diags.AddAttributeError(path.Root("code"), "Invalid Function Code", "code must be known before apply")
+		return diags
+	}
+
+	functionData, err := inlineCodeFunctionData(code)
+	if err != nil {
+		diags.AddError("Invalid function_data", err.Error())
+		return diags
+	}
+
+	data.FunctionData = types.StringValue(functionData)
+	data.CodeHash = types.StringValue(functionCodeHash(code.Source.ValueString()))
+	return diags
+}
+
+// uploadFunctionCodeBundle zips the configured sources, uploads them and sets
+// function_data to reference the new bundle.
+func (r *FunctionResource) uploadFunctionCodeBundle(ctx context.Context, data *FunctionResourceModel) diag.Diagnostics {
+	bundle, diags := buildFunctionResourceCodeBundle(*data)
+	if diags.HasError() {
+		return diags
+	}
+
+	if !data.SourceHash.IsUnknown() && data.SourceHash.ValueString() != bundle.hash {
+		diags.AddError(
+			"Function Sources Changed",
+			"The function sources changed after the plan was created. Run terraform apply again to upload the current sources.",
+		)
+		return diags
+	}
+
+	upload, err := r.client.CreateCodeBundleUpload(ctx, &client.CodeBundleUploadRequest{
+		RuntimeContext: client.RuntimeContext{
+			Runtime: data.Runtime.ValueString(),
+			Version: data.RuntimeVersion.ValueString(),
+		},
+	})
+	if err != nil {
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to create code bundle upload, got error: %s", err),
+		)
+		return diags
+	}
+
+	if err := r.client.UploadCodeBundle(ctx, upload.URL, bundle.archive); err != nil {
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to upload code bundle, got error: %s", err),
+		)
+		return diags
+	}
+
+	functionData, err := codeBundleFunctionData(upload.BundleID, data.Runtime.ValueString(), data.RuntimeVersion.ValueString(), bundle.preview)
+	if err != nil {
+		diags.AddError("Invalid function_data", err.Error())
+		return diags
+	}
+
+	data.FunctionData = types.StringValue(functionData)
+	data.SourceHash = types.StringValue(bundle.hash)
+	data.Entrypoint = stringOrNull(bundle.entrypoint)
+	return diags
+}
+
+func functionUsesCodeBundle(data FunctionResourceModel) bool {
+	return !data.SourceDir.IsNull() || !data.SourceFile.IsNull()
+}
+
+// functionCodeBundleChanged reports whether the planned sources or runtime
+// differ from the ones last uploaded.
+func functionCodeBundleChanged(plan, state FunctionResourceModel) bool {
+	return !plan.SourceHash.Equal(state.SourceHash) ||
+		!plan.Runtime.Equal(state.Runtime) ||
+		!plan.RuntimeVersion.Equal(state.RuntimeVersion) ||
+		!plan.Entrypoint.Equal(state.Entrypoint) ||
+		state.FunctionData.IsNull()
+}
+
+func buildFunctionResourceCodeBundle(data FunctionResourceModel) (*functionCodeBundle, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	bundle, err := buildFunctionCodeBundle(data.SourceDir.ValueString(), data.SourceFile.ValueString(), data.Entrypoint.ValueString())
+	if err != nil {
+		sourcePath := path.Root("source_dir")
+		if !data.SourceFile.IsNull() {
+			sourcePath = path.Root("source_file")
+		}
+		diags.AddAttributeError(sourcePath, "Invalid Function Source", err.Error())
+		return nil, diags
+	}
+
+	return bundle, diags
+}
+
 func buildCreateFunctionRequest(ctx context.Context, data FunctionResourceModel) (*client.CreateFunctionRequest, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -3,9 +3,11 @@ package provider
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAccFunctionResource(t *testing.T) {
@@ -46,6 +48,83 @@ func TestAccFunctionResource(t *testing.T) {
 	})
 }
 
+func TestAccFunctionResource_SourceFile(t *testing.T) {
+	testAccFunctionResourceRequiresAPIKey(t)
+
+	sourceFile := filepath.Join(t.TempDir(), "scorer.py")
+	writeSource := func(content string) {
+		if err := os.WriteFile(sourceFile, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeSource("def handler(output, expected):\n    return 1.0 if output == expected else 0.0\n")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionResourceSourceFileConfig(sourceFile),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("braintrustdata_function.test", "entrypoint", "scorer.py"),
+					resource.TestCheckResourceAttrSet("braintrustdata_function.test", "source_hash"),
+					resource.TestCheckResourceAttrSet("braintrustdata_function.test", "function_data"),
+				),
+			},
+			{
+				Config: testAccFunctionResourceSourceFileConfig(sourceFile),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				PreConfig: func() {
+					writeSource("def handler(output, expected):\n    return 0.5\n")
+				},
+				Config: testAccFunctionResourceSourceFileConfig(sourceFile),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("braintrustdata_function.test", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccFunctionResource_InlineCode(t *testing.T) {
+	testAccFunctionResourceRequiresAPIKey(t)
+
+	initialSource := "def handler(output, expected):\n    return 1.0 if output == expected else 0.0\n"
+	updatedSource := "def handler(output, expected):\n    return 0.5\n"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionResourceInlineCodeConfig(initialSource),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("braintrustdata_function.test", "code.runtime", "python"),
+					resource.TestCheckResourceAttr("braintrustdata_function.test", "code_hash", functionCodeHash(initialSource)),
+					resource.TestCheckResourceAttrSet("braintrustdata_function.test", "function_data"),
+				),
+			},
+			{
+				Config: testAccFunctionResourceInlineCodeConfig(updatedSource),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("braintrustdata_function.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("braintrustdata_function.test", "code_hash", functionCodeHash(updatedSource)),
+			},
+		},
+	})
+}
+
 func testAccFunctionResourceConfig(name, description string) string {
 	metadataOwner := "terraform"
 	model := "gpt-4o-mini"
@@ -92,6 +171,42 @@ resource "braintrustdata_function" "test" {
 `, name, description, model, metadataOwner, tags)
 }
 
+func testAccFunctionResourceSourceFileConfig(sourceFile string) string {
+	return fmt.Sprintf(`
+resource "braintrustdata_project" "test" {
+  name = "test-project-for-function-bundle"
+}
+
+resource "braintrustdata_function" "test" {
+  project_id      = braintrustdata_project.test.id
+  name            = "test-bundled-scorer"
+  function_type   = "scorer"
+  source_file     = %q
+  runtime         = "python"
+  runtime_version = "3.12"
+}
+`, sourceFile)
+}
+
+func testAccFunctionResourceInlineCodeConfig(source string) string {
+	return fmt.Sprintf(`
+resource "braintrustdata_project" "test" {
+  name = "test-project-for-function-inline-code"
+}
+
+resource "braintrustdata_function" "test" {
+  project_id    = braintrustdata_project.test.id
+  name          = "test-inline-scorer"
+  function_type = "scorer"
+  code = {
+    runtime = "python"
+    version = "3.12"
+    source  = %q
+  }
+}
+`, source)
+}
+
 func testAccFunctionResourceRequiresAPIKey(t *testing.T) {
 	t.Helper()
 
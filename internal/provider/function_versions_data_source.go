@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &FunctionVersionsDataSource{}
+
+// NewFunctionVersionsDataSource creates a new function versions data source instance.
+func NewFunctionVersionsDataSource() datasource.DataSource {
+	return &FunctionVersionsDataSource{}
+}
+
+// FunctionVersionsDataSource defines the data source implementation.
+type FunctionVersionsDataSource struct {
+	client *client.Client
+}
+
+// FunctionVersionsDataSourceModel describes the data source data model.
+type FunctionVersionsDataSourceModel struct {
+	FunctionID types.String                        `tfsdk:"function_id"`
+	Versions   []FunctionVersionsDataSourceVersion `tfsdk:"versions"`
+	Limit      types.Int64                         `tfsdk:"limit"`
+}
+
+// FunctionVersionsDataSourceVersion represents a single function version in the list.
+type FunctionVersionsDataSourceVersion struct {
+	Version      types.String `tfsdk:"version"`
+	Created      types.String `tfsdk:"created"`
+	UserID       types.String `tfsdk:"user_id"`
+	Name         types.String `tfsdk:"name"`
+	Slug         types.String `tfsdk:"slug"`
+	Description  types.String `tfsdk:"description"`
+	FunctionData types.String `tfsdk:"function_data"`
+	PromptData   types.String `tfsdk:"prompt_data"`
+}
+
+// Metadata implements datasource.DataSource.
+func (d *FunctionVersionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_function_versions"
+}
+
+// Schema implements datasource.DataSource.
+func (d *FunctionVersionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the version history of a Braintrust function, newest first. Pass a `version` to `braintrustdata_function` to read a specific one.",
+		Attributes: map[string]schema.Attribute{
+			"function_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the function.",
+			},
+			"limit": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("Maximum number of versions to return, including the current one. Defaults to %d, at most %d.", defaultVersionsLimit, maxVersionsLimit),
+				Validators:          versionsLimitValidators(),
+			},
+			"versions": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The function versions, newest first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"version": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The version (transaction ID).",
+						},
+						"created": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the version was written.",
+						},
+						"user_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the user who wrote the version.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The function name at this version.",
+						},
+						"slug": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The function slug at this version.",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The function description at this version.",
+						},
+						"function_data": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The function data at this version as a JSON-encoded string.",
+						},
+						"prompt_data": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The prompt data at this version as a JSON-encoded string.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure implements datasource.DataSource.
+func (d *FunctionVersionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *FunctionVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FunctionVersionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	functionID := strings.TrimSpace(data.FunctionID.ValueString())
+	functions, err := d.client.ListFunctionVersions(ctx, functionID, versionsLimit(data.Limit))
+	if err != nil {
+		if client.IsFunctionNotFound(err) || client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Function Not Found",
+				fmt.Sprintf("No function found with ID: %s", functionID),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Listing Function Versions",
+			fmt.Sprintf("Could not list versions of function ID %s: %s", functionID, err.Error()),
+		)
+		return
+	}
+
+	data.Versions = make([]FunctionVersionsDataSourceVersion, 0, len(functions))
+	for i := range functions {
+		version, versionDiags := functionVersionsDataSourceVersionFromFunction(&functions[i])
+		resp.Diagnostics.Append(versionDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.Versions = append(data.Versions, version)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func functionVersionsDataSourceVersionFromFunction(function *client.Function) (FunctionVersionsDataSourceVersion, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	functionData, functionDataDiags := jsonEncodedOrNull("function_data", function.FunctionData)
+	diags.Append(functionDataDiags...)
+
+	promptData, promptDataDiags := jsonEncodedOrNull("prompt_data", function.PromptData)
+	diags.Append(promptDataDiags...)
+
+	return FunctionVersionsDataSourceVersion{
+		Version:      stringOrNull(function.XactID),
+		Created:      versionCreatedOrNull(function.XactID, function.Created),
+		UserID:       stringOrNull(function.UserID),
+		Name:         stringOrNull(function.Name),
+		Slug:         stringOrNull(function.Slug),
+		Description:  stringOrNull(function.Description),
+		FunctionData: functionData,
+		PromptData:   promptData,
+	}, diags
+}
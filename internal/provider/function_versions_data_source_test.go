@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFunctionVersionsDataSource_Basic(t *testing.T) {
+	testAccFunctionDataSourceRequiresAPIKey(t)
+
+	functionID, ok := testAccFunctionLookupContext()
+	if !ok {
+		t.Skip("BRAINTRUST_FUNCTION_ID must be set for function versions data source acceptance testing")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionVersionsDataSourceConfig(functionID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.braintrustdata_function_versions.test", "versions.0.version"),
+					resource.TestCheckResourceAttrSet("data.braintrustdata_function_versions.test", "versions.0.created"),
+					resource.TestCheckResourceAttrPair("data.braintrustdata_function_versions.test", "versions.0.version", "data.braintrustdata_function.latest", "xact_id"),
+					resource.TestCheckResourceAttrPair("data.braintrustdata_function.pinned", "function_data", "data.braintrustdata_function_versions.test", "versions.0.function_data"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFunctionVersionsDataSourceConfig(functionID string) string {
+	return fmt.Sprintf(`
+data "braintrustdata_function_versions" "test" {
+  function_id = %q
+  limit       = 3
+}
+
+data "braintrustdata_function" "latest" {
+  id = %q
+}
+
+data "braintrustdata_function" "pinned" {
+  id      = %q
+  version = data.braintrustdata_function_versions.test.versions[0].version
+}
+`, functionID, functionID, functionID)
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+)
+
+func TestFunctionVersionsDataSourceVersionFromFunction(t *testing.T) {
+	t.Parallel()
+
+	version, diags := functionVersionsDataSourceVersionFromFunction(&client.Function{
+		ID:     "func-1",
+		Name:   "scorer",
+		Slug:   "scorer",
+		XactID: "1000192656880881099",
+		UserID: "user-1",
+		FunctionData: map[string]interface{}{
+			"type": "code",
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if version.Version.ValueString() != "1000192656880881099" {
+		t.Fatalf("version mismatch: got=%q", version.Version.ValueString())
+	}
+	if version.Created.ValueString() != "2024-03-09T07:48:38Z" {
+		t.Fatalf("created mismatch: got=%q", version.Created.ValueString())
+	}
+	if version.UserID.ValueString() != "user-1" {
+		t.Fatalf("user_id mismatch: got=%q", version.UserID.ValueString())
+	}
+	if version.FunctionData.ValueString() != `{"type":"code"}` {
+		t.Fatalf("function_data mismatch: got=%q", version.FunctionData.ValueString())
+	}
+	if !version.PromptData.IsNull() {
+		t.Fatalf("expected prompt_data to be null")
+	}
+	if !version.Description.IsNull() {
+		t.Fatalf("expected description to be null")
+	}
+}
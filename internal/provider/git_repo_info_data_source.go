@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/gitrepo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const defaultGitRepoInfoMaxDiffBytes = 65536
+
+var _ datasource.DataSource = &GitRepoInfoDataSource{}
+
+// NewGitRepoInfoDataSource creates a new git repo info data source instance.
+func NewGitRepoInfoDataSource() datasource.DataSource {
+	return &GitRepoInfoDataSource{}
+}
+
+// GitRepoInfoDataSource defines the data source implementation. It reads the
+// local filesystem and does not call the Braintrust API.
+type GitRepoInfoDataSource struct{}
+
+// GitRepoInfoDataSourceModel describes the data source data model.
+type GitRepoInfoDataSourceModel struct {
+	RepoInfo     types.Object `tfsdk:"repo_info"`
+	Path         types.String `tfsdk:"path"`
+	WorkTree     types.String `tfsdk:"work_tree"`
+	GitDir       types.String `tfsdk:"git_dir"`
+	MaxDiffBytes types.Int64  `tfsdk:"max_diff_bytes"`
+	IncludeDiff  types.Bool   `tfsdk:"include_diff"`
+}
+
+// Metadata implements datasource.DataSource.
+func (d *GitRepoInfoDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_git_repo_info"
+}
+
+// Schema implements datasource.DataSource.
+func (d *GitRepoInfoDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads commit, branch, tag and working tree information from a local Git repository, without requiring a `git` binary. The `repo_info` attribute has the same shape as `braintrustdata_experiment.repo_info` and can be assigned to it directly.",
+		Attributes: map[string]schema.Attribute{
+			"path": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A path inside the repository. Parent directories are searched for `.git` like `git` does. Defaults to the current working directory.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"include_diff": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether to populate `repo_info.git_diff` with a diff of the working tree against the checked out commit. Defaults to false.",
+			},
+			"max_diff_bytes": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("Maximum size of `repo_info.git_diff` in bytes. Longer diffs are truncated. Defaults to %d.", defaultGitRepoInfoMaxDiffBytes),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"work_tree": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Absolute path of the repository's working tree.",
+			},
+			"git_dir": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Absolute path of the repository's git directory.",
+			},
+			"repo_info": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Git repository metadata, ready to assign to `braintrustdata_experiment.repo_info`.",
+				Attributes: map[string]schema.Attribute{
+					"commit": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "SHA of the checked out commit. Null for a repository without commits.",
+					},
+					"branch": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Checked out branch. Null when HEAD is detached.",
+					},
+					"tag": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Tag pointing at the checked out commit. When several do, the alphabetically first is used.",
+					},
+					"dirty": schema.BoolAttribute{
+						Computed:            true,
+						MarkdownDescription: "Whether tracked files differ from the checked out commit, either staged or in the working tree. Untracked files are ignored.",
+					},
+					"author_name": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Author name of the checked out commit.",
+					},
+					"author_email": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Author email of the checked out commit.",
+					},
+					"commit_message": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Message of the checked out commit.",
+					},
+					"commit_time": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Commit time of the checked out commit in RFC 3339 format.",
+					},
+					"git_diff": schema.StringAttribute{
+						Computed:            true,
+						Sensitive:           true,
+						MarkdownDescription: "Unified diff of the working tree against the checked out commit. Null unless `include_diff` is true and the repository is dirty.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GitRepoInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitRepoInfoDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	path := "."
+	if !data.Path.IsNull() {
+		path = data.Path.ValueString()
+	}
+
+	maxDiffBytes := int64(defaultGitRepoInfoMaxDiffBytes)
+	if !data.MaxDiffBytes.IsNull() {
+		maxDiffBytes = data.MaxDiffBytes.ValueInt64()
+	}
+
+	repo, err := gitrepo.Open(path)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Git Repository",
+			fmt.Sprintf("Could not open git repository at %s: %s", path, err.Error()),
+		)
+		return
+	}
+
+	info, err := repo.Info(gitrepo.InfoOptions{
+		IncludeDiff:  data.IncludeDiff.ValueBool(),
+		MaxDiffBytes: int(maxDiffBytes),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Git Repository",
+			fmt.Sprintf("Could not read git repository at %s: %s", repo.WorkTree, err.Error()),
+		)
+		return
+	}
+
+	repoInfo, diags := repoInfoToObject(gitRepoInfoToClient(info))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.WorkTree = types.StringValue(repo.WorkTree)
+	data.GitDir = types.StringValue(repo.GitDir)
+	data.RepoInfo = repoInfo
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// gitRepoInfoToClient converts repository information to the experiment API
+// shape, leaving fields that do not apply unset.
+func gitRepoInfoToClient(info *gitrepo.Info) *client.RepoInfo {
+	optional := func(value string) *string {
+		if value == "" {
+			return nil
+		}
+		return &value
+	}
+
+	dirty := info.Dirty
+	return &client.RepoInfo{
+		Commit:        optional(info.Commit),
+		Branch:        optional(info.Branch),
+		Tag:           optional(info.Tag),
+		Dirty:         &dirty,
+		AuthorName:    optional(info.AuthorName),
+		AuthorEmail:   optional(info.AuthorEmail),
+		CommitMessage: optional(info.CommitMessage),
+		CommitTime:    optional(info.CommitTime),
+		GitDiff:       optional(info.Diff),
+	}
+}
@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGitRepoInfoDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "braintrustdata_git_repo_info" "test" {
+  path = "."
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.braintrustdata_git_repo_info.test", "work_tree"),
+					resource.TestCheckResourceAttrSet("data.braintrustdata_git_repo_info.test", "git_dir"),
+					resource.TestMatchResourceAttr("data.braintrustdata_git_repo_info.test", "repo_info.commit", regexp.MustCompile(`^[0-9a-f]{40}$`)),
+					resource.TestCheckResourceAttrSet("data.braintrustdata_git_repo_info.test", "repo_info.dirty"),
+					resource.TestCheckNoResourceAttr("data.braintrustdata_git_repo_info.test", "repo_info.git_diff"),
+				),
+			},
+		},
+	})
+}
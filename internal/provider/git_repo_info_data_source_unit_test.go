@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/gitrepo"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestGitRepoInfoToClient(t *testing.T) {
+	t.Parallel()
+
+	repoInfo := gitRepoInfoToClient(&gitrepo.Info{
+		Commit:        "0123456789abcdef0123456789abcdef01234567",
+		Tag:           "v1.0.0",
+		AuthorName:    "Ada Lovelace",
+		AuthorEmail:   "ada@example.com",
+		CommitMessage: "Initial commit",
+		CommitTime:    "2023-11-14T22:13:20Z",
+	})
+
+	object, diags := repoInfoToObject(repoInfo)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	attrs := object.Attributes()
+	if got := attrs["commit"].(types.String).ValueString(); got != "0123456789abcdef0123456789abcdef01234567" {
+		t.Errorf("commit = %q", got)
+	}
+	if got := attrs["tag"].(types.String).ValueString(); got != "v1.0.0" {
+		t.Errorf("tag = %q", got)
+	}
+	if !attrs["branch"].IsNull() {
+		t.Errorf("expected null branch for detached HEAD, got %v", attrs["branch"])
+	}
+	if !attrs["git_diff"].IsNull() {
+		t.Errorf("expected null git_diff, got %v", attrs["git_diff"])
+	}
+	if dirty := attrs["dirty"].(types.Bool); dirty.IsNull() || dirty.ValueBool() {
+		t.Errorf("expected dirty = false, got %v", dirty)
+	}
+}
@@ -0,0 +1,373 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &GroupExpandedMembersDataSource{}
+
+// groupExpandedMembersUserBatchSize caps the number of IDs sent in one ListUsers call.
+const groupExpandedMembersUserBatchSize = 100
+
+// NewGroupExpandedMembersDataSource creates a new group expanded members data source instance.
+func NewGroupExpandedMembersDataSource() datasource.DataSource {
+	return &GroupExpandedMembersDataSource{}
+}
+
+// GroupExpandedMembersDataSource defines the data source implementation.
+type GroupExpandedMembersDataSource struct {
+	client *client.Client
+}
+
+// GroupExpandedMembersDataSourceModel describes the data source data model.
+type GroupExpandedMembersDataSourceModel struct {
+	ID             types.String                          `tfsdk:"id"`
+	GroupID        types.String                          `tfsdk:"group_id"`
+	UserID         types.String                          `tfsdk:"user_id"`
+	OrgID          types.String                          `tfsdk:"org_id"`
+	Users          []UsersDataSourceUser                 `tfsdk:"users"`
+	UserIDs        []string                              `tfsdk:"user_ids"`
+	MemberGroupIDs []string                              `tfsdk:"member_group_ids"`
+	Groups         []GroupExpandedMembersDataSourceGroup `tfsdk:"groups"`
+	GroupIDs       []string                              `tfsdk:"group_ids"`
+}
+
+// GroupExpandedMembersDataSourceGroup represents a group the user belongs to.
+type GroupExpandedMembersDataSourceGroup struct {
+	ID     types.String `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	Direct types.Bool   `tfsdk:"direct"`
+}
+
+// Metadata implements datasource.DataSource.
+func (d *GroupExpandedMembersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_expanded_members"
+}
+
+// Schema implements datasource.DataSource.
+func (d *GroupExpandedMembersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resolves nested Braintrust group membership. Given `group_id`, returns every user in the group directly or through `member_groups`. " +
+			"Given `user_id`, returns every group the user belongs to directly or transitively. Exactly one of `group_id` or `user_id` must be specified.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the group or user that was expanded.",
+			},
+			"group_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The ID of the group to expand into a flat list of users.",
+			},
+			"user_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The ID of the user to find every group for.",
+			},
+			"org_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The organization whose groups are searched. Defaults to the provider's organization_id.",
+			},
+			"user_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Sorted, de-duplicated IDs of every user in the group. Only set when `group_id` is specified.",
+			},
+			"users": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Details of every user in the group. Users that no longer exist are listed in `user_ids` only. Only set when `group_id` is specified.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the user.",
+						},
+						"given_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The user's given name.",
+						},
+						"family_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The user's family name.",
+						},
+						"email": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The user's email.",
+						},
+						"avatar_url": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The URL of the user's avatar image.",
+						},
+						"created": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The timestamp when the user was created.",
+						},
+					},
+				},
+			},
+			"member_group_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Sorted IDs of every group nested in the group, directly or transitively. Only set when `group_id` is specified.",
+			},
+			"group_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Sorted IDs of every group the user belongs to. Only set when `user_id` is specified.",
+			},
+			"groups": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Every group the user belongs to. Only set when `user_id` is specified.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the group.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the group.",
+						},
+						"direct": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the user is listed in the group's `member_users`, rather than inheriting membership through a nested group.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure implements datasource.DataSource.
+func (d *GroupExpandedMembersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *GroupExpandedMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GroupExpandedMembersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasGroup := !data.GroupID.IsNull() && data.GroupID.ValueString() != ""
+	hasUser := !data.UserID.IsNull() && data.UserID.ValueString() != ""
+	if hasGroup == hasUser {
+		resp.Diagnostics.AddError(
+			"Invalid Configuration",
+			"Exactly one of group_id or user_id must be specified.",
+		)
+		return
+	}
+
+	orgID := d.client.OrgID()
+	if !data.OrgID.IsNull() && data.OrgID.ValueString() != "" {
+		orgID = data.OrgID.ValueString()
+	}
+
+	groups, err := listAllGroups(ctx, d.client, orgID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Groups",
+			fmt.Sprintf("Could not list groups: %s", err.Error()),
+		)
+		return
+	}
+	index := indexGroups(groups)
+
+	data.OrgID = types.StringValue(orgID)
+	data.UserIDs = []string{}
+	data.Users = []UsersDataSourceUser{}
+	data.MemberGroupIDs = []string{}
+	data.GroupIDs = []string{}
+	data.Groups = []GroupExpandedMembersDataSourceGroup{}
+
+	if hasGroup {
+		groupID := data.GroupID.ValueString()
+		if _, ok := index[groupID]; !ok {
+			resp.Diagnostics.AddError(
+				"Group Not Found",
+				fmt.Sprintf("No group found with ID %s in organization %s", groupID, orgID),
+			)
+			return
+		}
+
+		userIDs, memberGroupIDs := expandGroupMembers(index, groupID)
+
+		users, err := d.listUsersByID(ctx, userIDs)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Listing Users",
+				fmt.Sprintf("Could not list users: %s", err.Error()),
+			)
+			return
+		}
+
+		data.ID = types.StringValue(groupID)
+		data.UserIDs = userIDs
+		data.MemberGroupIDs = memberGroupIDs
+		for _, id := range userIDs {
+			user, ok := users[id]
+			if !ok {
+				continue
+			}
+			data.Users = append(data.Users, UsersDataSourceUser{
+				ID:         types.StringValue(user.ID),
+				GivenName:  types.StringValue(user.GivenName),
+				FamilyName: types.StringValue(user.FamilyName),
+				Email:      types.StringValue(user.Email),
+				AvatarURL:  types.StringValue(user.AvatarURL),
+				Created:    types.StringValue(user.Created),
+			})
+		}
+	} else {
+		userID := data.UserID.ValueString()
+
+		data.ID = types.StringValue(userID)
+		for _, groupID := range groupsContainingUser(index, userID) {
+			group := index[groupID]
+			data.GroupIDs = append(data.GroupIDs, groupID)
+			data.Groups = append(data.Groups, GroupExpandedMembersDataSourceGroup{
+				ID:     types.StringValue(group.ID),
+				Name:   types.StringValue(group.Name),
+				Direct: types.BoolValue(slices.Contains(group.MemberUsers, userID)),
+			})
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listUsersByID fetches user details in batches and returns them keyed by ID.
+func (d *GroupExpandedMembersDataSource) listUsersByID(ctx context.Context, ids []string) (map[string]client.User, error) {
+	users := make(map[string]client.User, len(ids))
+
+	for start := 0; start < len(ids); start += groupExpandedMembersUserBatchSize {
+		batch := ids[start:min(start+groupExpandedMembersUserBatchSize, len(ids))]
+
+		listResp, err := d.client.ListUsers(ctx, &client.ListUsersOptions{
+			IDs:   batch,
+			Limit: len(batch),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, user := range listResp.Users {
+			users[user.ID] = user
+		}
+	}
+
+	return users, nil
+}
+
+// indexGroups keys the groups that have not been deleted by ID.
+func indexGroups(groups []client.Group) map[string]*client.Group {
+	index := make(map[string]*client.Group, len(groups))
+	for i := range groups {
+		if groups[i].DeletedAt != "" {
+			continue
+		}
+		index[groups[i].ID] = &groups[i]
+	}
+	return index
+}
+
+// expandGroupMembers returns the sorted IDs of every user in the group and of
+// every group nested in it. A group includes all users of its member_groups.
+func expandGroupMembers(index map[string]*client.Group, groupID string) ([]string, []string) {
+	userSet := map[string]struct{}{}
+	visited := map[string]bool{groupID: true}
+	memberGroupIDs := []string{}
+
+	queue := []string{groupID}
+	for len(queue) > 0 {
+		group, ok := index[queue[0]]
+		queue = queue[1:]
+		if !ok {
+			continue
+		}
+
+		for _, userID := range group.MemberUsers {
+			userSet[userID] = struct{}{}
+		}
+		for _, memberGroupID := range group.MemberGroups {
+			if visited[memberGroupID] {
+				continue
+			}
+			visited[memberGroupID] = true
+			memberGroupIDs = append(memberGroupIDs, memberGroupID)
+			queue = append(queue, memberGroupID)
+		}
+	}
+
+	userIDs := make([]string, 0, len(userSet))
+	for userID := range userSet {
+		userIDs = append(userIDs, userID)
+	}
+	sort.Strings(userIDs)
+	sort.Strings(memberGroupIDs)
+
+	return userIDs, memberGroupIDs
+}
+
+// groupsContainingUser returns the sorted IDs of every group the user belongs
+// to, starting from the groups that list the user directly and walking up to
+// every group that nests one of them.
+func groupsContainingUser(index map[string]*client.Group, userID string) []string {
+	parents := map[string][]string{}
+	var queue []string
+	for id, group := range index {
+		if slices.Contains(group.MemberUsers, userID) {
+			queue = append(queue, id)
+		}
+		for _, memberGroupID := range group.MemberGroups {
+			parents[memberGroupID] = append(parents[memberGroupID], id)
+		}
+	}
+
+	visited := map[string]bool{}
+	for _, id := range queue {
+		visited[id] = true
+	}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for _, parentID := range parents[id] {
+			if visited[parentID] {
+				continue
+			}
+			visited[parentID] = true
+			queue = append(queue, parentID)
+		}
+	}
+
+	groupIDs := make([]string, 0, len(visited))
+	for id := range visited {
+		groupIDs = append(groupIDs, id)
+	}
+	sort.Strings(groupIDs)
+
+	return groupIDs
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGroupExpandedMembersDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupExpandedMembersDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.braintrustdata_group_expanded_members.by_group", "id", "braintrustdata_group.parent", "id"),
+					resource.TestCheckResourceAttr("data.braintrustdata_group_expanded_members.by_group", "user_ids.#", "1"),
+					resource.TestCheckResourceAttr("data.braintrustdata_group_expanded_members.by_group", "user_ids.0", "866a8a8a-fee9-4a5b-8278-12970de499c2"),
+					resource.TestCheckResourceAttr("data.braintrustdata_group_expanded_members.by_group", "member_group_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.braintrustdata_group_expanded_members.by_group", "member_group_ids.0", "braintrustdata_group.child", "id"),
+					resource.TestCheckTypeSetElemAttrPair("data.braintrustdata_group_expanded_members.by_user", "group_ids.*", "braintrustdata_group.parent", "id"),
+					resource.TestCheckTypeSetElemAttrPair("data.braintrustdata_group_expanded_members.by_user", "group_ids.*", "braintrustdata_group.child", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGroupExpandedMembersDataSourceConfig() string {
+	return `
+resource "braintrustdata_group" "child" {
+  name         = "test-expanded-members-child"
+  member_users = ["866a8a8a-fee9-4a5b-8278-12970de499c2"]  # Real user ID
+}
+
+resource "braintrustdata_group" "parent" {
+  name          = "test-expanded-members-parent"
+  member_groups = [braintrustdata_group.child.id]
+}
+
+data "braintrustdata_group_expanded_members" "by_group" {
+  group_id = braintrustdata_group.parent.id
+}
+
+data "braintrustdata_group_expanded_members" "by_user" {
+  user_id = "866a8a8a-fee9-4a5b-8278-12970de499c2"
+
+  depends_on = [braintrustdata_group.parent]
+}
+`
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+)
+
+func testGroupIndex() map[string]*client.Group {
+	return indexGroups([]client.Group{
+		{ID: "admins", MemberUsers: []string{"alice"}, MemberGroups: []string{"engineers"}},
+		{ID: "engineers", MemberUsers: []string{"bob", "carol"}, MemberGroups: []string{"contractors"}},
+		{ID: "contractors", MemberUsers: []string{"dave", "bob"}},
+		{ID: "loop-a", MemberUsers: []string{"erin"}, MemberGroups: []string{"loop-b"}},
+		{ID: "loop-b", MemberGroups: []string{"loop-a"}},
+		{ID: "deleted", MemberUsers: []string{"bob"}, DeletedAt: "2024-01-01T00:00:00Z"},
+	})
+}
+
+func TestExpandGroupMembers(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		groupID       string
+		wantUsers     []string
+		wantSubgroups []string
+	}{
+		"nested groups are flattened and de-duplicated": {
+			groupID:       "admins",
+			wantUsers:     []string{"alice", "bob", "carol", "dave"},
+			wantSubgroups: []string{"contractors", "engineers"},
+		},
+		"leaf group": {
+			groupID:       "contractors",
+			wantUsers:     []string{"bob", "dave"},
+			wantSubgroups: []string{},
+		},
+		"cycle terminates": {
+			groupID:       "loop-b",
+			wantUsers:     []string{"erin"},
+			wantSubgroups: []string{"loop-a"},
+		},
+		"unknown group": {
+			groupID:       "missing",
+			wantUsers:     []string{},
+			wantSubgroups: []string{},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			users, subgroups := expandGroupMembers(testGroupIndex(), tc.groupID)
+			if !reflect.DeepEqual(users, tc.wantUsers) {
+				t.Fatalf("users mismatch: got %#v, want %#v", users, tc.wantUsers)
+			}
+			if !reflect.DeepEqual(subgroups, tc.wantSubgroups) {
+				t.Fatalf("member groups mismatch: got %#v, want %#v", subgroups, tc.wantSubgroups)
+			}
+		})
+	}
+}
+
+func TestGroupsContainingUser(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		userID string
+		want   []string
+	}{
+		"direct and transitive membership": {
+			userID: "dave",
+			want:   []string{"admins", "contractors", "engineers"},
+		},
+		"deleted groups are ignored": {
+			userID: "bob",
+			want:   []string{"admins", "contractors", "engineers"},
+		},
+		"cycle terminates": {
+			userID: "erin",
+			want:   []string{"loop-a", "loop-b"},
+		},
+		"no membership": {
+			userID: "nobody",
+			want:   []string{},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := groupsContainingUser(testGroupIndex(), tc.userID)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("got %#v, want %#v", got, tc.want)
+			}
+		})
+	}
+}
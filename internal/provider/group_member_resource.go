@@ -0,0 +1,342 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GroupMemberResource{}
+var _ resource.ResourceWithImportState = &GroupMemberResource{}
+var _ resource.ResourceWithModifyPlan = &GroupMemberResource{}
+var _ resource.ResourceWithValidateConfig = &GroupMemberResource{}
+
+const (
+	groupMemberTypeUser  = "user"
+	groupMemberTypeGroup = "group"
+)
+
+// NewGroupMemberResource creates a new group member resource instance.
+func NewGroupMemberResource() resource.Resource {
+	return &GroupMemberResource{}
+}
+
+// GroupMemberResource defines the resource implementation.
+type GroupMemberResource struct {
+	client *client.Client
+}
+
+// GroupMemberResourceModel describes the resource data model.
+type GroupMemberResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	GroupID       types.String `tfsdk:"group_id"`
+	UserID        types.String `tfsdk:"user_id"`
+	MemberGroupID types.String `tfsdk:"member_group_id"`
+}
+
+// Metadata implements resource.Resource.
+func (r *GroupMemberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_member"
+}
+
+// Schema implements resource.Resource.
+func (r *GroupMemberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a single member of a Braintrust group without taking ownership of the rest of the group's membership. " +
+			"Do not combine with `member_users` or `member_groups` on `braintrustdata_group` for the same group, since those attributes are authoritative. " +
+			"Plans are rejected when a nested group would make the group a member of itself given the organization's current groups; cycles formed only by several memberships created in the same apply are not detected.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The membership identifier in the format `<group_id>,<user|group>,<member_id>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the group to add the member to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The ID of the user to add to the group. Exactly one of user_id or member_group_id must be specified.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"member_group_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The ID of the group to nest inside the group. Exactly one of user_id or member_group_id must be specified.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Configure implements resource.Resource.
+func (r *GroupMemberResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create implements resource.Resource by adding the member to the group.
+func (r *GroupMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GroupMemberResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memberType, memberID, err := groupMemberFromModel(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+
+	groupID := data.GroupID.ValueString()
+	_, err = r.client.UpdateGroup(ctx, groupID, buildGroupMembershipDeltaRequest(memberType, []string{memberID}, nil))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add member to group, got error: %s", err))
+		return
+	}
+
+	group, err := r.client.GetGroup(ctx, groupID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group after adding member, got error: %s", err))
+		return
+	}
+
+	if !groupHasMember(group, memberType, memberID) {
+		resp.Diagnostics.AddError(
+			"Membership Not Applied",
+			fmt.Sprintf("The %s %s was not found in group %s after it was added.", memberType, memberID, groupID),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(groupMemberID(groupID, memberType, memberID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read implements resource.Resource by checking that the member is still in the group.
+func (r *GroupMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GroupMemberResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memberType, memberID, err := groupMemberFromModel(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid State", err.Error())
+		return
+	}
+
+	group, err := r.client.GetGroup(ctx, data.GroupID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group, got error: %s", err))
+		return
+	}
+
+	// A deleted group or a member removed out-of-band both mean the membership is gone.
+	if group.DeletedAt != "" || !groupHasMember(group, memberType, memberID) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(groupMemberID(group.ID, memberType, memberID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource.
+// Note: every attribute requires replacement, so this is never called in practice.
+func (r *GroupMemberResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Update Not Supported",
+		"Group memberships are immutable and cannot be updated. All changes require replacement.",
+	)
+}
+
+// Delete implements resource.Resource by removing the member from the group.
+func (r *GroupMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GroupMemberResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memberType, memberID, err := groupMemberFromModel(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid State", err.Error())
+		return
+	}
+
+	_, err = r.client.UpdateGroup(ctx, data.GroupID.ValueString(), buildGroupMembershipDeltaRequest(memberType, nil, []string{memberID}))
+	if err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove member from group, got error: %s", err))
+		return
+	}
+}
+
+// ValidateConfig implements resource.ResourceWithValidateConfig.
+func (r *GroupMemberResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data GroupMemberResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The member may come from another resource and only be known at apply.
+	if data.UserID.IsUnknown() || data.MemberGroupID.IsUnknown() {
+		return
+	}
+
+	if _, _, err := groupMemberFromModel(data); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("user_id"), "Invalid Attribute Combination", err.Error())
+	}
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan by rejecting a nested
+// group that would make the group a member of itself.
+func (r *GroupMemberResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan GroupMemberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.GroupID.IsNull() || plan.GroupID.IsUnknown() || plan.MemberGroupID.IsNull() || plan.MemberGroupID.IsUnknown() {
+		return
+	}
+
+	memberGroupID := plan.MemberGroupID.ValueString()
+	resp.Diagnostics.Append(checkGroupMembershipCycle(ctx, r.client, plan.GroupID.ValueString(), path.Root("member_group_id"), func(current []string) []string {
+		return append(current, memberGroupID)
+	})...)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (r *GroupMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	groupID, memberType, memberID, err := parseGroupMemberImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), groupMemberID(groupID, memberType, memberID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), groupID)...)
+	if memberType == groupMemberTypeUser {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), memberID)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("member_group_id"), memberID)...)
+	}
+}
+
+func groupMemberFromModel(data GroupMemberResourceModel) (string, string, error) {
+	hasUser := !data.UserID.IsNull() && data.UserID.ValueString() != ""
+	hasGroup := !data.MemberGroupID.IsNull() && data.MemberGroupID.ValueString() != ""
+
+	switch {
+	case hasUser && !hasGroup:
+		return groupMemberTypeUser, data.UserID.ValueString(), nil
+	case hasGroup && !hasUser:
+		return groupMemberTypeGroup, data.MemberGroupID.ValueString(), nil
+	default:
+		return "", "", fmt.Errorf("exactly one of user_id or member_group_id must be specified")
+	}
+}
+
+func groupMemberID(groupID, memberType, memberID string) string {
+	return strings.Join([]string{groupID, memberType, memberID}, ",")
+}
+
+func parseGroupMemberImportID(raw string) (string, string, string, error) {
+	parts := strings.Split(raw, ",")
+	if len(parts) != 3 {
+		return "", "", "", fmt.Errorf("expected import ID in the format <group_id>,<user|group>,<member_id>")
+	}
+
+	groupID := strings.TrimSpace(parts[0])
+	memberType := strings.TrimSpace(parts[1])
+	memberID := strings.TrimSpace(parts[2])
+	if groupID == "" || memberID == "" {
+		return "", "", "", fmt.Errorf("expected import ID in the format <group_id>,<user|group>,<member_id>")
+	}
+	if memberType != groupMemberTypeUser && memberType != groupMemberTypeGroup {
+		return "", "", "", fmt.Errorf("member type must be %q or %q, got %q", groupMemberTypeUser, groupMemberTypeGroup, memberType)
+	}
+
+	return groupID, memberType, memberID, nil
+}
+
+func groupHasMember(group *client.Group, memberType, memberID string) bool {
+	if memberType == groupMemberTypeUser {
+		return slices.Contains(group.MemberUsers, memberID)
+	}
+	return slices.Contains(group.MemberGroups, memberID)
+}
+
+// buildGroupMembershipDeltaRequest builds an incremental membership update
+// that only touches the given members, leaving the rest of the group intact.
+func buildGroupMembershipDeltaRequest(memberType string, add, remove []string) *client.UpdateGroupRequest {
+	if memberType == groupMemberTypeUser {
+		return &client.UpdateGroupRequest{
+			AddMemberUsers:    add,
+			RemoveMemberUsers: remove,
+		}
+	}
+
+	return &client.UpdateGroupRequest{
+		AddMemberGroups:    add,
+		RemoveMemberGroups: remove,
+	}
+}
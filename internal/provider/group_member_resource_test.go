@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGroupMemberResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGroupMemberResourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("braintrustdata_group_member.test", "group_id", "braintrustdata_group.parent", "id"),
+					resource.TestCheckResourceAttrPair("braintrustdata_group_member.test", "member_group_id", "braintrustdata_group.child", "id"),
+					resource.TestCheckResourceAttrSet("braintrustdata_group_member.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "braintrustdata_group_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccGroupMembersResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembersResourceConfig(1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("braintrustdata_group_members.test", "id", "braintrustdata_group.parent", "id"),
+					resource.TestCheckResourceAttr("braintrustdata_group_members.test", "member_groups.#", "1"),
+				),
+			},
+			{
+				Config: testAccGroupMembersResourceConfig(2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("braintrustdata_group_members.test", "member_groups.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGroupMemberResourceConfig() string {
+	return `
+resource "braintrustdata_group" "parent" {
+  name        = "test-group-member-parent"
+  description = "Group managed without authoritative membership"
+}
+
+resource "braintrustdata_group" "child" {
+  name        = "test-group-member-child"
+  description = "Group nested via braintrustdata_group_member"
+}
+
+resource "braintrustdata_group_member" "test" {
+  group_id        = braintrustdata_group.parent.id
+  member_group_id = braintrustdata_group.child.id
+}
+`
+}
+
+func testAccGroupMembersResourceConfig(memberCount int) string {
+	members := "braintrustdata_group.child_a.id"
+	if memberCount > 1 {
+		members += ", braintrustdata_group.child_b.id"
+	}
+
+	return `
+resource "braintrustdata_group" "parent" {
+  name        = "test-group-members-parent"
+  description = "Group managed without authoritative membership"
+}
+
+resource "braintrustdata_group" "child_a" {
+  name = "test-group-members-child-a"
+}
+
+resource "braintrustdata_group" "child_b" {
+  name = "test-group-members-child-b"
+}
+
+resource "braintrustdata_group_members" "test" {
+  group_id      = braintrustdata_group.parent.id
+  member_groups = [` + members + `]
+}
+`
+}
@@ -0,0 +1,284 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestParseGroupMemberImportID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		raw            string
+		wantGroupID    string
+		wantMemberType string
+		wantMemberID   string
+		wantErr        bool
+	}{
+		"user member": {
+			raw:            "group-1,user,user-1",
+			wantGroupID:    "group-1",
+			wantMemberType: groupMemberTypeUser,
+			wantMemberID:   "user-1",
+		},
+		"group member with whitespace": {
+			raw:            " group-1 , group , group-2 ",
+			wantGroupID:    "group-1",
+			wantMemberType: groupMemberTypeGroup,
+			wantMemberID:   "group-2",
+		},
+		"missing parts": {
+			raw:     "group-1,user-1",
+			wantErr: true,
+		},
+		"unknown member type": {
+			raw:     "group-1,role,role-1",
+			wantErr: true,
+		},
+		"empty member ID": {
+			raw:     "group-1,user,",
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			groupID, memberType, memberID, err := parseGroupMemberImportID(tc.raw)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if groupID != tc.wantGroupID || memberType != tc.wantMemberType || memberID != tc.wantMemberID {
+				t.Fatalf("got (%q, %q, %q), want (%q, %q, %q)", groupID, memberType, memberID, tc.wantGroupID, tc.wantMemberType, tc.wantMemberID)
+			}
+		})
+	}
+}
+
+func TestGroupMemberFromModel(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		data           GroupMemberResourceModel
+		wantMemberType string
+		wantMemberID   string
+		wantErr        bool
+	}{
+		"user": {
+			data: GroupMemberResourceModel{
+				UserID:        types.StringValue("user-1"),
+				MemberGroupID: types.StringNull(),
+			},
+			wantMemberType: groupMemberTypeUser,
+			wantMemberID:   "user-1",
+		},
+		"group": {
+			data: GroupMemberResourceModel{
+				UserID:        types.StringNull(),
+				MemberGroupID: types.StringValue("group-2"),
+			},
+			wantMemberType: groupMemberTypeGroup,
+			wantMemberID:   "group-2",
+		},
+		"both set": {
+			data: GroupMemberResourceModel{
+				UserID:        types.StringValue("user-1"),
+				MemberGroupID: types.StringValue("group-2"),
+			},
+			wantErr: true,
+		},
+		"neither set": {
+			data: GroupMemberResourceModel{
+				UserID:        types.StringNull(),
+				MemberGroupID: types.StringNull(),
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			memberType, memberID, err := groupMemberFromModel(tc.data)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if memberType != tc.wantMemberType || memberID != tc.wantMemberID {
+				t.Fatalf("got (%q, %q), want (%q, %q)", memberType, memberID, tc.wantMemberType, tc.wantMemberID)
+			}
+		})
+	}
+}
+
+func TestGroupMemberResourceValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewGroupMemberResource().(*GroupMemberResource)
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":              tftypes.String,
+			"group_id":        tftypes.String,
+			"user_id":         tftypes.String,
+			"member_group_id": tftypes.String,
+		},
+	}
+
+	testCases := map[string]struct {
+		userID        tftypes.Value
+		memberGroupID tftypes.Value
+		wantErr       bool
+	}{
+		"user": {
+			userID:        tftypes.NewValue(tftypes.String, "user-1"),
+			memberGroupID: tftypes.NewValue(tftypes.String, nil),
+		},
+		"member group": {
+			userID:        tftypes.NewValue(tftypes.String, nil),
+			memberGroupID: tftypes.NewValue(tftypes.String, "group-2"),
+		},
+		"both": {
+			userID:        tftypes.NewValue(tftypes.String, "user-1"),
+			memberGroupID: tftypes.NewValue(tftypes.String, "group-2"),
+			wantErr:       true,
+		},
+		"neither": {
+			userID:        tftypes.NewValue(tftypes.String, nil),
+			memberGroupID: tftypes.NewValue(tftypes.String, nil),
+			wantErr:       true,
+		},
+		"unknown member": {
+			userID:        tftypes.NewValue(tftypes.String, nil),
+			memberGroupID: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{
+					Schema: schemaResp.Schema,
+					Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+						"id":              tftypes.NewValue(tftypes.String, nil),
+						"group_id":        tftypes.NewValue(tftypes.String, "group-1"),
+						"user_id":         tc.userID,
+						"member_group_id": tc.memberGroupID,
+					}),
+				},
+			}
+			var resp resource.ValidateConfigResponse
+			r.ValidateConfig(ctx, req, &resp)
+
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Fatalf("expected error %t, got diagnostics: %v", tc.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestBuildGroupMembershipDeltaRequest(t *testing.T) {
+	t.Parallel()
+
+	userReq := buildGroupMembershipDeltaRequest(groupMemberTypeUser, []string{"user-1"}, nil)
+	if !reflect.DeepEqual(userReq, &client.UpdateGroupRequest{AddMemberUsers: []string{"user-1"}}) {
+		t.Fatalf("unexpected user delta request: %#v", userReq)
+	}
+
+	groupReq := buildGroupMembershipDeltaRequest(groupMemberTypeGroup, nil, []string{"group-2"})
+	if !reflect.DeepEqual(groupReq, &client.UpdateGroupRequest{RemoveMemberGroups: []string{"group-2"}}) {
+		t.Fatalf("unexpected group delta request: %#v", groupReq)
+	}
+}
+
+func TestOwnedGroupMembersFromRemote(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		owned  types.Set
+		want   types.Set
+		remote []string
+	}{
+		"null owned stays null": {
+			owned:  types.SetNull(types.StringType),
+			remote: []string{"user-1"},
+			want:   types.SetNull(types.StringType),
+		},
+		"ignores members managed elsewhere": {
+			owned: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("user-1"),
+			}),
+			remote: []string{"user-1", "user-other"},
+			want: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("user-1"),
+			}),
+		},
+		"drops members removed out-of-band": {
+			owned: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("user-1"),
+				types.StringValue("user-2"),
+			}),
+			remote: []string{"user-2"},
+			want: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("user-2"),
+			}),
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := ownedGroupMembersFromRemote(ctx, tc.owned, tc.remote)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !got.Equal(tc.want) {
+				t.Fatalf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestHasGroupMembershipDelta(t *testing.T) {
+	t.Parallel()
+
+	if hasGroupMembershipDelta(&client.UpdateGroupRequest{}) {
+		t.Fatalf("expected empty request to have no delta")
+	}
+	if !hasGroupMembershipDelta(&client.UpdateGroupRequest{RemoveMemberGroups: []string{"group-1"}}) {
+		t.Fatalf("expected removal to count as delta")
+	}
+}
@@ -0,0 +1,425 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GroupMembersResource{}
+var _ resource.ResourceWithModifyPlan = &GroupMembersResource{}
+var _ resource.ResourceWithImportState = &GroupMembersResource{}
+
+// NewGroupMembersResource creates a new group members resource instance.
+func NewGroupMembersResource() resource.Resource {
+	return &GroupMembersResource{}
+}
+
+// GroupMembersResource defines the resource implementation.
+type GroupMembersResource struct {
+	client *client.Client
+}
+
+// GroupMembersResourceModel describes the resource data model.
+type GroupMembersResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	GroupID      types.String `tfsdk:"group_id"`
+	MemberUsers  types.Set    `tfsdk:"member_users"`
+	MemberGroups types.Set    `tfsdk:"member_groups"`
+}
+
+// Metadata implements resource.Resource.
+func (r *GroupMembersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_members"
+}
+
+// Schema implements resource.Resource.
+func (r *GroupMembersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a subset of the members of a Braintrust group. Only the users and groups listed here are added or removed; " +
+			"members managed elsewhere are left untouched. Do not combine with `member_users` or `member_groups` on `braintrustdata_group` for the same group, since those attributes are authoritative. " +
+			"Plans are rejected when a nested group would make the group a member of itself given the organization's current groups; cycles formed only by several memberships created in the same apply are not detected. " +
+			"Importing adopts every current member of the group, so list all of them in the configuration or they are removed on the next apply.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of this membership set. Matches `group_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the group to manage members of.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"member_users": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Set of user IDs owned by this resource.",
+			},
+			"member_groups": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Set of group IDs owned by this resource.",
+			},
+		},
+	}
+}
+
+// Configure implements resource.Resource.
+func (r *GroupMembersResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create implements resource.Resource by adding the owned members to the group.
+func (r *GroupMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GroupMembersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq, diags := buildGroupMembersCreateRequest(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if hasGroupMembershipDelta(updateReq) {
+		if _, err := r.client.UpdateGroup(ctx, data.GroupID.ValueString(), updateReq); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add members to group, got error: %s", err))
+			return
+		}
+	}
+
+	data.ID = data.GroupID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read implements resource.Resource by dropping owned members that were removed out-of-band.
+func (r *GroupMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GroupMembersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.client.GetGroup(ctx, data.GroupID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group, got error: %s", err))
+		return
+	}
+
+	if group.DeletedAt != "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	memberUsers, diags := ownedGroupMembersFromRemote(ctx, data.MemberUsers, group.MemberUsers)
+	resp.Diagnostics.Append(diags...)
+	memberGroups, diags := ownedGroupMembersFromRemote(ctx, data.MemberGroups, group.MemberGroups)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(group.ID)
+	data.MemberUsers = memberUsers
+	data.MemberGroups = memberGroups
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource by applying only the membership delta between state and plan.
+func (r *GroupMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GroupMembersResourceModel
+	var state GroupMembersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq, diags := buildGroupMembersUpdateRequest(ctx, data, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if hasGroupMembershipDelta(updateReq) {
+		if _, err := r.client.UpdateGroup(ctx, data.GroupID.ValueString(), updateReq); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update group members, got error: %s", err))
+			return
+		}
+	}
+
+	data.ID = state.ID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete implements resource.Resource by removing only the owned members from the group.
+func (r *GroupMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GroupMembersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq, diags := buildGroupMembersDeleteRequest(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !hasGroupMembershipDelta(updateReq) {
+		return
+	}
+
+	if _, err := r.client.UpdateGroup(ctx, data.GroupID.ValueString(), updateReq); err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove members from group, got error: %s", err))
+		return
+	}
+}
+
+// ImportState implements resource.ResourceWithImportState. The import ID is
+// the group ID, and every current member of the group is adopted.
+func (r *GroupMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	groupID := strings.TrimSpace(req.ID)
+	if groupID == "" {
+		resp.Diagnostics.AddError("Invalid import ID", "expected import ID in the format <group_id>")
+		return
+	}
+
+	group, err := r.client.GetGroup(ctx, groupID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group, got error: %s", err))
+		return
+	}
+
+	data, diags := groupMembersModelFromGroup(ctx, group)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan by rejecting member
+// groups that would make the group a member of itself.
+func (r *GroupMembersResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan GroupMembersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.GroupID.IsNull() || plan.GroupID.IsUnknown() || plan.MemberGroups.IsUnknown() {
+		return
+	}
+
+	desired, diags := setToStringSlice(ctx, plan.MemberGroups)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || len(desired) == 0 {
+		return
+	}
+
+	var owned []string
+	if !req.State.Raw.IsNull() {
+		var state GroupMembersResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		owned, diags = setToStringSlice(ctx, state.MemberGroups)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(checkGroupMembershipCycle(ctx, r.client, plan.GroupID.ValueString(), path.Root("member_groups"), func(current []string) []string {
+		return plannedGroupMembers(current, owned, desired)
+	})...)
+}
+
+// plannedGroupMembers returns the members a group has once owned members are
+// replaced by desired ones, leaving members managed elsewhere in place.
+func plannedGroupMembers(current, owned, desired []string) []string {
+	planned := make([]string, 0, len(current)+len(desired))
+	for _, member := range current {
+		if !slices.Contains(owned, member) {
+			planned = append(planned, member)
+		}
+	}
+
+	return append(planned, desired...)
+}
+
+// buildGroupMembersCreateRequest adds every owned member.
+func buildGroupMembersCreateRequest(ctx context.Context, data GroupMembersResourceModel) (*client.UpdateGroupRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	memberUsers, setDiags := setToStringSlice(ctx, data.MemberUsers)
+	diags.Append(setDiags...)
+	memberGroups, setDiags := setToStringSlice(ctx, data.MemberGroups)
+	diags.Append(setDiags...)
+
+	return &client.UpdateGroupRequest{
+		AddMemberUsers:  memberUsers,
+		AddMemberGroups: memberGroups,
+	}, diags
+}
+
+// buildGroupMembersUpdateRequest adds and removes only the owned members that
+// differ between state and plan.
+func buildGroupMembersUpdateRequest(ctx context.Context, plan, state GroupMembersResourceModel) (*client.UpdateGroupRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	currentUsers, setDiags := setToStringSlice(ctx, state.MemberUsers)
+	diags.Append(setDiags...)
+	currentGroups, setDiags := setToStringSlice(ctx, state.MemberGroups)
+	diags.Append(setDiags...)
+	desiredUsers, setDiags := setToStringSlice(ctx, plan.MemberUsers)
+	diags.Append(setDiags...)
+	desiredGroups, setDiags := setToStringSlice(ctx, plan.MemberGroups)
+	diags.Append(setDiags...)
+
+	addUsers, removeUsers := computeStringSliceDiff(currentUsers, desiredUsers)
+	addGroups, removeGroups := computeStringSliceDiff(currentGroups, desiredGroups)
+
+	return &client.UpdateGroupRequest{
+		AddMemberUsers:     addUsers,
+		RemoveMemberUsers:  removeUsers,
+		AddMemberGroups:    addGroups,
+		RemoveMemberGroups: removeGroups,
+	}, diags
+}
+
+// buildGroupMembersDeleteRequest removes every owned member.
+func buildGroupMembersDeleteRequest(ctx context.Context, data GroupMembersResourceModel) (*client.UpdateGroupRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	memberUsers, setDiags := setToStringSlice(ctx, data.MemberUsers)
+	diags.Append(setDiags...)
+	memberGroups, setDiags := setToStringSlice(ctx, data.MemberGroups)
+	diags.Append(setDiags...)
+
+	return &client.UpdateGroupRequest{
+		RemoveMemberUsers:  memberUsers,
+		RemoveMemberGroups: memberGroups,
+	}, diags
+}
+
+// groupMembersModelFromGroup owns every current member of the group. Empty
+// member lists are stored as null to match an omitted attribute.
+func groupMembersModelFromGroup(ctx context.Context, group *client.Group) (GroupMembersResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	data := GroupMembersResourceModel{
+		ID:           types.StringValue(group.ID),
+		GroupID:      types.StringValue(group.ID),
+		MemberUsers:  types.SetNull(types.StringType),
+		MemberGroups: types.SetNull(types.StringType),
+	}
+
+	if len(group.MemberUsers) > 0 {
+		memberUsers, setDiags := types.SetValueFrom(ctx, types.StringType, group.MemberUsers)
+		diags.Append(setDiags...)
+		data.MemberUsers = memberUsers
+	}
+	if len(group.MemberGroups) > 0 {
+		memberGroups, setDiags := types.SetValueFrom(ctx, types.StringType, group.MemberGroups)
+		diags.Append(setDiags...)
+		data.MemberGroups = memberGroups
+	}
+
+	return data, diags
+}
+
+func hasGroupMembershipDelta(req *client.UpdateGroupRequest) bool {
+	return len(req.AddMemberUsers) > 0 ||
+		len(req.RemoveMemberUsers) > 0 ||
+		len(req.AddMemberGroups) > 0 ||
+		len(req.RemoveMemberGroups) > 0
+}
+
+// ownedGroupMembersFromRemote keeps the owned members that are still present
+// remotely, so members removed out-of-band show up as a diff on the next plan.
+func ownedGroupMembersFromRemote(ctx context.Context, owned types.Set, remote []string) (types.Set, diag.Diagnostics) {
+	if owned.IsNull() || owned.IsUnknown() {
+		return owned, nil
+	}
+
+	ownedMembers, diags := setToStringSlice(ctx, owned)
+	if diags.HasError() {
+		return owned, diags
+	}
+
+	present := make([]string, 0, len(ownedMembers))
+	for _, member := range ownedMembers {
+		if slices.Contains(remote, member) {
+			present = append(present, member)
+		}
+	}
+
+	result, setDiags := types.SetValueFrom(ctx, types.StringType, present)
+	diags.Append(setDiags...)
+	return result, diags
+}
+
+func setToStringSlice(ctx context.Context, values types.Set) ([]string, diag.Diagnostics) {
+	if values.IsNull() || values.IsUnknown() {
+		return nil, nil
+	}
+
+	var result []string
+	diags := values.ElementsAs(ctx, &result, false)
+	return result, diags
+}
@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPlannedGroupMembers(t *testing.T) {
+	t.Parallel()
+
+	got := plannedGroupMembers(
+		[]string{"group-external", "group-owned-removed", "group-owned-kept"},
+		[]string{"group-owned-removed", "group-owned-kept"},
+		[]string{"group-owned-kept", "group-new"},
+	)
+
+	want := []string{"group-external", "group-owned-kept", "group-new"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func testGroupMembersModel(t *testing.T, users, groups []string) GroupMembersResourceModel {
+	t.Helper()
+
+	data := GroupMembersResourceModel{
+		ID:           types.StringValue("group-123"),
+		GroupID:      types.StringValue("group-123"),
+		MemberUsers:  types.SetNull(types.StringType),
+		MemberGroups: types.SetNull(types.StringType),
+	}
+	if users != nil {
+		values, diags := types.SetValueFrom(context.Background(), types.StringType, users)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		data.MemberUsers = values
+	}
+	if groups != nil {
+		values, diags := types.SetValueFrom(context.Background(), types.StringType, groups)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		data.MemberGroups = values
+	}
+
+	return data
+}
+
+func TestBuildGroupMembersCreateRequest(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		want   *client.UpdateGroupRequest
+		users  []string
+		groups []string
+	}{
+		"adds all owned members": {
+			users:  []string{"user-1", "user-2"},
+			groups: []string{"group-1"},
+			want: &client.UpdateGroupRequest{
+				AddMemberUsers:  []string{"user-1", "user-2"},
+				AddMemberGroups: []string{"group-1"},
+			},
+		},
+		"null sets add nothing": {
+			want: &client.UpdateGroupRequest{},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := buildGroupMembersCreateRequest(context.Background(), testGroupMembersModel(t, tc.users, tc.groups))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("got %+v, want %+v", got, tc.want)
+			}
+			if hasGroupMembershipDelta(got) != (len(tc.users)+len(tc.groups) > 0) {
+				t.Fatalf("unexpected delta result for %+v", got)
+			}
+		})
+	}
+}
+
+func TestBuildGroupMembersUpdateRequest(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		want        *client.UpdateGroupRequest
+		stateUsers  []string
+		stateGroups []string
+		planUsers   []string
+		planGroups  []string
+	}{
+		"adds and removes changed members": {
+			stateUsers:  []string{"user-1", "user-2"},
+			stateGroups: []string{"group-1"},
+			planUsers:   []string{"user-2", "user-3"},
+			planGroups:  []string{"group-2"},
+			want: &client.UpdateGroupRequest{
+				AddMemberUsers:     []string{"user-3"},
+				RemoveMemberUsers:  []string{"user-1"},
+				AddMemberGroups:    []string{"group-2"},
+				RemoveMemberGroups: []string{"group-1"},
+			},
+		},
+		"removing an attribute removes its members": {
+			stateUsers:  []string{"user-1"},
+			stateGroups: []string{"group-1"},
+			planUsers:   []string{"user-1"},
+			want: &client.UpdateGroupRequest{
+				RemoveMemberGroups: []string{"group-1"},
+			},
+		},
+		"unchanged members produce no delta": {
+			stateUsers: []string{"user-1"},
+			planUsers:  []string{"user-1"},
+			want:       &client.UpdateGroupRequest{},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := buildGroupMembersUpdateRequest(
+				context.Background(),
+				testGroupMembersModel(t, tc.planUsers, tc.planGroups),
+				testGroupMembersModel(t, tc.stateUsers, tc.stateGroups),
+			)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("got %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBuildGroupMembersDeleteRequest(t *testing.T) {
+	t.Parallel()
+
+	got, diags := buildGroupMembersDeleteRequest(
+		context.Background(),
+		testGroupMembersModel(t, []string{"user-1"}, []string{"group-1", "group-2"}),
+	)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	want := &client.UpdateGroupRequest{
+		RemoveMemberUsers:  []string{"user-1"},
+		RemoveMemberGroups: []string{"group-1", "group-2"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+	if got.AddMemberUsers != nil || got.AddMemberGroups != nil {
+		t.Fatalf("delete must not add members: %+v", got)
+	}
+}
+
+func TestGroupMembersModelFromGroup(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		group      *client.Group
+		wantUsers  []string
+		wantGroups []string
+	}{
+		"adopts all current members": {
+			group: &client.Group{
+				ID:           "group-123",
+				MemberUsers:  []string{"user-1", "user-2"},
+				MemberGroups: []string{"group-1"},
+			},
+			wantUsers:  []string{"user-1", "user-2"},
+			wantGroups: []string{"group-1"},
+		},
+		"empty member lists are null": {
+			group:     &client.Group{ID: "group-123", MemberUsers: []string{"user-1"}},
+			wantUsers: []string{"user-1"},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := groupMembersModelFromGroup(context.Background(), tc.group)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			want := testGroupMembersModel(t, tc.wantUsers, tc.wantGroups)
+			if !got.ID.Equal(want.ID) || !got.GroupID.Equal(want.GroupID) {
+				t.Fatalf("got id %s and group_id %s, want %s", got.ID, got.GroupID, want.ID)
+			}
+			if !got.MemberUsers.Equal(want.MemberUsers) {
+				t.Fatalf("got member_users %s, want %s", got.MemberUsers, want.MemberUsers)
+			}
+			if !got.MemberGroups.Equal(want.MemberGroups) {
+				t.Fatalf("got member_groups %s, want %s", got.MemberGroups, want.MemberGroups)
+			}
+		})
+	}
+}
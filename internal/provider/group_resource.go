@@ -3,8 +3,12 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
 
 	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -16,6 +20,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &GroupResource{}
 var _ resource.ResourceWithImportState = &GroupResource{}
+var _ resource.ResourceWithModifyPlan = &GroupResource{}
 
 // NewGroupResource creates a new group resource instance.
 func NewGroupResource() resource.Resource {
@@ -29,13 +34,14 @@ type GroupResource struct {
 
 // GroupResourceModel describes the resource data model.
 type GroupResourceModel struct {
-	ID           types.String `tfsdk:"id"`
-	Name         types.String `tfsdk:"name"`
-	OrgID        types.String `tfsdk:"org_id"`
-	Description  types.String `tfsdk:"description"`
-	MemberUsers  types.List   `tfsdk:"member_users"`
-	MemberGroups types.List   `tfsdk:"member_groups"`
-	Created      types.String `tfsdk:"created"`
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	OrgID            types.String `tfsdk:"org_id"`
+	Description      types.String `tfsdk:"description"`
+	MemberUsers      types.List   `tfsdk:"member_users"`
+	MemberGroups     types.List   `tfsdk:"member_groups"`
+	MemberUserEmails types.Set    `tfsdk:"member_user_emails"`
+	Created          types.String `tfsdk:"created"`
 }
 
 // Metadata implements resource.Resource.
@@ -46,7 +52,9 @@ func (r *GroupResource) Metadata(_ context.Context, req resource.MetadataRequest
 // Schema implements resource.Resource.
 func (r *GroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a Braintrust group. Groups are collections of users that can be assigned permissions via ACLs.",
+		MarkdownDescription: "Manages a Braintrust group. Groups are collections of users that can be assigned permissions via ACLs. " +
+			"Plans are rejected when `member_groups` would make a group a member of itself given the organization's current groups; " +
+			"cycles formed only by several memberships created in the same apply are not detected.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -76,12 +84,17 @@ func (r *GroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			"member_users": schema.ListAttribute{
 				ElementType:         types.StringType,
 				Optional:            true,
-				MarkdownDescription: "List of user IDs that are members of this group.",
+				MarkdownDescription: "List of user IDs that are members of this group. This list is authoritative; use `braintrustdata_group_member` or `braintrustdata_group_members` to manage a subset of members instead.",
+			},
+			"member_user_emails": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Set of user emails that are members of this group. Emails are resolved to user IDs during plan and apply, and planning fails if an email does not belong to a member of the organization. Resolved users are not repeated in `member_users`.",
 			},
 			"member_groups": schema.ListAttribute{
 				ElementType:         types.StringType,
 				Optional:            true,
-				MarkdownDescription: "List of group IDs that are members of this group.",
+				MarkdownDescription: "List of group IDs that are members of this group. This list is authoritative; use `braintrustdata_group_member` or `braintrustdata_group_members` to manage a subset of members instead. Planning fails if the list would make the group a member of itself.",
 			},
 			"created": schema.StringAttribute{
 				Computed:            true,
@@ -142,6 +155,13 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 		}
 	}
 
+	emailUserIDs, diags := r.resolveMemberUserEmails(ctx, data.MemberUserEmails)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	memberUsers = mergeGroupMemberUsers(memberUsers, emailUserIDs)
+
 	// Create group via API with members
 	group, err := r.client.CreateGroup(ctx, &client.CreateGroupRequest{
 		Name:         data.Name.ValueString(),
@@ -170,15 +190,9 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 	data.Created = types.StringValue(group.Created)
 
 	// Update member lists from API response
-	if len(group.MemberUsers) > 0 {
-		memberUsersList, diags := types.ListValueFrom(ctx, types.StringType, group.MemberUsers)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		data.MemberUsers = memberUsersList
-	} else {
-		data.MemberUsers = types.ListNull(types.StringType)
+	resp.Diagnostics.Append(populateGroupMemberUsers(ctx, &data, group.MemberUsers, emailUserIDs)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	if len(group.MemberGroups) > 0 {
@@ -228,16 +242,27 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	data.OrgID = types.StringValue(group.OrgID)
 	data.Created = types.StringValue(group.Created)
 
-	// Convert member lists to Terraform lists
-	if len(group.MemberUsers) > 0 {
-		memberUsersList, diags := types.ListValueFrom(ctx, types.StringType, group.MemberUsers)
+	// Re-resolve configured emails so users who left the org, or were removed
+	// from the group out-of-band, show up as drift.
+	var emailUserIDs map[string]string
+	if !data.MemberUserEmails.IsNull() {
+		emails, diags := setToStringSlice(ctx, data.MemberUserEmails)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		data.MemberUsers = memberUsersList
-	} else {
-		data.MemberUsers = types.ListNull(types.StringType)
+		resolved, _, err := resolveUserIDsByEmail(ctx, r.client, emails)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve member_user_emails, got error: %s", err))
+			return
+		}
+		emailUserIDs = resolved
+	}
+
+	// Convert member lists to Terraform lists
+	resp.Diagnostics.Append(populateGroupMemberUsers(ctx, &data, group.MemberUsers, emailUserIDs)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	if len(group.MemberGroups) > 0 {
@@ -283,6 +308,13 @@ func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 	}
 
+	emailUserIDs, diags := r.resolveMemberUserEmails(ctx, data.MemberUserEmails)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	memberUsers = mergeGroupMemberUsers(memberUsers, emailUserIDs)
+
 	// Update group via API
 	group, err := r.client.UpdateGroup(ctx, data.ID.ValueString(), &client.UpdateGroupRequest{
 		Name:         data.Name.ValueString(),
@@ -327,7 +359,298 @@ func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan by validating member_user_emails
+// and rejecting member_groups that would make the group a member of itself.
+func (r *GroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var emails types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("member_user_emails"), &emails)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, diags := r.resolveMemberUserEmails(ctx, emails)
+	resp.Diagnostics.Append(diags...)
+
+	var plan GroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.validateMemberGroupsAcyclic(ctx, plan)...)
+}
+
 // ImportState implements resource.ResourceWithImportState by importing a group by ID.
 func (r *GroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// validateMemberGroupsAcyclic reports an error when the planned member_groups,
+// combined with the membership of every other group in the organization,
+// would make the group a member of itself.
+func (r *GroupResource) validateMemberGroupsAcyclic(ctx context.Context, plan GroupResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// A group that does not exist yet cannot be a member of any other group.
+	if plan.ID.IsNull() || plan.ID.IsUnknown() {
+		return diags
+	}
+
+	memberGroups, memberGroupsState, listDiags := listToStringSliceWithState(ctx, plan.MemberGroups)
+	diags.Append(listDiags...)
+	if diags.HasError() || memberGroupsState != listValueStateKnown || len(memberGroups) == 0 {
+		return diags
+	}
+
+	orgID := r.client.OrgID()
+	if !plan.OrgID.IsNull() && !plan.OrgID.IsUnknown() && plan.OrgID.ValueString() != "" {
+		orgID = plan.OrgID.ValueString()
+	}
+
+	groups, err := listAllGroups(ctx, r.client, orgID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list groups to check member_groups for cycles, got error: %s", err))
+		return diags
+	}
+
+	groupID := plan.ID.ValueString()
+	cycle, names := groupMembershipCycle(groups, groupID, func([]string) []string { return memberGroups })
+	names[groupID] = plan.Name.ValueString()
+
+	if cycle != nil {
+		diags.AddAttributeError(
+			path.Root("member_groups"),
+			"Group Membership Cycle",
+			fmt.Sprintf("member_groups would make the group a member of itself: %s.", formatMembershipCycle(cycle, names)),
+		)
+	}
+
+	return diags
+}
+
+// resolveMemberUserEmails resolves member_user_emails into a map from email
+// to user ID, reporting emails that are not organization members as errors.
+func (r *GroupResource) resolveMemberUserEmails(ctx context.Context, emails types.Set) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if emails.IsNull() || emails.IsUnknown() {
+		return nil, diags
+	}
+
+	emailValues, setDiags := setToStringSlice(ctx, emails)
+	diags.Append(setDiags...)
+	if diags.HasError() || len(emailValues) == 0 {
+		return nil, diags
+	}
+
+	resolved, missing, err := resolveUserIDsByEmail(ctx, r.client, emailValues)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("member_user_emails"),
+			"Error Resolving User Emails",
+			fmt.Sprintf("Could not resolve member_user_emails: %s", err),
+		)
+		return nil, diags
+	}
+	for _, email := range missing {
+		diags.AddAttributeError(
+			path.Root("member_user_emails"),
+			"User Email Not Found",
+			fmt.Sprintf("No member of the organization has the email %q.", email),
+		)
+	}
+
+	return resolved, diags
+}
+
+// mergeGroupMemberUsers combines explicit member user IDs with the IDs
+// resolved from member_user_emails, dropping duplicates.
+func mergeGroupMemberUsers(memberUsers []string, emailUserIDs map[string]string) []string {
+	if len(emailUserIDs) == 0 {
+		return memberUsers
+	}
+
+	emails := make([]string, 0, len(emailUserIDs))
+	for email := range emailUserIDs {
+		emails = append(emails, email)
+	}
+	sort.Strings(emails)
+
+	merged := append([]string{}, memberUsers...)
+	for _, email := range emails {
+		userID := emailUserIDs[email]
+		if !slices.Contains(merged, userID) {
+			merged = append(merged, userID)
+		}
+	}
+
+	return merged
+}
+
+// populateGroupMemberUsers splits the group's remote user IDs between
+// member_users and member_user_emails. Users that were resolved from an email
+// are only kept in member_users when they are also listed there explicitly.
+func populateGroupMemberUsers(ctx context.Context, data *GroupResourceModel, remoteUsers []string, emailUserIDs map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var explicitUsers []string
+	if !data.MemberUsers.IsNull() && !data.MemberUsers.IsUnknown() {
+		diags.Append(data.MemberUsers.ElementsAs(ctx, &explicitUsers, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	emailByUserID := make(map[string]string, len(emailUserIDs))
+	for email, userID := range emailUserIDs {
+		emailByUserID[userID] = email
+	}
+
+	var memberUsers []string
+	memberEmails := []string{}
+	for _, userID := range remoteUsers {
+		email, fromEmail := emailByUserID[userID]
+		if fromEmail {
+			memberEmails = append(memberEmails, email)
+		}
+		if !fromEmail || slices.Contains(explicitUsers, userID) {
+			memberUsers = append(memberUsers, userID)
+		}
+	}
+
+	if len(memberUsers) > 0 {
+		memberUsersList, listDiags := types.ListValueFrom(ctx, types.StringType, memberUsers)
+		diags.Append(listDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		data.MemberUsers = memberUsersList
+	} else {
+		data.MemberUsers = types.ListNull(types.StringType)
+	}
+
+	if len(memberEmails) > 0 || (!data.MemberUserEmails.IsNull() && !data.MemberUserEmails.IsUnknown()) {
+		memberEmailsSet, setDiags := types.SetValueFrom(ctx, types.StringType, memberEmails)
+		diags.Append(setDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		data.MemberUserEmails = memberEmailsSet
+	} else {
+		data.MemberUserEmails = types.SetNull(types.StringType)
+	}
+
+	return diags
+}
+
+// listAllGroups pages through every group in the organization.
+func listAllGroups(ctx context.Context, c *client.Client, orgID string) ([]client.Group, error) {
+	var groups []client.Group
+
+	cursor := ""
+	for {
+		listResp, err := c.ListGroups(ctx, &client.ListGroupsOptions{
+			OrgID:  orgID,
+			Cursor: cursor,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		groups = append(groups, listResp.Groups...)
+
+		// Exit loop if no more pages
+		if listResp.Cursor == "" {
+			break
+		}
+		cursor = listResp.Cursor
+	}
+
+	return groups, nil
+}
+
+// groupMembershipCycle returns the cycle, if any, that the planned member
+// groups of groupID would create, with group names for display. planned maps
+// the group's current member groups to the planned ones.
+func groupMembershipCycle(groups []client.Group, groupID string, planned func(current []string) []string) ([]string, map[string]string) {
+	edges := make(map[string][]string, len(groups)+1)
+	names := make(map[string]string, len(groups)+1)
+	for _, group := range groups {
+		if group.DeletedAt != "" {
+			continue
+		}
+		edges[group.ID] = group.MemberGroups
+		names[group.ID] = group.Name
+	}
+
+	edges[groupID] = planned(slices.Clone(edges[groupID]))
+
+	return findMembershipCycle(groupID, edges), names
+}
+
+// checkGroupMembershipCycle lists the organization's groups and reports an
+// error on attr when the planned member groups of groupID would make the group
+// a member of itself.
+func checkGroupMembershipCycle(ctx context.Context, c *client.Client, groupID string, attr path.Path, planned func(current []string) []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	groups, err := listAllGroups(ctx, c, c.OrgID())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list groups to check group membership for cycles, got error: %s", err))
+		return diags
+	}
+
+	if cycle, names := groupMembershipCycle(groups, groupID, planned); cycle != nil {
+		diags.AddAttributeError(
+			attr,
+			"Group Membership Cycle",
+			fmt.Sprintf("The membership would make the group a member of itself: %s.", formatMembershipCycle(cycle, names)),
+		)
+	}
+
+	return diags
+}
+
+// findMembershipCycle follows edges from start and returns the first path
+// that leads back to start, beginning and ending with start, or nil if none.
+func findMembershipCycle(start string, edges map[string][]string) []string {
+	visited := map[string]bool{start: true}
+
+	var walk func(node string, path []string) []string
+	walk = func(node string, path []string) []string {
+		for _, next := range edges[node] {
+			if next == start {
+				return append(slices.Clone(path), start)
+			}
+			if visited[next] {
+				continue
+			}
+			visited[next] = true
+			if cycle := walk(next, append(path, next)); cycle != nil {
+				return cycle
+			}
+		}
+		return nil
+	}
+
+	return walk(start, []string{start})
+}
+
+// formatMembershipCycle renders a cycle as "name (id) -> name (id) -> ...",
+// falling back to the bare ID when the name is unknown.
+func formatMembershipCycle(cycle []string, names map[string]string) string {
+	parts := make([]string, 0, len(cycle))
+	for _, id := range cycle {
+		if name := names[id]; name != "" {
+			parts = append(parts, fmt.Sprintf("%s (%s)", name, id))
+			continue
+		}
+		parts = append(parts, id)
+	}
+	return strings.Join(parts, " -> ")
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -60,6 +61,42 @@ func TestAccGroupResource_WithMembers(t *testing.T) {
 	})
 }
 
+func TestAccGroupResource_UnknownMemberUserEmail(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "braintrustdata_group" "test" {
+  name               = "test-group-member-emails"
+  member_user_emails = ["nobody@example.invalid"]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`User Email Not Found`),
+			},
+		},
+	})
+}
+
+func TestAccGroupResource_MemberGroupsCycle(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupResourceConfigCycle(false),
+			},
+			{
+				Config:      testAccGroupResourceConfigCycle(true),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Group Membership Cycle`),
+			},
+		},
+	})
+}
+
 func testAccGroupResourceConfig(name, description string) string {
 	return fmt.Sprintf(`
 resource "braintrustdata_group" "test" {
@@ -91,3 +128,31 @@ func testAccPreCheck(_ *testing.T) {
 	// Check for required environment variables
 	// Currently no-op since provider handles validation
 }
+
+// testAccGroupResourceConfigCycle nests group a inside group b. With closeCycle
+// set, group b is looked up by name and nested inside group a, which avoids a
+// Terraform dependency cycle so the provider's own check has to catch it.
+func testAccGroupResourceConfigCycle(closeCycle bool) string {
+	memberGroups := "[]"
+	lookup := ""
+	if closeCycle {
+		memberGroups = "[data.braintrustdata_group.b.id]"
+		lookup = `
+data "braintrustdata_group" "b" {
+  name = "test-group-cycle-b"
+}
+`
+	}
+
+	return fmt.Sprintf(`
+resource "braintrustdata_group" "a" {
+  name          = "test-group-cycle-a"
+  member_groups = %s
+}
+
+resource "braintrustdata_group" "b" {
+  name          = "test-group-cycle-b"
+  member_groups = [braintrustdata_group.a.id]
+}
+%s`, memberGroups, lookup)
+}
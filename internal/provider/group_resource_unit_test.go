@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMergeGroupMemberUsers(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		emailUserIDs map[string]string
+		memberUsers  []string
+		want         []string
+	}{
+		"no emails keeps explicit users": {
+			memberUsers: []string{"user-1"},
+			want:        []string{"user-1"},
+		},
+		"adds resolved users in email order": {
+			memberUsers: []string{"user-1"},
+			emailUserIDs: map[string]string{
+				"bob@example.com":   "user-3",
+				"alice@example.com": "user-2",
+			},
+			want: []string{"user-1", "user-2", "user-3"},
+		},
+		"drops duplicates of explicit users": {
+			memberUsers: []string{"user-1"},
+			emailUserIDs: map[string]string{
+				"alice@example.com": "user-1",
+			},
+			want: []string{"user-1"},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := mergeGroupMemberUsers(tc.memberUsers, tc.emailUserIDs)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("mergeGroupMemberUsers() mismatch: got=%v want=%v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPopulateGroupMemberUsers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		emailUserIDs     map[string]string
+		data             GroupResourceModel
+		wantMemberUsers  types.List
+		wantMemberEmails types.Set
+		remoteUsers      []string
+	}{
+		"without emails all users stay in member_users": {
+			data: GroupResourceModel{
+				MemberUsers:      types.ListNull(types.StringType),
+				MemberUserEmails: types.SetNull(types.StringType),
+			},
+			remoteUsers: []string{"user-1", "user-2"},
+			wantMemberUsers: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("user-1"),
+				types.StringValue("user-2"),
+			}),
+			wantMemberEmails: types.SetNull(types.StringType),
+		},
+		"email resolved users move to member_user_emails": {
+			data: GroupResourceModel{
+				MemberUsers: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("user-1"),
+				}),
+				MemberUserEmails: types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue("alice@example.com"),
+				}),
+			},
+			emailUserIDs: map[string]string{"alice@example.com": "user-2"},
+			remoteUsers:  []string{"user-1", "user-2"},
+			wantMemberUsers: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("user-1"),
+			}),
+			wantMemberEmails: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("alice@example.com"),
+			}),
+		},
+		"user listed both ways stays in both attributes": {
+			data: GroupResourceModel{
+				MemberUsers: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("user-2"),
+				}),
+				MemberUserEmails: types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue("alice@example.com"),
+				}),
+			},
+			emailUserIDs: map[string]string{"alice@example.com": "user-2"},
+			remoteUsers:  []string{"user-2"},
+			wantMemberUsers: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("user-2"),
+			}),
+			wantMemberEmails: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("alice@example.com"),
+			}),
+		},
+		"email removed out-of-band is dropped": {
+			data: GroupResourceModel{
+				MemberUsers: types.ListNull(types.StringType),
+				MemberUserEmails: types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue("alice@example.com"),
+				}),
+			},
+			emailUserIDs:     map[string]string{"alice@example.com": "user-2"},
+			remoteUsers:      nil,
+			wantMemberUsers:  types.ListNull(types.StringType),
+			wantMemberEmails: types.SetValueMust(types.StringType, []attr.Value{}),
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data := tc.data
+			diags := populateGroupMemberUsers(ctx, &data, tc.remoteUsers, tc.emailUserIDs)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !data.MemberUsers.Equal(tc.wantMemberUsers) {
+				t.Fatalf("member_users mismatch: got=%v want=%v", data.MemberUsers, tc.wantMemberUsers)
+			}
+			if !data.MemberUserEmails.Equal(tc.wantMemberEmails) {
+				t.Fatalf("member_user_emails mismatch: got=%v want=%v", data.MemberUserEmails, tc.wantMemberEmails)
+			}
+		})
+	}
+}
+
+func TestFindMembershipCycle(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		edges map[string][]string
+		start string
+		want  []string
+	}{
+		"no members": {
+			start: "a",
+			edges: map[string][]string{"a": nil},
+		},
+		"acyclic chain": {
+			start: "a",
+			edges: map[string][]string{"a": {"b"}, "b": {"c"}, "c": nil},
+		},
+		"self reference": {
+			start: "a",
+			edges: map[string][]string{"a": {"a"}},
+			want:  []string{"a", "a"},
+		},
+		"two node cycle": {
+			start: "a",
+			edges: map[string][]string{"a": {"b"}, "b": {"a"}},
+			want:  []string{"a", "b", "a"},
+		},
+		"cycle through a diamond": {
+			start: "a",
+			edges: map[string][]string{"a": {"b", "c"}, "b": {"d"}, "c": {"d"}, "d": {"e"}, "e": {"a"}},
+			want:  []string{"a", "b", "d", "e", "a"},
+		},
+		"cycle elsewhere in the graph": {
+			start: "a",
+			edges: map[string][]string{"a": {"b"}, "b": {"c"}, "c": {"b"}},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := findMembershipCycle(tc.start, tc.edges)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("got %#v, want %#v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestGroupMembershipCycle(t *testing.T) {
+	t.Parallel()
+
+	groups := []client.Group{
+		{ID: "group-a", Name: "A", MemberGroups: []string{"group-b"}},
+		{ID: "group-b", Name: "B"},
+		{ID: "group-c", Name: "C", MemberGroups: []string{"group-a"}, DeletedAt: "2024-01-01T00:00:00Z"},
+	}
+	addMember := func(memberGroupID string) func([]string) []string {
+		return func(current []string) []string { return append(current, memberGroupID) }
+	}
+
+	cycle, names := groupMembershipCycle(groups, "group-b", addMember("group-a"))
+	if want := []string{"group-b", "group-a", "group-b"}; !reflect.DeepEqual(cycle, want) {
+		t.Fatalf("got cycle %v, want %v", cycle, want)
+	}
+	if names["group-a"] != "A" {
+		t.Fatalf("expected group names to be returned, got %v", names)
+	}
+
+	if cycle, _ := groupMembershipCycle(groups, "group-a", addMember("group-c")); cycle != nil {
+		t.Fatalf("expected deleted groups to be ignored, got cycle %v", cycle)
+	}
+
+	// Replacing the member groups drops the edge that closed the cycle.
+	if cycle, _ := groupMembershipCycle(groups, "group-b", func([]string) []string { return nil }); cycle != nil {
+		t.Fatalf("expected no cycle, got %v", cycle)
+	}
+}
+
+func TestFormatMembershipCycle(t *testing.T) {
+	t.Parallel()
+
+	got := formatMembershipCycle([]string{"a", "b", "a"}, map[string]string{"a": "admins"})
+	want := "admins (a) -> b -> admins (a)"
+	if got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccObjectACLPolicyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccObjectACLPolicyResourceConfig(`"read"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("braintrustdata_object_acl_policy.test", "object_type", "project"),
+					resource.TestCheckResourceAttrPair("braintrustdata_object_acl_policy.test", "object_id", "braintrustdata_project.test", "id"),
+					resource.TestCheckResourceAttr("braintrustdata_object_acl_policy.test", "bindings.#", "1"),
+					resource.TestCheckResourceAttrSet("braintrustdata_object_acl_policy.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "braintrustdata_object_acl_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update testing
+			{
+				Config: testAccObjectACLPolicyResourceConfig(`"read", "update"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("braintrustdata_object_acl_policy.test", "bindings.#", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccObjectACLPolicyResourceConfig(permissions string) string {
+	return fmt.Sprintf(`
+resource "braintrustdata_project" "test" {
+  name        = "test-object-acl-policy-project"
+  description = "Project for object ACL policy testing"
+}
+
+resource "braintrustdata_group" "test" {
+  name        = "test-object-acl-policy-group"
+  description = "Group for object ACL policy testing"
+}
+
+resource "braintrustdata_object_acl_policy" "test" {
+  object_id   = braintrustdata_project.test.id
+  object_type = "project"
+
+  bindings = [
+    for permission in [%s] : {
+      group_id   = braintrustdata_group.test.id
+      permission = permission
+    }
+  ]
+}
+`, permissions)
+}
@@ -0,0 +1,265 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDiffObjectACLBindings(t *testing.T) {
+	t.Parallel()
+
+	groupRead := objectACLBinding{GroupID: "group-1", Permission: "read"}
+	userRole := objectACLBinding{UserID: "user-1", RoleID: "role-1"}
+	restricted := objectACLBinding{GroupID: "group-1", Permission: "read", RestrictObjectType: "dataset"}
+
+	testCases := map[string]struct {
+		desired    []objectACLBinding
+		current    []client.ACL
+		wantCreate []objectACLBinding
+		wantDelete []string
+	}{
+		"create into empty object": {
+			desired:    []objectACLBinding{groupRead, userRole},
+			wantCreate: []objectACLBinding{groupRead, userRole},
+		},
+		"already in sync": {
+			desired: []objectACLBinding{groupRead},
+			current: []client.ACL{
+				{ID: "acl-1", GroupID: "group-1", Permission: client.PermissionRead},
+			},
+		},
+		"removes undeclared ACLs": {
+			desired: []objectACLBinding{groupRead},
+			current: []client.ACL{
+				{ID: "acl-1", GroupID: "group-1", Permission: client.PermissionRead},
+				{ID: "acl-2", UserID: "user-1", RoleID: "role-1"},
+			},
+			wantDelete: []string{"acl-2"},
+		},
+		"restrict_object_type distinguishes bindings": {
+			desired: []objectACLBinding{restricted},
+			current: []client.ACL{
+				{ID: "acl-1", GroupID: "group-1", Permission: client.PermissionRead},
+			},
+			wantCreate: []objectACLBinding{restricted},
+			wantDelete: []string{"acl-1"},
+		},
+		"removes duplicate remote ACLs": {
+			desired: []objectACLBinding{groupRead},
+			current: []client.ACL{
+				{ID: "acl-1", GroupID: "group-1", Permission: client.PermissionRead},
+				{ID: "acl-2", GroupID: "group-1", Permission: client.PermissionRead},
+			},
+			wantDelete: []string{"acl-2"},
+		},
+		"empty policy removes everything": {
+			current: []client.ACL{
+				{ID: "acl-1", GroupID: "group-1", Permission: client.PermissionRead},
+			},
+			wantDelete: []string{"acl-1"},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotCreate, gotDelete := diffObjectACLBindings(tc.desired, tc.current)
+			if !reflect.DeepEqual(gotCreate, tc.wantCreate) {
+				t.Fatalf("toCreate mismatch: got %#v, want %#v", gotCreate, tc.wantCreate)
+			}
+			if !reflect.DeepEqual(gotDelete, tc.wantDelete) {
+				t.Fatalf("toDelete mismatch: got %#v, want %#v", gotDelete, tc.wantDelete)
+			}
+		})
+	}
+}
+
+func TestValidateObjectACLBinding(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		binding objectACLBinding
+		wantErr bool
+	}{
+		"user permission": {
+			binding: objectACLBinding{UserID: "user-1", Permission: "read"},
+		},
+		"group role": {
+			binding: objectACLBinding{GroupID: "group-1", RoleID: "role-1"},
+		},
+		"missing principal": {
+			binding: objectACLBinding{Permission: "read"},
+			wantErr: true,
+		},
+		"both principals": {
+			binding: objectACLBinding{UserID: "user-1", GroupID: "group-1", Permission: "read"},
+			wantErr: true,
+		},
+		"both permission and role": {
+			binding: objectACLBinding{UserID: "user-1", Permission: "read", RoleID: "role-1"},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateObjectACLBinding(tc.binding)
+			if tc.wantErr && err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+			if !tc.wantErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestObjectACLBindingsSetRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	bindings := []objectACLBinding{
+		{GroupID: "group-1", Permission: "read"},
+		{UserID: "user-1", RoleID: "role-1", RestrictObjectType: "dataset"},
+		{GroupID: "group-1", Permission: "read"},
+	}
+
+	set, diags := objectACLBindingsToSet(ctx, bindings)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(set.Elements()) != 2 {
+		t.Fatalf("expected duplicate bindings to collapse to 2 elements, got %d", len(set.Elements()))
+	}
+
+	got, diags := objectACLBindingsFromSet(ctx, set)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !reflect.DeepEqual(got, bindings[:2]) {
+		t.Fatalf("round trip mismatch: got %#v, want %#v", got, bindings[:2])
+	}
+}
+
+func TestValidateObjectACLPolicyBindings(t *testing.T) {
+	t.Parallel()
+
+	elementType := types.ObjectType{AttrTypes: objectACLPolicyBindingAttributeTypes}
+	binding := func(userID, groupID, permission, roleID types.String) attr.Value {
+		return types.ObjectValueMust(objectACLPolicyBindingAttributeTypes, map[string]attr.Value{
+			"user_id":              userID,
+			"group_id":             groupID,
+			"permission":           permission,
+			"role_id":              roleID,
+			"restrict_object_type": types.StringNull(),
+		})
+	}
+
+	testCases := map[string]struct {
+		bindings types.Set
+		wantErr  bool
+	}{
+		"valid": {
+			bindings: types.SetValueMust(elementType, []attr.Value{
+				binding(types.StringValue("user-1"), types.StringNull(), types.StringValue("read"), types.StringNull()),
+			}),
+		},
+		"both principals": {
+			bindings: types.SetValueMust(elementType, []attr.Value{
+				binding(types.StringValue("user-1"), types.StringValue("group-1"), types.StringValue("read"), types.StringNull()),
+			}),
+			wantErr: true,
+		},
+		"no grant": {
+			bindings: types.SetValueMust(elementType, []attr.Value{
+				binding(types.StringNull(), types.StringValue("group-1"), types.StringNull(), types.StringNull()),
+			}),
+			wantErr: true,
+		},
+		"unknown principal": {
+			bindings: types.SetValueMust(elementType, []attr.Value{
+				binding(types.StringUnknown(), types.StringNull(), types.StringValue("read"), types.StringNull()),
+			}),
+		},
+		"unknown set": {
+			bindings: types.SetUnknown(elementType),
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := validateObjectACLPolicyBindings(context.Background(), tc.bindings)
+			if diags.HasError() != tc.wantErr {
+				t.Fatalf("expected error %t, got diagnostics: %v", tc.wantErr, diags)
+			}
+		})
+	}
+}
+
+func TestParseObjectACLPolicyImportID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		raw            string
+		wantObjectType string
+		wantObjectID   string
+		wantErr        bool
+	}{
+		"valid": {
+			raw:            "project,project-1",
+			wantObjectType: "project",
+			wantObjectID:   "project-1",
+		},
+		"whitespace": {
+			raw:            " dataset , dataset-1 ",
+			wantObjectType: "dataset",
+			wantObjectID:   "dataset-1",
+		},
+		"missing object ID": {
+			raw:     "project,",
+			wantErr: true,
+		},
+		"too many parts": {
+			raw:     "project,project-1,extra",
+			wantErr: true,
+		},
+		"unknown object type": {
+			raw:     "projects,project-1",
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			objectType, objectID, err := parseObjectACLPolicyImportID(tc.raw)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if objectType != tc.wantObjectType || objectID != tc.wantObjectID {
+				t.Fatalf("got (%q, %q), want (%q, %q)", objectType, objectID, tc.wantObjectType, tc.wantObjectID)
+			}
+		})
+	}
+}
@@ -0,0 +1,369 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &PlaygroundResource{}
+var _ resource.ResourceWithImportState = &PlaygroundResource{}
+
+// NewPlaygroundResource creates a new playground resource instance.
+func NewPlaygroundResource() resource.Resource {
+	return &PlaygroundResource{}
+}
+
+// PlaygroundResource defines the resource implementation.
+type PlaygroundResource struct {
+	client *client.Client
+}
+
+// PlaygroundResourceModel describes the resource data model.
+type PlaygroundResourceModel struct {
+	FunctionIDs types.Set    `tfsdk:"function_ids"`
+	ID          types.String `tfsdk:"id"`
+	ProjectID   types.String `tfsdk:"project_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	DatasetID   types.String `tfsdk:"dataset_id"`
+	UserID      types.String `tfsdk:"user_id"`
+	Created     types.String `tfsdk:"created"`
+}
+
+// Metadata implements resource.Resource.
+func (r *PlaygroundResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_playground"
+}
+
+// Schema implements resource.Resource.
+func (r *PlaygroundResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Braintrust playground. Playgrounds are stored as prompt sessions and bind a set of prompts or functions to an optional dataset. Use `prompt_session` as the ACL object type when granting access to a playground.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the playground (prompt session ID).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The project ID that owns the playground.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The playground name.",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The playground description.",
+			},
+			"function_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "IDs of the prompts or functions loaded into the playground. Prompts are functions in Braintrust, so prompt IDs can be used directly.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"dataset_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The ID of the dataset the playground runs against.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the user who created the playground.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The timestamp when the playground was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure implements resource.Resource.
+func (r *PlaygroundResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// Create implements resource.Resource.
+func (r *PlaygroundResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PlaygroundResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq, diags := buildCreatePlaygroundRequest(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	promptSession, err := r.client.CreatePromptSession(ctx, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to create playground, got error: %s", err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setPlaygroundResourceModel(ctx, &data, promptSession)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read implements resource.Resource.
+func (r *PlaygroundResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PlaygroundResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	promptSession, err := r.client.GetPromptSession(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read playground, got error: %s", err),
+		)
+		return
+	}
+
+	if promptSession.DeletedAt != "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(setPlaygroundResourceModel(ctx, &data, promptSession)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource.
+func (r *PlaygroundResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan PlaygroundResourceModel
+	var state PlaygroundResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq, diags := buildUpdatePlaygroundRequest(ctx, plan, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if hasPlaygroundUpdateChanges(updateReq) {
+		if _, err := r.client.UpdatePromptSession(ctx, state.ID.ValueString(), updateReq); err != nil {
+			if client.IsNotFound(err) {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to update playground, got error: %s", err),
+			)
+			return
+		}
+	}
+
+	promptSession, err := r.client.GetPromptSession(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read playground after update, got error: %s", err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setPlaygroundResourceModel(ctx, &plan, promptSession)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete implements resource.Resource.
+func (r *PlaygroundResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PlaygroundResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeletePromptSession(ctx, data.ID.ValueString()); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to delete playground, got error: %s", err),
+		)
+	}
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (r *PlaygroundResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// buildPromptSessionData returns nil when the playground binds neither
+// functions nor a dataset.
+func buildPromptSessionData(ctx context.Context, model PlaygroundResourceModel) (*client.PromptSessionData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data := &client.PromptSessionData{}
+	if !model.FunctionIDs.IsNull() && !model.FunctionIDs.IsUnknown() {
+		diags.Append(model.FunctionIDs.ElementsAs(ctx, &data.FunctionIDs, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+	if !model.DatasetID.IsNull() && !model.DatasetID.IsUnknown() {
+		datasetID := model.DatasetID.ValueString()
+		data.DatasetID = &datasetID
+	}
+
+	if len(data.FunctionIDs) == 0 && data.DatasetID == nil {
+		return nil, diags
+	}
+
+	return data, diags
+}
+
+func buildCreatePlaygroundRequest(ctx context.Context, model PlaygroundResourceModel) (*client.CreatePromptSessionRequest, diag.Diagnostics) {
+	promptSessionData, diags := buildPromptSessionData(ctx, model)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	req := &client.CreatePromptSessionRequest{
+		ProjectID:         model.ProjectID.ValueString(),
+		Name:              model.Name.ValueString(),
+		PromptSessionData: promptSessionData,
+	}
+	if !model.Description.IsNull() && !model.Description.IsUnknown() {
+		req.Description = model.Description.ValueString()
+	}
+
+	return req, diags
+}
+
+func buildUpdatePlaygroundRequest(ctx context.Context, plan, state PlaygroundResourceModel) (*client.UpdatePromptSessionRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	req := &client.UpdatePromptSessionRequest{}
+
+	if !plan.Name.IsUnknown() && !plan.Name.Equal(state.Name) {
+		v := plan.Name.ValueString()
+		req.Name = &v
+	}
+
+	req.Description = changedStringPointer(plan.Description, state.Description)
+
+	// Bindings are replaced as a whole, so removed functions or a removed
+	// dataset are cleared remotely.
+	if !plan.FunctionIDs.Equal(state.FunctionIDs) || !plan.DatasetID.Equal(state.DatasetID) {
+		promptSessionData, dataDiags := buildPromptSessionData(ctx, plan)
+		diags.Append(dataDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		if promptSessionData == nil {
+			promptSessionData = &client.PromptSessionData{FunctionIDs: []string{}}
+		}
+		req.PromptSessionData = promptSessionData
+	}
+
+	return req, diags
+}
+
+func hasPlaygroundUpdateChanges(req *client.UpdatePromptSessionRequest) bool {
+	return req.Name != nil || req.Description != nil || req.PromptSessionData != nil
+}
+
+func setPlaygroundResourceModel(ctx context.Context, model *PlaygroundResourceModel, promptSession *client.PromptSession) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.ID = stringOrNull(promptSession.ID)
+	model.ProjectID = stringOrNull(promptSession.ProjectID)
+	model.Name = stringOrNull(promptSession.Name)
+	model.Description = stringOrNull(promptSession.Description)
+	model.UserID = stringOrNull(promptSession.UserID)
+	model.Created = stringOrNull(promptSession.Created)
+	model.FunctionIDs = types.SetNull(types.StringType)
+	model.DatasetID = types.StringNull()
+
+	if promptSession.PromptSessionData == nil {
+		return diags
+	}
+
+	if len(promptSession.PromptSessionData.FunctionIDs) > 0 {
+		functionIDs, setDiags := types.SetValueFrom(ctx, types.StringType, promptSession.PromptSessionData.FunctionIDs)
+		diags.Append(setDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		model.FunctionIDs = functionIDs
+	}
+	if promptSession.PromptSessionData.DatasetID != nil {
+		model.DatasetID = stringOrNull(*promptSession.PromptSessionData.DatasetID)
+	}
+
+	return diags
+}
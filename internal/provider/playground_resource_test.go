@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPlaygroundResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlaygroundResourceConfig("test-playground", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("braintrustdata_playground.test", "name", "test-playground"),
+					resource.TestCheckResourceAttr("braintrustdata_playground.test", "function_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("braintrustdata_playground.test", "function_ids.*", "braintrustdata_prompt.test", "id"),
+					resource.TestCheckResourceAttrPair("braintrustdata_playground.test", "dataset_id", "braintrustdata_dataset.test", "id"),
+					resource.TestCheckResourceAttrSet("braintrustdata_playground.test", "id"),
+					resource.TestCheckResourceAttrSet("braintrustdata_playground.test", "created"),
+				),
+			},
+			{
+				Config: testAccPlaygroundResourceConfig("test-playground-updated", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("braintrustdata_playground.test", "name", "test-playground-updated"),
+					resource.TestCheckNoResourceAttr("braintrustdata_playground.test", "dataset_id"),
+				),
+			},
+			{
+				ResourceName:      "braintrustdata_playground.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPlaygroundResourceConfig(name string, withDataset bool) string {
+	datasetID := ""
+	if withDataset {
+		datasetID = "dataset_id   = braintrustdata_dataset.test.id"
+	}
+
+	return fmt.Sprintf(`
+resource "braintrustdata_project" "test" {
+  name = "test-project-for-playground-resource"
+}
+
+resource "braintrustdata_prompt" "test" {
+  project_id = braintrustdata_project.test.id
+  name       = "test-prompt-for-playground"
+}
+
+resource "braintrustdata_dataset" "test" {
+  project_id = braintrustdata_project.test.id
+  name       = "test-dataset-for-playground"
+}
+
+resource "braintrustdata_playground" "test" {
+  project_id   = braintrustdata_project.test.id
+  name         = %[1]q
+  description  = "Standard evaluation playground"
+  function_ids = [braintrustdata_prompt.test.id]
+  %[2]s
+}
+`, name, datasetID)
+}
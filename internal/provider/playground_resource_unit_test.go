@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testPlaygroundFunctionIDs(ids ...string) types.Set {
+	values := make([]attr.Value, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, types.StringValue(id))
+	}
+	return types.SetValueMust(types.StringType, values)
+}
+
+func testPlaygroundModel() PlaygroundResourceModel {
+	return PlaygroundResourceModel{
+		ProjectID:   types.StringValue("project-123"),
+		Name:        types.StringValue("baseline-playground"),
+		Description: types.StringNull(),
+		FunctionIDs: testPlaygroundFunctionIDs("prompt-1"),
+		DatasetID:   types.StringValue("dataset-123"),
+	}
+}
+
+func TestBuildCreatePlaygroundRequest(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	req, diags := buildCreatePlaygroundRequest(ctx, testPlaygroundModel())
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	datasetID := "dataset-123"
+	want := &client.CreatePromptSessionRequest{
+		ProjectID: "project-123",
+		Name:      "baseline-playground",
+		PromptSessionData: &client.PromptSessionData{
+			DatasetID:   &datasetID,
+			FunctionIDs: []string{"prompt-1"},
+		},
+	}
+	if !reflect.DeepEqual(req, want) {
+		t.Fatalf("request mismatch: got=%#v want=%#v", req, want)
+	}
+
+	empty := testPlaygroundModel()
+	empty.FunctionIDs = types.SetNull(types.StringType)
+	empty.DatasetID = types.StringNull()
+
+	req, diags = buildCreatePlaygroundRequest(ctx, empty)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if req.PromptSessionData != nil {
+		t.Fatalf("expected prompt_session_data to be omitted, got %#v", req.PromptSessionData)
+	}
+}
+
+func TestBuildUpdatePlaygroundRequest(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	state := testPlaygroundModel()
+	state.Description = types.StringValue("Old description")
+
+	testCases := []struct {
+		mutate          func(*PlaygroundResourceModel)
+		wantData        *client.PromptSessionData
+		name            string
+		wantName        bool
+		wantDescription bool
+	}{
+		{
+			name:   "no_changes",
+			mutate: func(*PlaygroundResourceModel) {},
+		},
+		{
+			name: "name_only",
+			mutate: func(m *PlaygroundResourceModel) {
+				m.Name = types.StringValue("baseline-playground-renamed")
+			},
+			wantName: true,
+		},
+		{
+			name: "null_description_clears",
+			mutate: func(m *PlaygroundResourceModel) {
+				m.Description = types.StringNull()
+			},
+			wantDescription: true,
+		},
+		{
+			name: "dataset_removed_sends_full_data",
+			mutate: func(m *PlaygroundResourceModel) {
+				m.DatasetID = types.StringNull()
+			},
+			wantData: &client.PromptSessionData{FunctionIDs: []string{"prompt-1"}},
+		},
+		{
+			name: "all_bindings_removed",
+			mutate: func(m *PlaygroundResourceModel) {
+				m.FunctionIDs = types.SetNull(types.StringType)
+				m.DatasetID = types.StringNull()
+			},
+			wantData: &client.PromptSessionData{FunctionIDs: []string{}},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			plan := state
+			tc.mutate(&plan)
+
+			req, diags := buildUpdatePlaygroundRequest(ctx, plan, state)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if (req.Name != nil) != tc.wantName {
+				t.Fatalf("name presence mismatch: got=%v want=%v", req.Name != nil, tc.wantName)
+			}
+			if (req.Description != nil) != tc.wantDescription {
+				t.Fatalf("description presence mismatch: got=%v want=%v", req.Description != nil, tc.wantDescription)
+			}
+			if !reflect.DeepEqual(req.PromptSessionData, tc.wantData) {
+				t.Fatalf("prompt_session_data mismatch: got=%#v want=%#v", req.PromptSessionData, tc.wantData)
+			}
+			if hasPlaygroundUpdateChanges(req) != (tc.wantName || tc.wantDescription || tc.wantData != nil) {
+				t.Fatalf("hasPlaygroundUpdateChanges() mismatch for %+v", req)
+			}
+		})
+	}
+}
+
+func TestSetPlaygroundResourceModel(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	datasetID := "dataset-123"
+
+	var model PlaygroundResourceModel
+	diags := setPlaygroundResourceModel(ctx, &model, &client.PromptSession{
+		ID:        "session-123",
+		ProjectID: "project-123",
+		Name:      "baseline-playground",
+		UserID:    "user-123",
+		Created:   "2026-01-01T00:00:00Z",
+		PromptSessionData: &client.PromptSessionData{
+			DatasetID:   &datasetID,
+			FunctionIDs: []string{"prompt-2", "prompt-1"},
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if model.ID.ValueString() != "session-123" {
+		t.Fatalf("expected id session-123, got %s", model.ID)
+	}
+	if !model.Description.IsNull() {
+		t.Fatalf("expected description to be null, got %s", model.Description)
+	}
+	if model.DatasetID.ValueString() != "dataset-123" {
+		t.Fatalf("expected dataset_id dataset-123, got %s", model.DatasetID)
+	}
+	if !model.FunctionIDs.Equal(testPlaygroundFunctionIDs("prompt-1", "prompt-2")) {
+		t.Fatalf("function_ids mismatch: got=%s", model.FunctionIDs)
+	}
+
+	diags = setPlaygroundResourceModel(ctx, &model, &client.PromptSession{ID: "session-123"})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !model.FunctionIDs.IsNull() || !model.DatasetID.IsNull() {
+		t.Fatalf("expected bindings to be null, got function_ids=%s dataset_id=%s", model.FunctionIDs, model.DatasetID)
+	}
+}
@@ -0,0 +1,318 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ProjectAutomationDataSource{}
+
+var (
+	errProjectAutomationNotFoundByName       = errors.New("project automation not found by name")
+	errMultipleProjectAutomationsFoundByName = errors.New("multiple project automations found by name")
+)
+
+// NewProjectAutomationDataSource creates a new project automation data source instance.
+func NewProjectAutomationDataSource() datasource.DataSource {
+	return &ProjectAutomationDataSource{}
+}
+
+// ProjectAutomationDataSource defines the data source implementation.
+type ProjectAutomationDataSource struct {
+	client *client.Client
+}
+
+// ProjectAutomationDataSourceModel describes the data source data model.
+type ProjectAutomationDataSourceModel struct {
+	Action          types.Object `tfsdk:"action"`
+	Export          types.Object `tfsdk:"export"`
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	ProjectID       types.String `tfsdk:"project_id"`
+	ProjectName     types.String `tfsdk:"project_name"`
+	OrgName         types.String `tfsdk:"org_name"`
+	Description     types.String `tfsdk:"description"`
+	EventType       types.String `tfsdk:"event_type"`
+	BTQLFilter      types.String `tfsdk:"btql_filter"`
+	UserID          types.String `tfsdk:"user_id"`
+	Created         types.String `tfsdk:"created"`
+	IntervalSeconds types.Int64  `tfsdk:"interval_seconds"`
+}
+
+// Metadata implements datasource.DataSource.
+func (d *ProjectAutomationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_automation"
+}
+
+// Schema implements datasource.DataSource.
+func (d *ProjectAutomationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads a Braintrust project automation by `id` or by API-native searchable attributes (`name`, optionally `project_id`, `project_name`, `org_name`).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the automation. Specify either `id` or `name`.",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The automation name. Can be used as a searchable attribute when `id` is not provided.",
+			},
+			"project_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Optional project ID filter applied during searchable lookups.",
+			},
+			"project_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional project name filter applied during searchable lookups.",
+			},
+			"org_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional organization name filter applied during searchable lookups.",
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The automation description.",
+			},
+			"event_type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The type of event that triggers the automation.",
+			},
+			"btql_filter": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "BTQL filter that selects the events which trigger the automation.",
+			},
+			"interval_seconds": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "How often, in seconds, the filter is evaluated.",
+			},
+			"action": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The action fired when the filter matches.",
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The action type.",
+					},
+					"url": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The URL the webhook is sent to.",
+					},
+				},
+			},
+			"export": projectAutomationExportDataSourceAttribute(),
+			"user_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the user who created the automation.",
+			},
+			"created": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The timestamp when the automation was created.",
+			},
+		},
+	}
+}
+
+// Configure implements datasource.DataSource.
+func (d *ProjectAutomationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ProjectAutomationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProjectAutomationDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasID := !data.ID.IsNull() && data.ID.ValueString() != ""
+	hasName := !data.Name.IsNull() && data.Name.ValueString() != ""
+	hasProjectID := !data.ProjectID.IsNull() && data.ProjectID.ValueString() != ""
+	hasProjectName := !data.ProjectName.IsNull() && data.ProjectName.ValueString() != ""
+	hasOrgName := !data.OrgName.IsNull() && data.OrgName.ValueString() != ""
+
+	if !hasID && !hasName {
+		resp.Diagnostics.AddError(
+			"Missing Required Attribute",
+			"Must specify either 'id' or 'name' to look up the project automation.",
+		)
+		return
+	}
+
+	if hasID && (hasName || hasProjectID || hasProjectName || hasOrgName) {
+		resp.Diagnostics.AddError(
+			"Conflicting Attributes",
+			"Cannot combine 'id' with searchable attributes ('name', 'project_id', 'project_name', 'org_name').",
+		)
+		return
+	}
+
+	var automation *client.ProjectAutomation
+	if hasID {
+		fetchedAutomation, err := d.client.GetProjectAutomation(ctx, data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Project Automation",
+				fmt.Sprintf("Could not read project automation ID %s: %s", data.ID.ValueString(), err.Error()),
+			)
+			return
+		}
+		automation = fetchedAutomation
+	} else {
+		listOpts := &client.ListProjectAutomationsOptions{
+			AutomationName: data.Name.ValueString(),
+			Limit:          2,
+		}
+		if hasProjectID {
+			listOpts.ProjectID = data.ProjectID.ValueString()
+		}
+		if hasProjectName {
+			listOpts.ProjectName = data.ProjectName.ValueString()
+		}
+		if hasOrgName {
+			listOpts.OrgName = data.OrgName.ValueString()
+		}
+
+		listResp, err := d.client.ListProjectAutomations(ctx, listOpts)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Listing Project Automations",
+				fmt.Sprintf("Could not list project automations using the provided searchable attributes: %s", err.Error()),
+			)
+			return
+		}
+
+		selectedAutomation, err := selectSingleProjectAutomationByName(listResp.ProjectAutomations, data.Name.ValueString())
+		if errors.Is(err, errProjectAutomationNotFoundByName) {
+			resp.Diagnostics.AddError(
+				"Project Automation Not Found",
+				fmt.Sprintf("No project automation found with name: %s", data.Name.ValueString()),
+			)
+			return
+		}
+		if errors.Is(err, errMultipleProjectAutomationsFoundByName) {
+			resp.Diagnostics.AddError(
+				"Multiple Project Automations Found",
+				"Searchable attributes matched multiple project automations. Refine the query or use 'id' for deterministic lookup.",
+			)
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Listing Project Automations",
+				fmt.Sprintf("Could not resolve project automation using the provided searchable attributes: %s", err.Error()),
+			)
+			return
+		}
+
+		automation = selectedAutomation
+	}
+
+	resp.Diagnostics.Append(populateProjectAutomationDataSourceModel(ctx, &data, automation)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func selectSingleProjectAutomationByName(automations []client.ProjectAutomation, name string) (*client.ProjectAutomation, error) {
+	var selected *client.ProjectAutomation
+
+	for i := range automations {
+		automation := &automations[i]
+		if automation.Name != name {
+			continue
+		}
+		if selected != nil {
+			return nil, fmt.Errorf("%w: %s", errMultipleProjectAutomationsFoundByName, name)
+		}
+		selected = automation
+	}
+
+	if selected == nil {
+		return nil, fmt.Errorf("%w: %s", errProjectAutomationNotFoundByName, name)
+	}
+
+	return selected, nil
+}
+
+// projectAutomationExportDataSourceAttribute describes the export settings of
+// a btql_export automation, shared by the single and list data sources.
+func projectAutomationExportDataSourceAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: "What a `btql_export` automation exports and where it writes it. Null for other event types.",
+		Attributes: map[string]schema.Attribute{
+			"scope": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "What is exported: log_traces, log_spans or btql_query.",
+			},
+			"btql_query": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The BTQL query whose results are exported when `scope` is `btql_query`.",
+			},
+			"path": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The destination the export is written to.",
+			},
+			"format": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The file format of the export.",
+			},
+			"role_arn": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ARN of the AWS IAM role Braintrust assumes to write to `path`.",
+			},
+			"external_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The external ID the IAM role's trust policy expects.",
+			},
+			"batch_size": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of rows written per batch.",
+			},
+		},
+	}
+}
+
+func populateProjectAutomationDataSourceModel(ctx context.Context, data *ProjectAutomationDataSourceModel, automation *client.ProjectAutomation) diag.Diagnostics {
+	var resourceModel ProjectAutomationResourceModel
+	diags := setProjectAutomationResourceModel(ctx, &resourceModel, automation)
+
+	data.ID = resourceModel.ID
+	data.Name = resourceModel.Name
+	data.ProjectID = resourceModel.ProjectID
+	data.Description = resourceModel.Description
+	data.EventType = resourceModel.EventType
+	data.BTQLFilter = resourceModel.BTQLFilter
+	data.IntervalSeconds = resourceModel.IntervalSeconds
+	data.Action = resourceModel.Action
+	data.Export = resourceModel.Export
+	data.UserID = resourceModel.UserID
+	data.Created = resourceModel.Created
+
+	return diags
+}
@@ -0,0 +1,593 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ resource.Resource = &ProjectAutomationResource{}
+var _ resource.ResourceWithImportState = &ProjectAutomationResource{}
+var _ resource.ResourceWithValidateConfig = &ProjectAutomationResource{}
+
+// NewProjectAutomationResource creates a new project automation resource instance.
+func NewProjectAutomationResource() resource.Resource {
+	return &ProjectAutomationResource{}
+}
+
+// ProjectAutomationResource defines the resource implementation.
+type ProjectAutomationResource struct {
+	client *client.Client
+}
+
+// ProjectAutomationResourceModel describes the resource data model.
+type ProjectAutomationResourceModel struct {
+	Action          types.Object `tfsdk:"action"`
+	Export          types.Object `tfsdk:"export"`
+	ID              types.String `tfsdk:"id"`
+	ProjectID       types.String `tfsdk:"project_id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	EventType       types.String `tfsdk:"event_type"`
+	BTQLFilter      types.String `tfsdk:"btql_filter"`
+	UserID          types.String `tfsdk:"user_id"`
+	Created         types.String `tfsdk:"created"`
+	IntervalSeconds types.Int64  `tfsdk:"interval_seconds"`
+}
+
+var projectAutomationActionAttributeTypes = map[string]attr.Type{
+	"type": types.StringType,
+	"url":  types.StringType,
+}
+
+type projectAutomationActionModel struct {
+	Type types.String `tfsdk:"type"`
+	URL  types.String `tfsdk:"url"`
+}
+
+var projectAutomationExportAttributeTypes = map[string]attr.Type{
+	"scope":       types.StringType,
+	"btql_query":  types.StringType,
+	"path":        types.StringType,
+	"format":      types.StringType,
+	"role_arn":    types.StringType,
+	"external_id": types.StringType,
+	"batch_size":  types.Int64Type,
+}
+
+type projectAutomationExportModel struct {
+	Scope      types.String `tfsdk:"scope"`
+	BTQLQuery  types.String `tfsdk:"btql_query"`
+	Path       types.String `tfsdk:"path"`
+	Format     types.String `tfsdk:"format"`
+	RoleARN    types.String `tfsdk:"role_arn"`
+	ExternalID types.String `tfsdk:"external_id"`
+	BatchSize  types.Int64  `tfsdk:"batch_size"`
+}
+
+// Metadata implements resource.Resource.
+func (r *ProjectAutomationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_automation"
+}
+
+// Schema implements resource.Resource.
+func (r *ProjectAutomationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Braintrust project automation. A `logs` automation periodically evaluates a BTQL filter against project events and fires a webhook when rows match. A `btql_export` automation periodically exports project logs to cloud storage.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the automation.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The project ID that owns the automation.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The automation name.",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The automation description.",
+			},
+			"event_type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The type of automation. Valid values: logs, btql_export.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("logs", "btql_export"),
+				},
+			},
+			"btql_filter": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "BTQL filter that selects the events which trigger the automation. Required when `event_type` is `logs`.",
+			},
+			"interval_seconds": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "How often, in seconds, the automation runs: the filter is evaluated or the export is written.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"action": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "The action to fire when the filter matches. Required when `event_type` is `logs`.",
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The action type. Valid values: webhook.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("webhook"),
+						},
+					},
+					"url": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The URL the webhook is sent to.",
+					},
+				},
+			},
+			"export": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "What to export and where to write it. Required when `event_type` is `btql_export`.",
+				Attributes: map[string]schema.Attribute{
+					"scope": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "What to export. Valid values: log_traces, log_spans, btql_query.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("log_traces", "log_spans", "btql_query"),
+						},
+					},
+					"btql_query": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "The BTQL query whose results are exported. Required when `scope` is `btql_query`.",
+					},
+					"path": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The destination the export is written to, for example `s3://bucket/prefix/`.",
+					},
+					"format": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The file format of the export. Valid values: jsonl, parquet.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("jsonl", "parquet"),
+						},
+					},
+					"role_arn": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The ARN of the AWS IAM role Braintrust assumes to write to `path`.",
+					},
+					"external_id": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The external ID the IAM role's trust policy expects.",
+					},
+					"batch_size": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "The number of rows written per batch. Defaults to the server's batch size.",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the user who created the automation.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The timestamp when the automation was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure implements resource.Resource.
+func (r *ProjectAutomationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// Create implements resource.Resource.
+func (r *ProjectAutomationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectAutomationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq, diags := buildCreateProjectAutomationRequest(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	automation, err := r.client.CreateProjectAutomation(ctx, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to create project automation, got error: %s", err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setProjectAutomationResourceModel(ctx, &data, automation)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read implements resource.Resource.
+func (r *ProjectAutomationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectAutomationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	automation, err := r.client.GetProjectAutomation(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read project automation, got error: %s", err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setProjectAutomationResourceModel(ctx, &data, automation)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource.
+func (r *ProjectAutomationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ProjectAutomationResourceModel
+	var state ProjectAutomationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq, diags := buildUpdateProjectAutomationRequest(ctx, plan, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if hasProjectAutomationUpdateChanges(updateReq) {
+		if _, err := r.client.UpdateProjectAutomation(ctx, state.ID.ValueString(), updateReq); err != nil {
+			if client.IsNotFound(err) {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to update project automation, got error: %s", err),
+			)
+			return
+		}
+	}
+
+	automation, err := r.client.GetProjectAutomation(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read project automation after update, got error: %s", err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setProjectAutomationResourceModel(ctx, &plan, automation)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete implements resource.Resource.
+func (r *ProjectAutomationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectAutomationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteProjectAutomation(ctx, data.ID.ValueString()); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to delete project automation, got error: %s", err),
+		)
+	}
+}
+
+// ValidateConfig implements resource.ResourceWithValidateConfig.
+func (r *ProjectAutomationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ProjectAutomationResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateProjectAutomationConfig(ctx, data)...)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (r *ProjectAutomationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// validateProjectAutomationConfig checks that the attributes of the
+// configured event_type are set and those of the other type are not.
+func validateProjectAutomationConfig(ctx context.Context, model ProjectAutomationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if model.EventType.IsNull() || model.EventType.IsUnknown() {
+		return diags
+	}
+
+	eventType := model.EventType.ValueString()
+	required := []string{"btql_filter", "action"}
+	forbidden := []string{"export"}
+	if eventType == "btql_export" {
+		required, forbidden = forbidden, required
+	}
+
+	isNull := map[string]bool{
+		"btql_filter": model.BTQLFilter.IsNull(),
+		"action":      model.Action.IsNull(),
+		"export":      model.Export.IsNull(),
+	}
+	for _, name := range required {
+		if isNull[name] {
+			diags.AddAttributeError(
+				path.Root(name),
+				"Missing Attribute Configuration",
+				fmt.Sprintf("%s is required when event_type is %q.", name, eventType),
+			)
+		}
+	}
+	for _, name := range forbidden {
+		if !isNull[name] {
+			diags.AddAttributeError(
+				path.Root(name),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("%s cannot be set when event_type is %q.", name, eventType),
+			)
+		}
+	}
+
+	if model.Export.IsNull() || model.Export.IsUnknown() {
+		return diags
+	}
+
+	var export projectAutomationExportModel
+	diags.Append(model.Export.As(ctx, &export, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return diags
+	}
+	if export.Scope.ValueString() == "btql_query" && export.BTQLQuery.IsNull() {
+		diags.AddAttributeError(
+			path.Root("export").AtName("btql_query"),
+			"Missing Attribute Configuration",
+			"export.btql_query is required when export.scope is \"btql_query\".",
+		)
+	}
+
+	return diags
+}
+
+func buildProjectAutomationConfig(ctx context.Context, model ProjectAutomationResourceModel) (*client.ProjectAutomationConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	config := &client.ProjectAutomationConfig{
+		EventType:       model.EventType.ValueString(),
+		BTQLFilter:      model.BTQLFilter.ValueString(),
+		IntervalSeconds: model.IntervalSeconds.ValueInt64(),
+	}
+
+	if !model.Action.IsNull() && !model.Action.IsUnknown() {
+		var action projectAutomationActionModel
+		diags.Append(model.Action.As(ctx, &action, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		config.Action = &client.ProjectAutomationAction{
+			Type: action.Type.ValueString(),
+			URL:  action.URL.ValueString(),
+		}
+	}
+
+	if !model.Export.IsNull() && !model.Export.IsUnknown() {
+		var export projectAutomationExportModel
+		diags.Append(model.Export.As(ctx, &export, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		config.ExportDefinition = &client.ProjectAutomationExportDefinition{
+			Type:      export.Scope.ValueString(),
+			BTQLQuery: export.BTQLQuery.ValueString(),
+		}
+		config.ExportPath = export.Path.ValueString()
+		config.Format = export.Format.ValueString()
+		config.Credentials = &client.ProjectAutomationCredentials{
+			Type:       "aws_iam",
+			RoleARN:    export.RoleARN.ValueString(),
+			ExternalID: export.ExternalID.ValueString(),
+		}
+		config.BatchSize = export.BatchSize.ValueInt64()
+	}
+
+	return config, diags
+}
+
+func buildCreateProjectAutomationRequest(ctx context.Context, model ProjectAutomationResourceModel) (*client.CreateProjectAutomationRequest, diag.Diagnostics) {
+	config, diags := buildProjectAutomationConfig(ctx, model)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	req := &client.CreateProjectAutomationRequest{
+		ProjectID: model.ProjectID.ValueString(),
+		Name:      model.Name.ValueString(),
+		Config:    config,
+	}
+	if !model.Description.IsNull() && !model.Description.IsUnknown() {
+		req.Description = model.Description.ValueString()
+	}
+
+	return req, diags
+}
+
+func buildUpdateProjectAutomationRequest(ctx context.Context, plan, state ProjectAutomationResourceModel) (*client.UpdateProjectAutomationRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	req := &client.UpdateProjectAutomationRequest{}
+
+	if !plan.Name.IsUnknown() && !plan.Name.Equal(state.Name) {
+		v := plan.Name.ValueString()
+		req.Name = &v
+	}
+
+	req.Description = changedStringPointer(plan.Description, state.Description)
+
+	// The API replaces config as a whole, so any trigger or action change sends all of it.
+	if !plan.EventType.Equal(state.EventType) ||
+		!plan.BTQLFilter.Equal(state.BTQLFilter) ||
+		!plan.IntervalSeconds.Equal(state.IntervalSeconds) ||
+		!plan.Action.Equal(state.Action) ||
+		!plan.Export.Equal(state.Export) {
+		config, configDiags := buildProjectAutomationConfig(ctx, plan)
+		diags.Append(configDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		req.Config = config
+	}
+
+	return req, diags
+}
+
+func hasProjectAutomationUpdateChanges(req *client.UpdateProjectAutomationRequest) bool {
+	return req.Name != nil || req.Description != nil || req.Config != nil
+}
+
+func projectAutomationActionToObject(action *client.ProjectAutomationAction) (types.Object, diag.Diagnostics) {
+	if action == nil {
+		return types.ObjectNull(projectAutomationActionAttributeTypes), nil
+	}
+
+	return types.ObjectValue(projectAutomationActionAttributeTypes, map[string]attr.Value{
+		"type": stringOrNull(action.Type),
+		"url":  stringOrNull(action.URL),
+	})
+}
+
+func projectAutomationExportToObject(config *client.ProjectAutomationConfig) (types.Object, diag.Diagnostics) {
+	if config.ExportDefinition == nil {
+		return types.ObjectNull(projectAutomationExportAttributeTypes), nil
+	}
+
+	values := map[string]attr.Value{
+		"scope":       stringOrNull(config.ExportDefinition.Type),
+		"btql_query":  stringOrNull(config.ExportDefinition.BTQLQuery),
+		"path":        stringOrNull(config.ExportPath),
+		"format":      stringOrNull(config.Format),
+		"role_arn":    types.StringNull(),
+		"external_id": types.StringNull(),
+		"batch_size":  types.Int64Null(),
+	}
+	if config.Credentials != nil {
+		values["role_arn"] = stringOrNull(config.Credentials.RoleARN)
+		values["external_id"] = stringOrNull(config.Credentials.ExternalID)
+	}
+	if config.BatchSize > 0 {
+		values["batch_size"] = types.Int64Value(config.BatchSize)
+	}
+
+	return types.ObjectValue(projectAutomationExportAttributeTypes, values)
+}
+
+func setProjectAutomationResourceModel(_ context.Context, model *ProjectAutomationResourceModel, automation *client.ProjectAutomation) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.ID = stringOrNull(automation.ID)
+	model.ProjectID = stringOrNull(automation.ProjectID)
+	model.Name = stringOrNull(automation.Name)
+	model.Description = stringOrNull(automation.Description)
+	model.UserID = stringOrNull(automation.UserID)
+	model.Created = stringOrNull(automation.Created)
+
+	if automation.Config == nil {
+		model.EventType = types.StringNull()
+		model.BTQLFilter = types.StringNull()
+		model.IntervalSeconds = types.Int64Null()
+		model.Action = types.ObjectNull(projectAutomationActionAttributeTypes)
+		model.Export = types.ObjectNull(projectAutomationExportAttributeTypes)
+		return diags
+	}
+
+	model.EventType = stringOrNull(automation.Config.EventType)
+	model.BTQLFilter = stringOrNull(automation.Config.BTQLFilter)
+	model.IntervalSeconds = types.Int64Value(automation.Config.IntervalSeconds)
+
+	action, actionDiags := projectAutomationActionToObject(automation.Config.Action)
+	diags.Append(actionDiags...)
+	model.Action = action
+
+	export, exportDiags := projectAutomationExportToObject(automation.Config)
+	diags.Append(exportDiags...)
+	model.Export = export
+
+	return diags
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectAutomationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectAutomationResourceConfig("test-automation", 3600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("braintrustdata_project_automation.test", "name", "test-automation"),
+					resource.TestCheckResourceAttr("braintrustdata_project_automation.test", "event_type", "logs"),
+					resource.TestCheckResourceAttr("braintrustdata_project_automation.test", "interval_seconds", "3600"),
+					resource.TestCheckResourceAttr("braintrustdata_project_automation.test", "action.type", "webhook"),
+					resource.TestCheckResourceAttr("braintrustdata_project_automation.test", "action.url", "https://example.com/braintrust-hook"),
+					resource.TestCheckResourceAttrSet("braintrustdata_project_automation.test", "id"),
+					resource.TestCheckResourceAttrSet("braintrustdata_project_automation.test", "created"),
+				),
+			},
+			{
+				Config: testAccProjectAutomationResourceConfig("test-automation-updated", 600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("braintrustdata_project_automation.test", "name", "test-automation-updated"),
+					resource.TestCheckResourceAttr("braintrustdata_project_automation.test", "interval_seconds", "600"),
+				),
+			},
+			{
+				ResourceName:      "braintrustdata_project_automation.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccProjectAutomationDataSources(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectAutomationResourceConfig("test-automation-lookup", 3600) + `
+data "braintrustdata_project_automation" "by_name" {
+  name       = braintrustdata_project_automation.test.name
+  project_id = braintrustdata_project.test.id
+}
+
+data "braintrustdata_project_automations" "all" {
+  project_id = braintrustdata_project.test.id
+  depends_on = [braintrustdata_project_automation.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.braintrustdata_project_automation.by_name", "id", "braintrustdata_project_automation.test", "id"),
+					resource.TestCheckResourceAttr("data.braintrustdata_project_automation.by_name", "btql_filter", "error IS NOT NULL"),
+					resource.TestCheckResourceAttr("data.braintrustdata_project_automation.by_name", "action.url", "https://example.com/braintrust-hook"),
+					resource.TestCheckResourceAttr("data.braintrustdata_project_automations.all", "project_automations.#", "1"),
+					resource.TestCheckResourceAttrPair("data.braintrustdata_project_automations.all", "ids.0", "braintrustdata_project_automation.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectAutomationResourceConfig(name string, intervalSeconds int) string {
+	return fmt.Sprintf(`
+resource "braintrustdata_project" "test" {
+  name = "test-project-for-automation-resource"
+}
+
+resource "braintrustdata_project_automation" "test" {
+  project_id       = braintrustdata_project.test.id
+  name             = %[1]q
+  description      = "Alert on errored spans"
+  event_type       = "logs"
+  btql_filter      = "error IS NOT NULL"
+  interval_seconds = %[2]d
+
+  action = {
+    type = "webhook"
+    url  = "https://example.com/braintrust-hook"
+  }
+}
+`, name, intervalSeconds)
+}
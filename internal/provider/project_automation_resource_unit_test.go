@@ -0,0 +1,341 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testProjectAutomationAction(url string) types.Object {
+	return types.ObjectValueMust(projectAutomationActionAttributeTypes, map[string]attr.Value{
+		"type": types.StringValue("webhook"),
+		"url":  types.StringValue(url),
+	})
+}
+
+func testProjectAutomationModel() ProjectAutomationResourceModel {
+	return ProjectAutomationResourceModel{
+		ProjectID:       types.StringValue("project-123"),
+		Name:            types.StringValue("error-alert"),
+		Description:     types.StringNull(),
+		EventType:       types.StringValue("logs"),
+		BTQLFilter:      types.StringValue("error IS NOT NULL"),
+		IntervalSeconds: types.Int64Value(3600),
+		Action:          testProjectAutomationAction("https://example.com/hook"),
+		Export:          types.ObjectNull(projectAutomationExportAttributeTypes),
+	}
+}
+
+func testProjectAutomationExport(scope string) types.Object {
+	return types.ObjectValueMust(projectAutomationExportAttributeTypes, map[string]attr.Value{
+		"scope":       types.StringValue(scope),
+		"btql_query":  types.StringNull(),
+		"path":        types.StringValue("s3://bucket/braintrust/"),
+		"format":      types.StringValue("parquet"),
+		"role_arn":    types.StringValue("arn:aws:iam::123456789012:role/braintrust-export"),
+		"external_id": types.StringValue("bt-external-id"),
+		"batch_size":  types.Int64Null(),
+	})
+}
+
+func testProjectAutomationExportModel() ProjectAutomationResourceModel {
+	model := testProjectAutomationModel()
+	model.EventType = types.StringValue("btql_export")
+	model.BTQLFilter = types.StringNull()
+	model.Action = types.ObjectNull(projectAutomationActionAttributeTypes)
+	model.Export = testProjectAutomationExport("log_traces")
+	return model
+}
+
+func TestBuildCreateProjectAutomationRequest(t *testing.T) {
+	t.Parallel()
+
+	req, diags := buildCreateProjectAutomationRequest(context.Background(), testProjectAutomationModel())
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	want := &client.CreateProjectAutomationRequest{
+		ProjectID: "project-123",
+		Name:      "error-alert",
+		Config: &client.ProjectAutomationConfig{
+			EventType:       "logs",
+			BTQLFilter:      "error IS NOT NULL",
+			IntervalSeconds: 3600,
+			Action:          &client.ProjectAutomationAction{Type: "webhook", URL: "https://example.com/hook"},
+		},
+	}
+	if !reflect.DeepEqual(req, want) {
+		t.Fatalf("request mismatch: got=%#v want=%#v", req, want)
+	}
+}
+
+func TestBuildCreateProjectAutomationRequest_Export(t *testing.T) {
+	t.Parallel()
+
+	req, diags := buildCreateProjectAutomationRequest(context.Background(), testProjectAutomationExportModel())
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	want := &client.ProjectAutomationConfig{
+		EventType:        "btql_export",
+		IntervalSeconds:  3600,
+		ExportDefinition: &client.ProjectAutomationExportDefinition{Type: "log_traces"},
+		ExportPath:       "s3://bucket/braintrust/",
+		Format:           "parquet",
+		Credentials: &client.ProjectAutomationCredentials{
+			Type:       "aws_iam",
+			RoleARN:    "arn:aws:iam::123456789012:role/braintrust-export",
+			ExternalID: "bt-external-id",
+		},
+	}
+	if !reflect.DeepEqual(req.Config, want) {
+		t.Fatalf("config mismatch: got=%#v want=%#v", req.Config, want)
+	}
+}
+
+func TestValidateProjectAutomationConfig(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		model   func() ProjectAutomationResourceModel
+		wantErr bool
+	}{
+		"logs": {
+			model: testProjectAutomationModel,
+		},
+		"logs without action": {
+			model: func() ProjectAutomationResourceModel {
+				m := testProjectAutomationModel()
+				m.Action = types.ObjectNull(projectAutomationActionAttributeTypes)
+				return m
+			},
+			wantErr: true,
+		},
+		"logs with export": {
+			model: func() ProjectAutomationResourceModel {
+				m := testProjectAutomationModel()
+				m.Export = testProjectAutomationExport("log_traces")
+				return m
+			},
+			wantErr: true,
+		},
+		"export": {
+			model: testProjectAutomationExportModel,
+		},
+		"export without export block": {
+			model: func() ProjectAutomationResourceModel {
+				m := testProjectAutomationExportModel()
+				m.Export = types.ObjectNull(projectAutomationExportAttributeTypes)
+				return m
+			},
+			wantErr: true,
+		},
+		"export with btql_filter": {
+			model: func() ProjectAutomationResourceModel {
+				m := testProjectAutomationExportModel()
+				m.BTQLFilter = types.StringValue("error IS NOT NULL")
+				return m
+			},
+			wantErr: true,
+		},
+		"btql_query scope without query": {
+			model: func() ProjectAutomationResourceModel {
+				m := testProjectAutomationExportModel()
+				m.Export = testProjectAutomationExport("btql_query")
+				return m
+			},
+			wantErr: true,
+		},
+		"unknown event_type": {
+			model: func() ProjectAutomationResourceModel {
+				m := testProjectAutomationModel()
+				m.EventType = types.StringUnknown()
+				m.Action = types.ObjectNull(projectAutomationActionAttributeTypes)
+				return m
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := validateProjectAutomationConfig(context.Background(), tc.model())
+			if diags.HasError() != tc.wantErr {
+				t.Fatalf("HasError() = %t, want %t: %v", diags.HasError(), tc.wantErr, diags)
+			}
+		})
+	}
+}
+
+func TestBuildUpdateProjectAutomationRequest(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	state := testProjectAutomationModel()
+	state.Description = types.StringValue("Old description")
+
+	testCases := []struct {
+		mutate          func(*ProjectAutomationResourceModel)
+		name            string
+		wantName        bool
+		wantDescription bool
+		wantConfig      bool
+	}{
+		{
+			name:   "no_changes",
+			mutate: func(*ProjectAutomationResourceModel) {},
+		},
+		{
+			name: "name_only",
+			mutate: func(m *ProjectAutomationResourceModel) {
+				m.Name = types.StringValue("error-alert-renamed")
+			},
+			wantName: true,
+		},
+		{
+			name: "null_description_clears",
+			mutate: func(m *ProjectAutomationResourceModel) {
+				m.Description = types.StringNull()
+			},
+			wantDescription: true,
+		},
+		{
+			name: "interval_change_sends_config",
+			mutate: func(m *ProjectAutomationResourceModel) {
+				m.IntervalSeconds = types.Int64Value(600)
+			},
+			wantConfig: true,
+		},
+		{
+			name: "action_change_sends_config",
+			mutate: func(m *ProjectAutomationResourceModel) {
+				m.Action = testProjectAutomationAction("https://example.com/other")
+			},
+			wantConfig: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			plan := state
+			tc.mutate(&plan)
+
+			req, diags := buildUpdateProjectAutomationRequest(ctx, plan, state)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if (req.Name != nil) != tc.wantName {
+				t.Fatalf("name presence mismatch: got=%v want=%v", req.Name != nil, tc.wantName)
+			}
+			if (req.Description != nil) != tc.wantDescription {
+				t.Fatalf("description presence mismatch: got=%v want=%v", req.Description != nil, tc.wantDescription)
+			}
+			if (req.Config != nil) != tc.wantConfig {
+				t.Fatalf("config presence mismatch: got=%v want=%v", req.Config != nil, tc.wantConfig)
+			}
+			if tc.wantDescription && *req.Description != "" {
+				t.Fatalf("expected description to be cleared, got %q", *req.Description)
+			}
+			if tc.wantConfig && req.Config.Action == nil {
+				t.Fatal("expected full config including action")
+			}
+			if hasProjectAutomationUpdateChanges(req) != (tc.wantName || tc.wantDescription || tc.wantConfig) {
+				t.Fatalf("hasProjectAutomationUpdateChanges() mismatch for %+v", req)
+			}
+		})
+	}
+}
+
+func TestSetProjectAutomationResourceModel(t *testing.T) {
+	t.Parallel()
+
+	var model ProjectAutomationResourceModel
+	diags := setProjectAutomationResourceModel(context.Background(), &model, &client.ProjectAutomation{
+		ID:        "auto-123",
+		ProjectID: "project-123",
+		Name:      "error-alert",
+		UserID:    "user-123",
+		Created:   "2026-01-01T00:00:00Z",
+		Config: &client.ProjectAutomationConfig{
+			EventType:       "logs",
+			BTQLFilter:      "error IS NOT NULL",
+			IntervalSeconds: 3600,
+			Action:          &client.ProjectAutomationAction{Type: "webhook", URL: "https://example.com/hook"},
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if model.ID.ValueString() != "auto-123" {
+		t.Fatalf("expected id auto-123, got %s", model.ID)
+	}
+	if !model.Description.IsNull() {
+		t.Fatalf("expected description to be null, got %s", model.Description)
+	}
+	if model.IntervalSeconds.ValueInt64() != 3600 {
+		t.Fatalf("expected interval_seconds 3600, got %s", model.IntervalSeconds)
+	}
+	if !model.Action.Equal(testProjectAutomationAction("https://example.com/hook")) {
+		t.Fatalf("action mismatch: got=%s", model.Action)
+	}
+	if !model.Export.IsNull() {
+		t.Fatalf("expected export to be null, got %s", model.Export)
+	}
+}
+
+func TestSetProjectAutomationResourceModel_Export(t *testing.T) {
+	t.Parallel()
+
+	var model ProjectAutomationResourceModel
+	diags := setProjectAutomationResourceModel(context.Background(), &model, &client.ProjectAutomation{
+		ID: "auto-123",
+		Config: &client.ProjectAutomationConfig{
+			EventType:        "btql_export",
+			IntervalSeconds:  3600,
+			ExportDefinition: &client.ProjectAutomationExportDefinition{Type: "log_traces"},
+			ExportPath:       "s3://bucket/braintrust/",
+			Format:           "parquet",
+			Credentials: &client.ProjectAutomationCredentials{
+				Type:       "aws_iam",
+				RoleARN:    "arn:aws:iam::123456789012:role/braintrust-export",
+				ExternalID: "bt-external-id",
+			},
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if !model.Export.Equal(testProjectAutomationExport("log_traces")) {
+		t.Fatalf("export mismatch: got=%s", model.Export)
+	}
+	if !model.Action.IsNull() || !model.BTQLFilter.IsNull() {
+		t.Fatalf("expected log attributes to be null, got %+v", model)
+	}
+}
+
+func TestSetProjectAutomationResourceModel_NilConfig(t *testing.T) {
+	t.Parallel()
+
+	var model ProjectAutomationResourceModel
+	diags := setProjectAutomationResourceModel(context.Background(), &model, &client.ProjectAutomation{ID: "auto-123"})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if !model.EventType.IsNull() || !model.BTQLFilter.IsNull() || !model.IntervalSeconds.IsNull() || !model.Action.IsNull() || !model.Export.IsNull() {
+		t.Fatalf("expected config attributes to be null, got %+v", model)
+	}
+}
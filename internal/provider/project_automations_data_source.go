@@ -0,0 +1,293 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ProjectAutomationsDataSource{}
+
+// NewProjectAutomationsDataSource creates a new project automations data source instance.
+func NewProjectAutomationsDataSource() datasource.DataSource {
+	return &ProjectAutomationsDataSource{}
+}
+
+// ProjectAutomationsDataSource defines the data source implementation.
+type ProjectAutomationsDataSource struct {
+	client *client.Client
+}
+
+// ProjectAutomationsDataSourceModel describes the data source data model.
+type ProjectAutomationsDataSourceModel struct {
+	FilterIDs          types.List                                      `tfsdk:"filter_ids"`
+	OrgName            types.String                                    `tfsdk:"org_name"`
+	ProjectID          types.String                                    `tfsdk:"project_id"`
+	ProjectName        types.String                                    `tfsdk:"project_name"`
+	AutomationName     types.String                                    `tfsdk:"automation_name"`
+	StartingAfter      types.String                                    `tfsdk:"starting_after"`
+	EndingBefore       types.String                                    `tfsdk:"ending_before"`
+	ProjectAutomations []ProjectAutomationsDataSourceProjectAutomation `tfsdk:"project_automations"`
+	IDs                []string                                        `tfsdk:"ids"`
+	Limit              types.Int64                                     `tfsdk:"limit"`
+}
+
+// ProjectAutomationsDataSourceProjectAutomation represents a single project automation in the list.
+type ProjectAutomationsDataSourceProjectAutomation struct {
+	Action          types.Object `tfsdk:"action"`
+	Export          types.Object `tfsdk:"export"`
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	ProjectID       types.String `tfsdk:"project_id"`
+	Description     types.String `tfsdk:"description"`
+	EventType       types.String `tfsdk:"event_type"`
+	BTQLFilter      types.String `tfsdk:"btql_filter"`
+	UserID          types.String `tfsdk:"user_id"`
+	Created         types.String `tfsdk:"created"`
+	IntervalSeconds types.Int64  `tfsdk:"interval_seconds"`
+}
+
+// Metadata implements datasource.DataSource.
+func (d *ProjectAutomationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_automations"
+}
+
+// Schema implements datasource.DataSource.
+func (d *ProjectAutomationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists Braintrust project automations using API-native filters.",
+		Attributes: map[string]schema.Attribute{
+			"filter_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Optional list of automation IDs to filter by. Maps to repeated `ids` query parameters.",
+			},
+			"org_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional organization name filter.",
+			},
+			"project_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional project ID filter.",
+			},
+			"project_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional project name filter.",
+			},
+			"automation_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional exact automation name filter.",
+			},
+			"limit": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Optional max number of automations to return.",
+			},
+			"starting_after": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional pagination cursor to fetch automations after this ID.",
+			},
+			"ending_before": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional pagination cursor to fetch automations before this ID.",
+			},
+			"ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "List of returned automation IDs.",
+			},
+			"project_automations": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of project automations.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the automation.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the automation.",
+						},
+						"project_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The project ID that the automation belongs to.",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The automation description.",
+						},
+						"event_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of event that triggers the automation.",
+						},
+						"btql_filter": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "BTQL filter that selects the events which trigger the automation.",
+						},
+						"interval_seconds": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "How often, in seconds, the filter is evaluated.",
+						},
+						"action": schema.SingleNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "The action fired when the filter matches.",
+							Attributes: map[string]schema.Attribute{
+								"type": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "The action type.",
+								},
+								"url": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "The URL the webhook is sent to.",
+								},
+							},
+						},
+						"export": projectAutomationExportDataSourceAttribute(),
+						"user_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the user who created the automation.",
+						},
+						"created": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The timestamp when the automation was created.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure implements datasource.DataSource.
+func (d *ProjectAutomationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ProjectAutomationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProjectAutomationsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listOpts, filterDiags := buildListProjectAutomationsOptions(ctx, data)
+	resp.Diagnostics.Append(filterDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listResp, err := d.client.ListProjectAutomations(ctx, listOpts)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Project Automations",
+			fmt.Sprintf("Could not list project automations: %s", err.Error()),
+		)
+		return
+	}
+
+	data.ProjectAutomations = make([]ProjectAutomationsDataSourceProjectAutomation, 0, len(listResp.ProjectAutomations))
+	data.IDs = make([]string, 0, len(listResp.ProjectAutomations))
+
+	for i := range listResp.ProjectAutomations {
+		automation := &listResp.ProjectAutomations[i]
+
+		var resourceModel ProjectAutomationResourceModel
+		resp.Diagnostics.Append(setProjectAutomationResourceModel(ctx, &resourceModel, automation)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.ProjectAutomations = append(data.ProjectAutomations, ProjectAutomationsDataSourceProjectAutomation{
+			ID:              resourceModel.ID,
+			Name:            resourceModel.Name,
+			ProjectID:       resourceModel.ProjectID,
+			Description:     resourceModel.Description,
+			EventType:       resourceModel.EventType,
+			BTQLFilter:      resourceModel.BTQLFilter,
+			IntervalSeconds: resourceModel.IntervalSeconds,
+			Action:          resourceModel.Action,
+			Export:          resourceModel.Export,
+			UserID:          resourceModel.UserID,
+			Created:         resourceModel.Created,
+		})
+		data.IDs = append(data.IDs, automation.ID)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func buildListProjectAutomationsOptions(ctx context.Context, data ProjectAutomationsDataSourceModel) (*client.ListProjectAutomationsOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	hasStartingAfter := !data.StartingAfter.IsNull() && data.StartingAfter.ValueString() != ""
+	hasEndingBefore := !data.EndingBefore.IsNull() && data.EndingBefore.ValueString() != ""
+
+	if hasStartingAfter && hasEndingBefore {
+		diags.AddError("Conflicting Attributes", "Cannot specify both 'starting_after' and 'ending_before'.")
+		return nil, diags
+	}
+
+	listOpts := &client.ListProjectAutomationsOptions{}
+
+	if !data.FilterIDs.IsNull() {
+		var ids []string
+		diags.Append(data.FilterIDs.ElementsAs(ctx, &ids, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		listOpts.IDs = ids
+	}
+	if !data.OrgName.IsNull() && data.OrgName.ValueString() != "" {
+		listOpts.OrgName = data.OrgName.ValueString()
+	}
+	if !data.ProjectID.IsNull() && data.ProjectID.ValueString() != "" {
+		listOpts.ProjectID = data.ProjectID.ValueString()
+	}
+	if !data.ProjectName.IsNull() && data.ProjectName.ValueString() != "" {
+		listOpts.ProjectName = data.ProjectName.ValueString()
+	}
+	if !data.AutomationName.IsNull() && data.AutomationName.ValueString() != "" {
+		listOpts.AutomationName = data.AutomationName.ValueString()
+	}
+	if !data.Limit.IsNull() {
+		limit := data.Limit.ValueInt64()
+		if limit < 1 {
+			diags.AddError("Invalid Limit", "'limit' must be greater than or equal to 1.")
+			return nil, diags
+		}
+
+		maxInt := int64(^uint(0) >> 1)
+		if limit > maxInt {
+			diags.AddError("Invalid Limit", "'limit' exceeds supported platform integer size.")
+			return nil, diags
+		}
+
+		listOpts.Limit = int(limit)
+	}
+	if hasStartingAfter {
+		listOpts.StartingAfter = data.StartingAfter.ValueString()
+	}
+	if hasEndingBefore {
+		listOpts.EndingBefore = data.EndingBefore.ValueString()
+	}
+
+	return listOpts, diags
+}
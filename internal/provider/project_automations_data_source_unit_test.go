@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSelectSingleProjectAutomationByName(t *testing.T) {
+	t.Parallel()
+
+	automations := []client.ProjectAutomation{
+		{ID: "auto-1", Name: "error-alert"},
+		{ID: "auto-2", Name: "latency-alert"},
+		{ID: "auto-3", Name: "latency-alert"},
+	}
+
+	selected, err := selectSingleProjectAutomationByName(automations, "error-alert")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if selected.ID != "auto-1" {
+		t.Fatalf("expected auto-1, got %s", selected.ID)
+	}
+
+	if _, err := selectSingleProjectAutomationByName(automations, "missing"); !errors.Is(err, errProjectAutomationNotFoundByName) {
+		t.Fatalf("expected errProjectAutomationNotFoundByName, got %v", err)
+	}
+	if _, err := selectSingleProjectAutomationByName(automations, "latency-alert"); !errors.Is(err, errMultipleProjectAutomationsFoundByName) {
+		t.Fatalf("expected errMultipleProjectAutomationsFoundByName, got %v", err)
+	}
+}
+
+func TestBuildListProjectAutomationsOptions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := []struct {
+		want    *client.ListProjectAutomationsOptions
+		name    string
+		data    ProjectAutomationsDataSourceModel
+		wantErr bool
+	}{
+		{
+			name: "all_filters",
+			data: ProjectAutomationsDataSourceModel{
+				FilterIDs: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("auto-1"),
+				}),
+				OrgName:        types.StringValue("org"),
+				ProjectID:      types.StringValue("project-123"),
+				ProjectName:    types.StringValue("project"),
+				AutomationName: types.StringValue("error-alert"),
+				StartingAfter:  types.StringValue("cursor"),
+				EndingBefore:   types.StringNull(),
+				Limit:          types.Int64Value(10),
+			},
+			want: &client.ListProjectAutomationsOptions{
+				IDs:            []string{"auto-1"},
+				OrgName:        "org",
+				ProjectID:      "project-123",
+				ProjectName:    "project",
+				AutomationName: "error-alert",
+				StartingAfter:  "cursor",
+				Limit:          10,
+			},
+		},
+		{
+			name: "conflicting_cursors",
+			data: ProjectAutomationsDataSourceModel{
+				FilterIDs:     types.ListNull(types.StringType),
+				StartingAfter: types.StringValue("a"),
+				EndingBefore:  types.StringValue("b"),
+				Limit:         types.Int64Null(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid_limit",
+			data: ProjectAutomationsDataSourceModel{
+				FilterIDs: types.ListNull(types.StringType),
+				Limit:     types.Int64Value(0),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts, diags := buildListProjectAutomationsOptions(ctx, tc.data)
+			if diags.HasError() != tc.wantErr {
+				t.Fatalf("diagnostics mismatch: hasErr=%v diags=%v", diags.HasError(), diags)
+			}
+			if tc.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(opts, tc.want) {
+				t.Fatalf("options mismatch: got=%+v want=%+v", *opts, *tc.want)
+			}
+		})
+	}
+}
+
+func TestPopulateProjectAutomationDataSourceModel_Export(t *testing.T) {
+	t.Parallel()
+
+	var data ProjectAutomationDataSourceModel
+	diags := populateProjectAutomationDataSourceModel(context.Background(), &data, &client.ProjectAutomation{
+		ID:   "auto-123",
+		Name: "nightly-export",
+		Config: &client.ProjectAutomationConfig{
+			EventType:        "btql_export",
+			IntervalSeconds:  3600,
+			ExportDefinition: &client.ProjectAutomationExportDefinition{Type: "log_traces"},
+			ExportPath:       "s3://bucket/braintrust/",
+			Format:           "parquet",
+			Credentials: &client.ProjectAutomationCredentials{
+				Type:       "aws_iam",
+				RoleARN:    "arn:aws:iam::123456789012:role/braintrust-export",
+				ExternalID: "bt-external-id",
+			},
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if !data.Export.Equal(testProjectAutomationExport("log_traces")) {
+		t.Fatalf("unexpected export: %s", data.Export)
+	}
+	if !data.Action.IsNull() {
+		t.Fatalf("expected action to be null, got %s", data.Action)
+	}
+}
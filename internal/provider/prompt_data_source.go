@@ -38,7 +38,9 @@ type PromptDataSourceModel struct {
 	Name         types.String `tfsdk:"name"`
 	ProjectID    types.String `tfsdk:"project_id"`
 	Slug         types.String `tfsdk:"slug"`
+	Version      types.String `tfsdk:"version"`
 	Description  types.String `tfsdk:"description"`
+	PromptData   types.String `tfsdk:"prompt_data"`
 	FunctionType types.String `tfsdk:"function_type"`
 	Created      types.String `tfsdk:"created"`
 	UserID       types.String `tfsdk:"user_id"`
@@ -53,7 +55,7 @@ func (d *PromptDataSource) Metadata(_ context.Context, req datasource.MetadataRe
 // Schema implements datasource.DataSource.
 func (d *PromptDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Reads a Braintrust prompt by `id` or by searchable attributes (`name` and `project_id`).",
+		MarkdownDescription: "Reads a Braintrust prompt by `id` or by searchable attributes (`name` and `project_id`), optionally pinned to a specific `version`.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Optional:            true,
@@ -70,6 +72,11 @@ func (d *PromptDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Computed:            true,
 				MarkdownDescription: "The project ID that scopes prompt lookup by name.",
 			},
+			"version": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The prompt version (transaction ID) to read. The prompt is located by `id` or `name` as it is now and then read as of this version. Defaults to the latest version.",
+			},
 			"slug": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The prompt slug.",
@@ -78,6 +85,10 @@ func (d *PromptDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Computed:            true,
 				MarkdownDescription: "A description of the prompt.",
 			},
+			"prompt_data": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The prompt data as a JSON-encoded string.",
+			},
 			"function_type": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The function type associated with the prompt.",
@@ -218,10 +229,35 @@ func (d *PromptDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	version := strings.TrimSpace(data.Version.ValueString())
+	if !data.Version.IsNull() && !data.Version.IsUnknown() && version != "" {
+		versionedPrompt, err := d.client.GetPromptVersion(ctx, prompt.ID, version)
+		if errors.Is(err, client.ErrVersionNotFound) {
+			resp.Diagnostics.AddError(
+				"Prompt Version Not Found",
+				fmt.Sprintf("Prompt %s has no version %s", prompt.ID, version),
+			)
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Prompt",
+				fmt.Sprintf("Could not read prompt ID %s at version %s: %s", prompt.ID, version, err.Error()),
+			)
+			return
+		}
+		prompt = versionedPrompt
+	}
+
 	resp.Diagnostics.Append(populatePromptDataSourceModel(ctx, &data, prompt)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if version != "" {
+		// Keep the configured version; a version between two edits resolves
+		// to the older edit's transaction ID.
+		data.Version = types.StringValue(version)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -266,12 +302,20 @@ func populatePromptDataSourceModel(ctx context.Context, data *PromptDataSourceMo
 	data.Name = stringOrNull(prompt.Name)
 	data.ProjectID = stringOrNull(prompt.ProjectID)
 	data.Slug = stringOrNull(prompt.Slug)
+	data.Version = stringOrNull(prompt.XactID)
 	data.Description = stringOrNull(prompt.Description)
 	data.FunctionType = stringOrNull(prompt.FunctionType)
 	data.Created = stringOrNull(prompt.Created)
 	data.UserID = stringOrNull(prompt.UserID)
 	data.OrgID = stringOrNull(prompt.OrgID)
 
+	promptData, promptDataDiags := jsonEncodedOrNull("prompt_data", prompt.PromptData)
+	diags.Append(promptDataDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	data.PromptData = promptData
+
 	if len(prompt.Metadata) > 0 {
 		metadata := make(map[string]string)
 		for k, v := range prompt.Metadata {
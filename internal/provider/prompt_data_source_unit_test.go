@@ -92,6 +92,10 @@ func TestPopulatePromptDataSourceModel(t *testing.T) {
 		Created:      "2026-02-27T00:00:00Z",
 		UserID:       "user-1",
 		OrgID:        "org-1",
+		XactID:       "1000192656880881099",
+		PromptData: map[string]interface{}{
+			"prompt": map[string]interface{}{"type": "completion", "content": "Hello"},
+		},
 		Metadata: map[string]interface{}{
 			"owner": "ml-team",
 			"tier":  1,
@@ -128,6 +132,12 @@ func TestPopulatePromptDataSourceModel(t *testing.T) {
 	if model.OrgID.ValueString() != "org-1" {
 		t.Fatalf("org_id mismatch: got=%q", model.OrgID.ValueString())
 	}
+	if model.Version.ValueString() != "1000192656880881099" {
+		t.Fatalf("version mismatch: got=%q", model.Version.ValueString())
+	}
+	if model.PromptData.ValueString() != `{"prompt":{"content":"Hello","type":"completion"}}` {
+		t.Fatalf("prompt_data mismatch: got=%q", model.PromptData.ValueString())
+	}
 
 	var metadata map[string]string
 	diags = model.Metadata.ElementsAs(ctx, &metadata, false)
@@ -182,6 +192,12 @@ func TestPopulatePromptDataSourceModel_Nullables(t *testing.T) {
 	if !model.OrgID.IsNull() {
 		t.Fatalf("expected org_id to be null")
 	}
+	if !model.Version.IsNull() {
+		t.Fatalf("expected version to be null")
+	}
+	if !model.PromptData.IsNull() {
+		t.Fatalf("expected prompt_data to be null")
+	}
 	if !model.Metadata.IsNull() {
 		t.Fatalf("expected metadata to be null")
 	}
@@ -0,0 +1,318 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &PromptEnvironmentBindingResource{}
+var _ resource.ResourceWithImportState = &PromptEnvironmentBindingResource{}
+
+var errInvalidPromptEnvironmentBindingImportID = errors.New("expected import ID in the format <object_type>,<object_id>,<environment_slug>")
+
+// NewPromptEnvironmentBindingResource creates a new prompt environment binding resource instance.
+func NewPromptEnvironmentBindingResource() resource.Resource {
+	return &PromptEnvironmentBindingResource{}
+}
+
+// PromptEnvironmentBindingResource defines the resource implementation.
+type PromptEnvironmentBindingResource struct {
+	client *client.Client
+}
+
+// PromptEnvironmentBindingResourceModel describes the resource data model.
+type PromptEnvironmentBindingResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	ObjectType      types.String `tfsdk:"object_type"`
+	ObjectID        types.String `tfsdk:"object_id"`
+	EnvironmentSlug types.String `tfsdk:"environment_slug"`
+	Version         types.String `tfsdk:"version"`
+	OrgID           types.String `tfsdk:"org_id"`
+	Created         types.String `tfsdk:"created"`
+}
+
+// Metadata implements resource.Resource.
+func (r *PromptEnvironmentBindingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_prompt_environment_binding"
+}
+
+// Schema implements resource.Resource.
+func (r *PromptEnvironmentBindingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Points a Braintrust environment at a specific version of a prompt or function. Changing `version` promotes the new version in place; destroying the binding removes the object from the environment. Creation fails when the environment already points at the object; import the existing binding instead.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The binding identifier, in the format `<object_type>,<object_id>,<environment_slug>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"object_type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("prompt"),
+				MarkdownDescription: "The type of the bound object: `prompt` or `function`. Defaults to `prompt`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("prompt", "function"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"object_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the prompt or function.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment_slug": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The slug of the environment, for example `production`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The object version (transaction ID) the environment uses. See the `braintrustdata_prompt_versions` and `braintrustdata_function_versions` data sources.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the organization the binding belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The timestamp when the binding was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure implements resource.Resource.
+func (r *PromptEnvironmentBindingResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// Create implements resource.Resource.
+func (r *PromptEnvironmentBindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PromptEnvironmentBindingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Setting a binding replaces any existing one, so refuse to take over a
+	// binding this resource did not create.
+	existing, err := r.client.GetEnvironmentObject(
+		ctx,
+		data.ObjectType.ValueString(),
+		data.ObjectID.ValueString(),
+		data.EnvironmentSlug.ValueString(),
+	)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to check for an existing prompt environment binding, got error: %s", err),
+		)
+		return
+	}
+	if err == nil {
+		id := promptEnvironmentBindingID(data.ObjectType.ValueString(), data.ObjectID.ValueString(), data.EnvironmentSlug.ValueString())
+		resp.Diagnostics.AddError(
+			"Prompt Environment Binding Already Exists",
+			fmt.Sprintf("Environment %q already points %s %s at version %s. Import it with: terraform import <address> %s",
+				data.EnvironmentSlug.ValueString(), data.ObjectType.ValueString(), data.ObjectID.ValueString(), existing.ObjectVersion, id),
+		)
+		return
+	}
+
+	environmentObject, err := r.client.SetEnvironmentObject(
+		ctx,
+		data.ObjectType.ValueString(),
+		data.ObjectID.ValueString(),
+		data.EnvironmentSlug.ValueString(),
+		&client.SetEnvironmentObjectRequest{ObjectVersion: data.Version.ValueString()},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to create prompt environment binding, got error: %s", err),
+		)
+		return
+	}
+
+	setPromptEnvironmentBindingResourceModel(&data, environmentObject)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read implements resource.Resource.
+func (r *PromptEnvironmentBindingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PromptEnvironmentBindingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environmentObject, err := r.client.GetEnvironmentObject(
+		ctx,
+		data.ObjectType.ValueString(),
+		data.ObjectID.ValueString(),
+		data.EnvironmentSlug.ValueString(),
+	)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read prompt environment binding, got error: %s", err),
+		)
+		return
+	}
+
+	setPromptEnvironmentBindingResourceModel(&data, environmentObject)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource.
+func (r *PromptEnvironmentBindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan PromptEnvironmentBindingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environmentObject, err := r.client.SetEnvironmentObject(
+		ctx,
+		plan.ObjectType.ValueString(),
+		plan.ObjectID.ValueString(),
+		plan.EnvironmentSlug.ValueString(),
+		&client.SetEnvironmentObjectRequest{ObjectVersion: plan.Version.ValueString()},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to update prompt environment binding, got error: %s", err),
+		)
+		return
+	}
+
+	setPromptEnvironmentBindingResourceModel(&plan, environmentObject)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete implements resource.Resource.
+func (r *PromptEnvironmentBindingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PromptEnvironmentBindingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteEnvironmentObject(
+		ctx,
+		data.ObjectType.ValueString(),
+		data.ObjectID.ValueString(),
+		data.EnvironmentSlug.ValueString(),
+	)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to delete prompt environment binding, got error: %s", err),
+		)
+	}
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (r *PromptEnvironmentBindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	objectType, objectID, environmentSlug, err := parsePromptEnvironmentBindingImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), promptEnvironmentBindingID(objectType, objectID, environmentSlug))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("object_type"), objectType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("object_id"), objectID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_slug"), environmentSlug)...)
+}
+
+func promptEnvironmentBindingID(objectType, objectID, environmentSlug string) string {
+	return strings.Join([]string{objectType, objectID, environmentSlug}, ",")
+}
+
+func parsePromptEnvironmentBindingImportID(raw string) (string, string, string, error) {
+	parts := strings.Split(raw, ",")
+	if len(parts) != 3 {
+		return "", "", "", errInvalidPromptEnvironmentBindingImportID
+	}
+
+	objectType := strings.TrimSpace(parts[0])
+	objectID := strings.TrimSpace(parts[1])
+	environmentSlug := strings.TrimSpace(parts[2])
+	if objectID == "" || environmentSlug == "" {
+		return "", "", "", errInvalidPromptEnvironmentBindingImportID
+	}
+	if objectType != "prompt" && objectType != "function" {
+		return "", "", "", fmt.Errorf("unsupported object type %q: %w", objectType, errInvalidPromptEnvironmentBindingImportID)
+	}
+
+	return objectType, objectID, environmentSlug, nil
+}
+
+// setPromptEnvironmentBindingResourceModel keeps the binding key from the
+// model, since the key is what the API was addressed by.
+func setPromptEnvironmentBindingResourceModel(model *PromptEnvironmentBindingResourceModel, environmentObject *client.EnvironmentObject) {
+	model.ID = types.StringValue(promptEnvironmentBindingID(
+		model.ObjectType.ValueString(),
+		model.ObjectID.ValueString(),
+		model.EnvironmentSlug.ValueString(),
+	))
+	model.Version = stringOrNull(environmentObject.ObjectVersion)
+	model.OrgID = stringOrNull(environmentObject.OrgID)
+	model.Created = stringOrNull(environmentObject.Created)
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPromptEnvironmentBindingResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPromptEnvironmentBindingResourceConfig("Initial description"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("braintrustdata_prompt_environment_binding.test", "object_type", "prompt"),
+					resource.TestCheckResourceAttr("braintrustdata_prompt_environment_binding.test", "environment_slug", "tf-acc-binding"),
+					resource.TestCheckResourceAttrPair("braintrustdata_prompt_environment_binding.test", "object_id", "braintrustdata_prompt.test", "id"),
+					resource.TestCheckResourceAttrPair("braintrustdata_prompt_environment_binding.test", "version", "data.braintrustdata_prompt_versions.test", "versions.0.version"),
+				),
+			},
+			{
+				Config: testAccPromptEnvironmentBindingResourceConfig("Updated description"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("braintrustdata_prompt_environment_binding.test", "version", "data.braintrustdata_prompt_versions.test", "versions.0.version"),
+				),
+			},
+			{
+				ResourceName:      "braintrustdata_prompt_environment_binding.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPromptEnvironmentBindingResourceConfig(description string) string {
+	return fmt.Sprintf(`
+resource "braintrustdata_project" "test" {
+  name = "test-project-for-prompt-environment-binding"
+}
+
+resource "braintrustdata_environment" "test" {
+  name = "TF Acc Binding"
+  slug = "tf-acc-binding"
+}
+
+resource "braintrustdata_prompt" "test" {
+  project_id  = braintrustdata_project.test.id
+  name        = "test-prompt-environment-binding"
+  description = %q
+}
+
+data "braintrustdata_prompt_versions" "test" {
+  prompt_id = braintrustdata_prompt.test.id
+  limit     = 1
+
+  depends_on = [braintrustdata_prompt.test]
+}
+
+resource "braintrustdata_prompt_environment_binding" "test" {
+  object_id        = braintrustdata_prompt.test.id
+  environment_slug = braintrustdata_environment.test.slug
+  version          = data.braintrustdata_prompt_versions.test.versions[0].version
+}
+`, description)
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParsePromptEnvironmentBindingImportID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		raw                 string
+		wantObjectType      string
+		wantObjectID        string
+		wantEnvironmentSlug string
+		wantErr             bool
+	}{
+		"prompt": {
+			raw:                 "prompt,prompt-1,production",
+			wantObjectType:      "prompt",
+			wantObjectID:        "prompt-1",
+			wantEnvironmentSlug: "production",
+		},
+		"function with whitespace": {
+			raw:                 " function , func-1 , staging ",
+			wantObjectType:      "function",
+			wantObjectID:        "func-1",
+			wantEnvironmentSlug: "staging",
+		},
+		"missing environment": {
+			raw:     "prompt,prompt-1",
+			wantErr: true,
+		},
+		"empty object id": {
+			raw:     "prompt,,production",
+			wantErr: true,
+		},
+		"unsupported object type": {
+			raw:     "dataset,dataset-1,production",
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			objectType, objectID, environmentSlug, err := parsePromptEnvironmentBindingImportID(tc.raw)
+			if tc.wantErr {
+				if !errors.Is(err, errInvalidPromptEnvironmentBindingImportID) {
+					t.Fatalf("expected invalid import ID error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if objectType != tc.wantObjectType || objectID != tc.wantObjectID || environmentSlug != tc.wantEnvironmentSlug {
+				t.Fatalf("got %q, %q, %q", objectType, objectID, environmentSlug)
+			}
+		})
+	}
+}
+
+func TestSetPromptEnvironmentBindingResourceModel(t *testing.T) {
+	t.Parallel()
+
+	model := PromptEnvironmentBindingResourceModel{
+		ObjectType:      types.StringValue("prompt"),
+		ObjectID:        types.StringValue("prompt-1"),
+		EnvironmentSlug: types.StringValue("production"),
+		Version:         types.StringValue("1000192656880000000"),
+	}
+	setPromptEnvironmentBindingResourceModel(&model, &client.EnvironmentObject{
+		ID:            "assoc-1",
+		OrgID:         "org-1",
+		ObjectVersion: "1000192656880881099",
+		Created:       "2026-01-01T00:00:00Z",
+	})
+
+	if model.ID.ValueString() != "prompt,prompt-1,production" {
+		t.Fatalf("id mismatch: got=%q", model.ID.ValueString())
+	}
+	if model.Version.ValueString() != "1000192656880881099" {
+		t.Fatalf("version mismatch: got=%q", model.Version.ValueString())
+	}
+	if model.OrgID.ValueString() != "org-1" {
+		t.Fatalf("org_id mismatch: got=%q", model.OrgID.ValueString())
+	}
+	if model.ObjectID.ValueString() != "prompt-1" {
+		t.Fatalf("object_id mismatch: got=%q", model.ObjectID.ValueString())
+	}
+}
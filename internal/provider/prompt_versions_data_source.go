@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &PromptVersionsDataSource{}
+
+const (
+	// defaultVersionsLimit is the number of versions read when `limit` is
+	// unset. Each version costs one API request.
+	defaultVersionsLimit = 20
+	maxVersionsLimit     = 100
+)
+
+// NewPromptVersionsDataSource creates a new prompt versions data source instance.
+func NewPromptVersionsDataSource() datasource.DataSource {
+	return &PromptVersionsDataSource{}
+}
+
+// PromptVersionsDataSource defines the data source implementation.
+type PromptVersionsDataSource struct {
+	client *client.Client
+}
+
+// PromptVersionsDataSourceModel describes the data source data model.
+type PromptVersionsDataSourceModel struct {
+	PromptID types.String                      `tfsdk:"prompt_id"`
+	Versions []PromptVersionsDataSourceVersion `tfsdk:"versions"`
+	Limit    types.Int64                       `tfsdk:"limit"`
+}
+
+// PromptVersionsDataSourceVersion represents a single prompt version in the list.
+type PromptVersionsDataSourceVersion struct {
+	Version     types.String `tfsdk:"version"`
+	Created     types.String `tfsdk:"created"`
+	UserID      types.String `tfsdk:"user_id"`
+	Name        types.String `tfsdk:"name"`
+	Slug        types.String `tfsdk:"slug"`
+	Description types.String `tfsdk:"description"`
+	PromptData  types.String `tfsdk:"prompt_data"`
+}
+
+// Metadata implements datasource.DataSource.
+func (d *PromptVersionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_prompt_versions"
+}
+
+// Schema implements datasource.DataSource.
+func (d *PromptVersionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the version history of a Braintrust prompt, newest first. Pass a `version` to `braintrustdata_prompt` to read a specific one.",
+		Attributes: map[string]schema.Attribute{
+			"prompt_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the prompt.",
+			},
+			"limit": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("Maximum number of versions to return, including the current one. Defaults to %d, at most %d.", defaultVersionsLimit, maxVersionsLimit),
+				Validators:          versionsLimitValidators(),
+			},
+			"versions": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The prompt versions, newest first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"version": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The version (transaction ID).",
+						},
+						"created": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the version was written.",
+						},
+						"user_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the user who wrote the version.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The prompt name at this version.",
+						},
+						"slug": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The prompt slug at this version.",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The prompt description at this version.",
+						},
+						"prompt_data": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The prompt data at this version as a JSON-encoded string.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure implements datasource.DataSource.
+func (d *PromptVersionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PromptVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PromptVersionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	promptID := strings.TrimSpace(data.PromptID.ValueString())
+	prompts, err := d.client.ListPromptVersions(ctx, promptID, versionsLimit(data.Limit))
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Prompt Not Found",
+				fmt.Sprintf("No prompt found with ID: %s", promptID),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Listing Prompt Versions",
+			fmt.Sprintf("Could not list versions of prompt ID %s: %s", promptID, err.Error()),
+		)
+		return
+	}
+
+	data.Versions = make([]PromptVersionsDataSourceVersion, 0, len(prompts))
+	for i := range prompts {
+		version, versionDiags := promptVersionsDataSourceVersionFromPrompt(&prompts[i])
+		resp.Diagnostics.Append(versionDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.Versions = append(data.Versions, version)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func promptVersionsDataSourceVersionFromPrompt(prompt *client.Prompt) (PromptVersionsDataSourceVersion, diag.Diagnostics) {
+	promptData, diags := jsonEncodedOrNull("prompt_data", prompt.PromptData)
+
+	return PromptVersionsDataSourceVersion{
+		Version:     stringOrNull(prompt.XactID),
+		Created:     versionCreatedOrNull(prompt.XactID, prompt.Created),
+		UserID:      stringOrNull(prompt.UserID),
+		Name:        stringOrNull(prompt.Name),
+		Slug:        stringOrNull(prompt.Slug),
+		Description: stringOrNull(prompt.Description),
+		PromptData:  promptData,
+	}, diags
+}
+
+func versionsLimitValidators() []validator.Int64 {
+	return []validator.Int64{int64validator.Between(1, maxVersionsLimit)}
+}
+
+func versionsLimit(limit types.Int64) int {
+	if limit.IsNull() || limit.IsUnknown() {
+		return defaultVersionsLimit
+	}
+
+	return int(limit.ValueInt64())
+}
+
+// versionCreatedOrNull returns the time a version was written, taken from its
+// transaction ID. Objects without a readable transaction ID fall back to
+// their creation time.
+func versionCreatedOrNull(xactID, created string) types.String {
+	written, err := client.VersionTime(xactID)
+	if err != nil {
+		return stringOrNull(created)
+	}
+
+	return types.StringValue(written.Format(time.RFC3339))
+}
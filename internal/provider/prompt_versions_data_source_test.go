@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPromptVersionsDataSource_Basic(t *testing.T) {
+	testAccPromptDataSourceRequiresAPIKey(t)
+
+	promptID, _, ok := testAccPromptLookupContext()
+	if !ok {
+		t.Skip("BRAINTRUST_PROMPT_ID and BRAINTRUST_PROMPT_PROJECT_ID must be set for prompt versions data source acceptance testing")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPromptVersionsDataSourceConfig(promptID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.braintrustdata_prompt_versions.test", "versions.0.version"),
+					resource.TestCheckResourceAttrSet("data.braintrustdata_prompt_versions.test", "versions.0.created"),
+					resource.TestCheckResourceAttrPair("data.braintrustdata_prompt_versions.test", "versions.0.version", "data.braintrustdata_prompt.latest", "version"),
+					resource.TestCheckResourceAttrPair("data.braintrustdata_prompt.pinned", "prompt_data", "data.braintrustdata_prompt_versions.test", "versions.0.prompt_data"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPromptVersionsDataSourceConfig(promptID string) string {
+	return fmt.Sprintf(`
+data "braintrustdata_prompt_versions" "test" {
+  prompt_id = %q
+  limit     = 3
+}
+
+data "braintrustdata_prompt" "latest" {
+  id = %q
+}
+
+data "braintrustdata_prompt" "pinned" {
+  id      = %q
+  version = data.braintrustdata_prompt_versions.test.versions[0].version
+}
+`, promptID, promptID, promptID)
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPromptVersionsDataSourceVersionFromPrompt(t *testing.T) {
+	t.Parallel()
+
+	version, diags := promptVersionsDataSourceVersionFromPrompt(&client.Prompt{
+		ID:          "prompt-1",
+		Name:        "support-agent",
+		Slug:        "support-agent",
+		Description: "Support assistant",
+		XactID:      "1000192656880881099",
+		Created:     "2024-01-01T00:00:00Z",
+		UserID:      "user-1",
+		PromptData: map[string]interface{}{
+			"prompt": map[string]interface{}{"type": "completion", "content": "Hello"},
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if version.Version.ValueString() != "1000192656880881099" {
+		t.Fatalf("version mismatch: got=%q", version.Version.ValueString())
+	}
+	if version.Created.ValueString() != "2024-03-09T07:48:38Z" {
+		t.Fatalf("created mismatch: got=%q", version.Created.ValueString())
+	}
+	if version.UserID.ValueString() != "user-1" {
+		t.Fatalf("user_id mismatch: got=%q", version.UserID.ValueString())
+	}
+	if version.PromptData.ValueString() != `{"prompt":{"content":"Hello","type":"completion"}}` {
+		t.Fatalf("prompt_data mismatch: got=%q", version.PromptData.ValueString())
+	}
+}
+
+func TestVersionCreatedOrNull(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		xactID  string
+		created string
+		want    types.String
+	}{
+		{name: "from transaction id", xactID: "1000192656880881099", created: "2024-01-01T00:00:00Z", want: types.StringValue("2024-03-09T07:48:38Z")},
+		{name: "falls back to created", xactID: "81cd05ee665fdfb3", created: "2024-01-01T00:00:00Z", want: types.StringValue("2024-01-01T00:00:00Z")},
+		{name: "null without either", want: types.StringNull()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := versionCreatedOrNull(tt.xactID, tt.created); !got.Equal(tt.want) {
+				t.Fatalf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestVersionsLimit(t *testing.T) {
+	t.Parallel()
+
+	if got := versionsLimit(types.Int64Null()); got != defaultVersionsLimit {
+		t.Fatalf("expected default limit %d, got %d", defaultVersionsLimit, got)
+	}
+	if got := versionsLimit(types.Int64Value(5)); got != 5 {
+		t.Fatalf("expected limit 5, got %d", got)
+	}
+}
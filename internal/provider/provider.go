@@ -133,18 +133,32 @@ func (p *BraintrustProvider) Configure(ctx context.Context, req provider.Configu
 func (p *BraintrustProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewACLResource,
+		NewACLBatchResource,
 		NewAISecretResource,
 		NewAPIKeyResource,
 		NewDatasetResource,
+		NewDatasetRecordResource,
+		NewDatasetRecordsResource,
+		NewEnvironmentResource,
 		NewEnvironmentVariableResource,
 		NewExperimentResource,
+		NewExperimentCopyResource,
 		NewFunctionResource,
 		NewGroupResource,
+		NewGroupMemberResource,
+		NewGroupMembersResource,
+		NewObjectACLPolicyResource,
 		NewOrgResource,
+		NewPlaygroundResource,
+		NewProjectAutomationResource,
 		NewProjectResource,
 		NewPromptResource,
+		NewPromptEnvironmentBindingResource,
 		NewRoleResource,
+		NewRoleInheritanceResource,
+		NewRolePermissionResource,
 		NewScoreResource,
+		NewSpanIframeResource,
 		NewTagResource,
 		NewViewResource,
 	}
@@ -160,25 +174,39 @@ func (p *BraintrustProvider) DataSources(_ context.Context) []func() datasource.
 		NewAPIKeyDataSource,
 		NewAPIKeysDataSource,
 		NewDatasetDataSource,
+		NewDatasetRecordsDataSource,
+		NewDatasetSnapshotDataSource,
 		NewDatasetsDataSource,
+		NewEffectivePermissionsDataSource,
 		NewEnvironmentVariableDataSource,
 		NewEnvironmentVariablesDataSource,
 		NewExperimentDataSource,
+		NewExperimentEventsDataSource,
+		NewExperimentSummaryDataSource,
 		NewExperimentsDataSource,
 		NewFunctionDataSource,
+		NewFunctionInvocationDataSource,
+		NewFunctionVersionsDataSource,
 		NewFunctionsDataSource,
+		NewGitRepoInfoDataSource,
 		NewGroupDataSource,
+		NewGroupExpandedMembersDataSource,
 		NewGroupsDataSource,
 		NewOrgDataSource,
 		NewOrgsDataSource,
+		NewProjectAutomationDataSource,
+		NewProjectAutomationsDataSource,
 		NewProjectDataSource,
 		NewProjectsDataSource,
 		NewPromptDataSource,
+		NewPromptVersionsDataSource,
 		NewPromptsDataSource,
 		NewRoleDataSource,
 		NewRolesDataSource,
 		NewScoreDataSource,
 		NewScoresDataSource,
+		NewSpanIframeDataSource,
+		NewSpanIframesDataSource,
 		NewTagDataSource,
 		NewTagsDataSource,
 		NewUserDataSource,
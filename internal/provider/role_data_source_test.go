@@ -33,7 +33,7 @@ func testAccRoleDataSourceConfigByID() string {
 resource "braintrustdata_role" "test" {
   name               = "test-role-ds-by-id"
   description        = "Role data source lookup by id"
-  member_permissions = ["read", "update"]
+  member_permissions = [{ permission = "read" }, { permission = "update" }]
 }
 
 data "braintrustdata_role" "test" {
@@ -67,7 +67,7 @@ func testAccRoleDataSourceConfigByName() string {
 resource "braintrustdata_role" "test" {
   name               = "test-role-ds-by-name"
   description        = "Role data source lookup by name"
-  member_permissions = ["read"]
+  member_permissions = [{ permission = "read" }]
 }
 
 data "braintrustdata_role" "test" {
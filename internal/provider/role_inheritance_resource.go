@@ -0,0 +1,273 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RoleInheritanceResource{}
+var _ resource.ResourceWithImportState = &RoleInheritanceResource{}
+var _ resource.ResourceWithModifyPlan = &RoleInheritanceResource{}
+
+// NewRoleInheritanceResource creates a new role inheritance resource instance.
+func NewRoleInheritanceResource() resource.Resource {
+	return &RoleInheritanceResource{}
+}
+
+// RoleInheritanceResource defines the resource implementation.
+type RoleInheritanceResource struct {
+	client *client.Client
+}
+
+// RoleInheritanceResourceModel describes the resource data model.
+type RoleInheritanceResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	RoleID       types.String `tfsdk:"role_id"`
+	MemberRoleID types.String `tfsdk:"member_role_id"`
+}
+
+// Metadata implements resource.Resource.
+func (r *RoleInheritanceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_inheritance"
+}
+
+// Schema implements resource.Resource.
+func (r *RoleInheritanceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a single role inherited by a Braintrust role without taking ownership of the role's other inherited roles. " +
+			"On a role managed by `braintrustdata_role`, add `member_roles` to that resource's `lifecycle.ignore_changes`, since the attribute is authoritative even when omitted.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The inheritance identifier in the format `<role_id>,<member_role_id>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the role that inherits from `member_role_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"member_role_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the role whose permissions are inherited.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Configure implements resource.Resource.
+func (r *RoleInheritanceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create implements resource.Resource by adding the member role to the role.
+func (r *RoleInheritanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RoleInheritanceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleID := data.RoleID.ValueString()
+	memberRoleID := data.MemberRoleID.ValueString()
+
+	_, err := r.client.UpdateRole(ctx, roleID, &client.UpdateRoleRequest{
+		AddMemberRoles: []string{memberRoleID},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add member role to role, got error: %s", err))
+		return
+	}
+
+	role, err := r.client.GetRole(ctx, roleID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read role after adding member role, got error: %s", err))
+		return
+	}
+
+	if !slices.Contains(role.MemberRoles, memberRoleID) {
+		resp.Diagnostics.AddError(
+			"Inheritance Not Applied",
+			fmt.Sprintf("The member role %s was not found in role %s after it was added.", memberRoleID, roleID),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(roleInheritanceID(roleID, memberRoleID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read implements resource.Resource by checking that the role still inherits the member role.
+func (r *RoleInheritanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RoleInheritanceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, err := r.client.GetRole(ctx, data.RoleID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read role, got error: %s", err))
+		return
+	}
+
+	// A deleted role or a member role removed out-of-band both mean the inheritance is gone.
+	memberRoleID := data.MemberRoleID.ValueString()
+	if role.DeletedAt != "" || !slices.Contains(role.MemberRoles, memberRoleID) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(roleInheritanceID(role.ID, memberRoleID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource.
+// Note: every attribute requires replacement, so this is never called in practice.
+func (r *RoleInheritanceResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Update Not Supported",
+		"Role inheritances are immutable and cannot be updated. All changes require replacement.",
+	)
+}
+
+// Delete implements resource.Resource by removing the member role from the role.
+func (r *RoleInheritanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RoleInheritanceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpdateRole(ctx, data.RoleID.ValueString(), &client.UpdateRoleRequest{
+		RemoveMemberRoles: []string{data.MemberRoleID.ValueString()},
+	})
+	if err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove member role from role, got error: %s", err))
+		return
+	}
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan by rejecting an
+// inheritance that would make the role inherit from itself.
+func (r *RoleInheritanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan RoleInheritanceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.RoleID.IsNull() || plan.RoleID.IsUnknown() || plan.MemberRoleID.IsNull() || plan.MemberRoleID.IsUnknown() {
+		return
+	}
+
+	roles, err := listAllRoles(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list roles to check member_role_id for cycles, got error: %s", err))
+		return
+	}
+
+	if cycle, names := roleInheritanceCycle(roles, plan.RoleID.ValueString(), plan.MemberRoleID.ValueString()); cycle != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("member_role_id"),
+			"Role Inheritance Cycle",
+			fmt.Sprintf("member_role_id would make the role inherit from itself: %s.", formatMembershipCycle(cycle, names)),
+		)
+	}
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (r *RoleInheritanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	roleID, memberRoleID, err := parseRoleInheritanceImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), roleInheritanceID(roleID, memberRoleID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_id"), roleID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("member_role_id"), memberRoleID)...)
+}
+
+func roleInheritanceID(roleID, memberRoleID string) string {
+	return strings.Join([]string{roleID, memberRoleID}, ",")
+}
+
+// roleInheritanceCycle returns the cycle, if any, that adding memberRoleID to
+// the current member roles of roleID would create, with role names for display.
+func roleInheritanceCycle(roles []client.Role, roleID, memberRoleID string) ([]string, map[string]string) {
+	return roleMembershipCycle(roles, roleID, func(current []string) []string {
+		if slices.Contains(current, memberRoleID) {
+			return current
+		}
+		return append(current, memberRoleID)
+	})
+}
+
+func parseRoleInheritanceImportID(raw string) (string, string, error) {
+	parts := strings.Split(raw, ",")
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("expected import ID in the format <role_id>,<member_role_id>")
+	}
+
+	roleID := strings.TrimSpace(parts[0])
+	memberRoleID := strings.TrimSpace(parts[1])
+	if roleID == "" || memberRoleID == "" {
+		return "", "", fmt.Errorf("expected import ID in the format <role_id>,<member_role_id>")
+	}
+
+	return roleID, memberRoleID, nil
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRoleInheritanceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRoleInheritanceResourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("braintrustdata_role_inheritance.test", "role_id", "braintrustdata_role.parent", "id"),
+					resource.TestCheckResourceAttrPair("braintrustdata_role_inheritance.test", "member_role_id", "braintrustdata_role.child", "id"),
+					resource.TestCheckResourceAttrSet("braintrustdata_role_inheritance.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "braintrustdata_role_inheritance.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRoleInheritanceResourceConfig() string {
+	return `
+resource "braintrustdata_role" "parent" {
+  name        = "test-role-inheritance-parent"
+  description = "Role extended via braintrustdata_role_inheritance"
+
+  lifecycle {
+    ignore_changes = [member_roles]
+  }
+}
+
+resource "braintrustdata_role" "child" {
+  name               = "test-role-inheritance-child"
+  member_permissions = [{ permission = "read" }]
+}
+
+resource "braintrustdata_role_inheritance" "test" {
+  role_id        = braintrustdata_role.parent.id
+  member_role_id = braintrustdata_role.child.id
+}
+`
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+)
+
+func TestParseRoleInheritanceImportID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		raw              string
+		wantRoleID       string
+		wantMemberRoleID string
+		wantErr          bool
+	}{
+		"valid": {
+			raw:              "role-1,role-2",
+			wantRoleID:       "role-1",
+			wantMemberRoleID: "role-2",
+		},
+		"whitespace": {
+			raw:              " role-1 , role-2 ",
+			wantRoleID:       "role-1",
+			wantMemberRoleID: "role-2",
+		},
+		"missing member role": {
+			raw:     "role-1",
+			wantErr: true,
+		},
+		"empty member role": {
+			raw:     "role-1,",
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			roleID, memberRoleID, err := parseRoleInheritanceImportID(tc.raw)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if roleID != tc.wantRoleID || memberRoleID != tc.wantMemberRoleID {
+				t.Fatalf("got (%q, %q), want (%q, %q)", roleID, memberRoleID, tc.wantRoleID, tc.wantMemberRoleID)
+			}
+		})
+	}
+}
+
+func TestRoleInheritanceCycle(t *testing.T) {
+	t.Parallel()
+
+	roles := []client.Role{
+		{ID: "role-a", Name: "A", MemberRoles: []string{"role-b"}},
+		{ID: "role-b", Name: "B", MemberRoles: []string{"role-c"}},
+		{ID: "role-c", Name: "C"},
+		{ID: "role-d", Name: "D", MemberRoles: []string{"role-a"}, DeletedAt: "2024-01-01T00:00:00Z"},
+	}
+
+	testCases := map[string]struct {
+		roleID       string
+		memberRoleID string
+		want         []string
+	}{
+		"new edge closes a cycle": {
+			roleID:       "role-c",
+			memberRoleID: "role-a",
+			want:         []string{"role-c", "role-a", "role-b", "role-c"},
+		},
+		"self inheritance": {
+			roleID:       "role-b",
+			memberRoleID: "role-b",
+			want:         []string{"role-b", "role-b"},
+		},
+		"no cycle": {
+			roleID:       "role-a",
+			memberRoleID: "role-c",
+		},
+		"deleted roles are ignored": {
+			roleID:       "role-a",
+			memberRoleID: "role-d",
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, names := roleInheritanceCycle(roles, tc.roleID, tc.memberRoleID)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("got cycle %v, want %v", got, tc.want)
+			}
+			if names["role-a"] != "A" {
+				t.Fatalf("expected role names to be returned, got %v", names)
+			}
+		})
+	}
+}
@@ -0,0 +1,285 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RolePermissionResource{}
+var _ resource.ResourceWithImportState = &RolePermissionResource{}
+
+// NewRolePermissionResource creates a new role permission resource instance.
+func NewRolePermissionResource() resource.Resource {
+	return &RolePermissionResource{}
+}
+
+// RolePermissionResource defines the resource implementation.
+type RolePermissionResource struct {
+	client *client.Client
+}
+
+// RolePermissionResourceModel describes the resource data model.
+type RolePermissionResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	RoleID             types.String `tfsdk:"role_id"`
+	Permission         types.String `tfsdk:"permission"`
+	RestrictObjectType types.String `tfsdk:"restrict_object_type"`
+}
+
+// Metadata implements resource.Resource.
+func (r *RolePermissionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_permission"
+}
+
+// Schema implements resource.Resource.
+func (r *RolePermissionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a single permission granted by a Braintrust role without taking ownership of the role's other permissions. " +
+			"On a role managed by `braintrustdata_role`, add `member_permissions` to that resource's `lifecycle.ignore_changes`, since the attribute is authoritative even when omitted.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The permission identifier in the format `<role_id>,<permission>` or `<role_id>,<permission>,<restrict_object_type>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the role to add the permission to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permission": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The permission to grant. Valid values: create, read, update, delete, create_acls, read_acls, update_acls, delete_acls.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"create",
+						"read",
+						"update",
+						"delete",
+						"create_acls",
+						"read_acls",
+						"update_acls",
+						"delete_acls",
+					),
+				},
+			},
+			"restrict_object_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "When specified, restricts the permission to only apply to objects of this type.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"organization",
+						"project",
+						"experiment",
+						"dataset",
+						"prompt",
+						"prompt_session",
+						"group",
+						"role",
+						"org_member",
+						"project_log",
+						"org_project",
+					),
+				},
+			},
+		},
+	}
+}
+
+// Configure implements resource.Resource.
+func (r *RolePermissionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create implements resource.Resource by adding the permission to the role.
+func (r *RolePermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RolePermissionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleID := data.RoleID.ValueString()
+	permission := rolePermissionFromModel(data)
+
+	_, err := r.client.UpdateRole(ctx, roleID, &client.UpdateRoleRequest{
+		AddMemberPermissions: []client.RoleMemberPermission{permission},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add permission to role, got error: %s", err))
+		return
+	}
+
+	role, err := r.client.GetRole(ctx, roleID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read role after adding permission, got error: %s", err))
+		return
+	}
+
+	if !slices.Contains(role.MemberPermissions, permission) {
+		resp.Diagnostics.AddError(
+			"Permission Not Applied",
+			fmt.Sprintf("The permission %s was not found in role %s after it was added.", permission.Permission, roleID),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(rolePermissionID(roleID, permission))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read implements resource.Resource by checking that the role still grants the permission.
+func (r *RolePermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RolePermissionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, err := r.client.GetRole(ctx, data.RoleID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read role, got error: %s", err))
+		return
+	}
+
+	// A deleted role or a permission removed out-of-band both mean the grant is gone.
+	permission := rolePermissionFromModel(data)
+	if role.DeletedAt != "" || !slices.Contains(role.MemberPermissions, permission) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(rolePermissionID(role.ID, permission))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource.
+// Note: every attribute requires replacement, so this is never called in practice.
+func (r *RolePermissionResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Update Not Supported",
+		"Role permissions are immutable and cannot be updated. All changes require replacement.",
+	)
+}
+
+// Delete implements resource.Resource by removing the permission from the role.
+func (r *RolePermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RolePermissionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpdateRole(ctx, data.RoleID.ValueString(), &client.UpdateRoleRequest{
+		RemoveMemberPermissions: []client.RoleMemberPermission{rolePermissionFromModel(data)},
+	})
+	if err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove permission from role, got error: %s", err))
+		return
+	}
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (r *RolePermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	roleID, permission, err := parseRolePermissionImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), rolePermissionID(roleID, permission))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_id"), roleID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("permission"), permission.Permission)...)
+	if permission.RestrictObjectType != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("restrict_object_type"), permission.RestrictObjectType)...)
+	}
+}
+
+func rolePermissionFromModel(data RolePermissionResourceModel) client.RoleMemberPermission {
+	return client.RoleMemberPermission{
+		Permission:         data.Permission.ValueString(),
+		RestrictObjectType: data.RestrictObjectType.ValueString(),
+	}
+}
+
+func rolePermissionID(roleID string, permission client.RoleMemberPermission) string {
+	parts := []string{roleID, permission.Permission}
+	if permission.RestrictObjectType != "" {
+		parts = append(parts, permission.RestrictObjectType)
+	}
+	return strings.Join(parts, ",")
+}
+
+func parseRolePermissionImportID(raw string) (string, client.RoleMemberPermission, error) {
+	parts := strings.Split(raw, ",")
+	if len(parts) != 2 && len(parts) != 3 {
+		return "", client.RoleMemberPermission{}, fmt.Errorf("expected import ID in the format <role_id>,<permission> or <role_id>,<permission>,<restrict_object_type>")
+	}
+
+	roleID := strings.TrimSpace(parts[0])
+	permission := client.RoleMemberPermission{Permission: strings.TrimSpace(parts[1])}
+	if len(parts) == 3 {
+		permission.RestrictObjectType = strings.TrimSpace(parts[2])
+		if permission.RestrictObjectType == "" {
+			return "", client.RoleMemberPermission{}, fmt.Errorf("expected import ID in the format <role_id>,<permission> or <role_id>,<permission>,<restrict_object_type>")
+		}
+	}
+	if roleID == "" || permission.Permission == "" {
+		return "", client.RoleMemberPermission{}, fmt.Errorf("expected import ID in the format <role_id>,<permission> or <role_id>,<permission>,<restrict_object_type>")
+	}
+
+	return roleID, permission, nil
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRolePermissionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRolePermissionResourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("braintrustdata_role_permission.read", "role_id", "braintrustdata_role.shared", "id"),
+					resource.TestCheckResourceAttr("braintrustdata_role_permission.read", "permission", "read"),
+					resource.TestCheckResourceAttr("braintrustdata_role_permission.update_datasets", "restrict_object_type", "dataset"),
+					resource.TestCheckResourceAttrSet("braintrustdata_role_permission.update_datasets", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "braintrustdata_role_permission.update_datasets",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRolePermissionResourceConfig() string {
+	return `
+resource "braintrustdata_role" "shared" {
+  name        = "test-role-permission-shared"
+  description = "Role extended via braintrustdata_role_permission"
+
+  lifecycle {
+    ignore_changes = [member_permissions]
+  }
+}
+
+resource "braintrustdata_role_permission" "read" {
+  role_id    = braintrustdata_role.shared.id
+  permission = "read"
+}
+
+resource "braintrustdata_role_permission" "update_datasets" {
+  role_id              = braintrustdata_role.shared.id
+  permission           = "update"
+  restrict_object_type = "dataset"
+}
+`
+}
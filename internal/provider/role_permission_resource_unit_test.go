@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+)
+
+func TestParseRolePermissionImportID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		raw            string
+		wantRoleID     string
+		wantPermission client.RoleMemberPermission
+		wantErr        bool
+	}{
+		"unrestricted permission": {
+			raw:            "role-1,read",
+			wantRoleID:     "role-1",
+			wantPermission: client.RoleMemberPermission{Permission: "read"},
+		},
+		"restricted permission with whitespace": {
+			raw:            " role-1 , update , project ",
+			wantRoleID:     "role-1",
+			wantPermission: client.RoleMemberPermission{Permission: "update", RestrictObjectType: "project"},
+		},
+		"missing permission": {
+			raw:     "role-1",
+			wantErr: true,
+		},
+		"empty restrict object type": {
+			raw:     "role-1,read,",
+			wantErr: true,
+		},
+		"too many parts": {
+			raw:     "role-1,read,project,extra",
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			roleID, permission, err := parseRolePermissionImportID(tc.raw)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if roleID != tc.wantRoleID || permission != tc.wantPermission {
+				t.Fatalf("got (%q, %#v), want (%q, %#v)", roleID, permission, tc.wantRoleID, tc.wantPermission)
+			}
+		})
+	}
+}
+
+func TestRolePermissionID(t *testing.T) {
+	t.Parallel()
+
+	if got := rolePermissionID("role-1", client.RoleMemberPermission{Permission: "read"}); got != "role-1,read" {
+		t.Fatalf("rolePermissionID() = %q, want %q", got, "role-1,read")
+	}
+	if got := rolePermissionID("role-1", client.RoleMemberPermission{Permission: "read", RestrictObjectType: "dataset"}); got != "role-1,read,dataset" {
+		t.Fatalf("rolePermissionID() = %q, want %q", got, "role-1,read,dataset")
+	}
+}
@@ -3,20 +3,26 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &RoleResource{}
 var _ resource.ResourceWithImportState = &RoleResource{}
+var _ resource.ResourceWithModifyPlan = &RoleResource{}
+var _ resource.ResourceWithUpgradeState = &RoleResource{}
 
 // NewRoleResource creates a new role resource instance.
 func NewRoleResource() resource.Resource {
@@ -30,6 +36,19 @@ type RoleResource struct {
 
 // RoleResourceModel describes the resource data model.
 type RoleResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	OrgID             types.String `tfsdk:"org_id"`
+	Description       types.String `tfsdk:"description"`
+	MemberPermissions types.Set    `tfsdk:"member_permissions"`
+	MemberRoles       types.List   `tfsdk:"member_roles"`
+	Created           types.String `tfsdk:"created"`
+	UserID            types.String `tfsdk:"user_id"`
+}
+
+// roleResourceModelV0 describes the schema version 0 data model, where
+// member_permissions was a list of permission names.
+type roleResourceModelV0 struct {
 	ID                types.String `tfsdk:"id"`
 	Name              types.String `tfsdk:"name"`
 	OrgID             types.String `tfsdk:"org_id"`
@@ -40,6 +59,19 @@ type RoleResourceModel struct {
 	UserID            types.String `tfsdk:"user_id"`
 }
 
+var roleMemberPermissionAttributeTypes = map[string]attr.Type{
+	"permission":           types.StringType,
+	"restrict_object_type": types.StringType,
+}
+
+type roleMemberPermissionModel struct {
+	Permission         types.String `tfsdk:"permission"`
+	RestrictObjectType types.String `tfsdk:"restrict_object_type"`
+}
+
+// rolePageSize is the page size used when listing every role.
+const rolePageSize = 100
+
 type listValueState int
 
 const (
@@ -56,7 +88,10 @@ func (r *RoleResource) Metadata(_ context.Context, req resource.MetadataRequest,
 // Schema implements resource.Resource.
 func (r *RoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a Braintrust role. Roles define permission levels and can be assigned to users and groups for access control.",
+		Version: 1,
+		MarkdownDescription: "Manages a Braintrust role. Roles define permission levels and can be assigned to users and groups for access control. " +
+			"`member_permissions` and `member_roles` are authoritative even when omitted. To extend the role with `braintrustdata_role_permission` " +
+			"or `braintrustdata_role_inheritance`, add the matching attribute to `lifecycle.ignore_changes`.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -78,15 +113,54 @@ func (r *RoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Optional:            true,
 				MarkdownDescription: "A description of the role.",
 			},
-			"member_permissions": schema.ListAttribute{
-				ElementType:         types.StringType,
+			"member_permissions": schema.SetNestedAttribute{
 				Optional:            true,
-				MarkdownDescription: "List of permissions assigned to members of this role.",
+				MarkdownDescription: "Set of permissions assigned to members of this role. Omitting it removes every permission from the role.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"permission": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The permission to grant. Valid values: create, read, update, delete, create_acls, read_acls, update_acls, delete_acls.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									"create",
+									"read",
+									"update",
+									"delete",
+									"create_acls",
+									"read_acls",
+									"update_acls",
+									"delete_acls",
+								),
+							},
+						},
+						"restrict_object_type": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "When specified, restricts the permission to only apply to objects of this type.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									"organization",
+									"project",
+									"experiment",
+									"dataset",
+									"prompt",
+									"prompt_session",
+									"group",
+									"role",
+									"org_member",
+									"project_log",
+									"org_project",
+								),
+							},
+						},
+					},
+				},
 			},
 			"member_roles": schema.ListAttribute{
-				ElementType:         types.StringType,
-				Optional:            true,
-				MarkdownDescription: "List of role IDs assigned to members of this role.",
+				ElementType: types.StringType,
+				Optional:    true,
+				MarkdownDescription: "List of role IDs assigned to members of this role. Planning fails if the list would make the role inherit from itself. " +
+					"Omitting it removes every inherited role from the role.",
 			},
 			"created": schema.StringAttribute{
 				Computed:            true,
@@ -207,11 +281,11 @@ func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	currentMemberPermissions, diags := listToStringSlice(ctx, state.MemberPermissions)
+	currentMemberPermissions, _, diags := roleMemberPermissionsFromSet(ctx, state.MemberPermissions)
 	resp.Diagnostics.Append(diags...)
 	currentMemberRoles, diags := listToStringSlice(ctx, state.MemberRoles)
 	resp.Diagnostics.Append(diags...)
-	desiredMemberPermissions, desiredMemberPermissionsState, diags := listToStringSliceWithState(ctx, data.MemberPermissions)
+	desiredMemberPermissions, desiredMemberPermissionsState, diags := roleMemberPermissionsFromSet(ctx, data.MemberPermissions)
 	resp.Diagnostics.Append(diags...)
 	desiredMemberRoles, desiredMemberRolesState, diags := listToStringSliceWithState(ctx, data.MemberRoles)
 	resp.Diagnostics.Append(diags...)
@@ -219,7 +293,7 @@ func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	addMemberPermissions, removeMemberPermissions := computeStringSliceDiffForDesiredState(
+	addMemberPermissions, removeMemberPermissions := diffRoleMemberPermissionsForDesiredState(
 		currentMemberPermissions,
 		desiredMemberPermissions,
 		desiredMemberPermissionsState,
@@ -234,8 +308,8 @@ func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	role, err := r.client.UpdateRole(ctx, data.ID.ValueString(), &client.UpdateRoleRequest{
 		Name:                    data.Name.ValueString(),
 		Description:             data.Description.ValueString(),
-		AddMemberPermissions:    roleMemberPermissionsFromStrings(addMemberPermissions),
-		RemoveMemberPermissions: roleMemberPermissionsFromStrings(removeMemberPermissions),
+		AddMemberPermissions:    addMemberPermissions,
+		RemoveMemberPermissions: removeMemberPermissions,
 		AddMemberRoles:          addMemberRoles,
 		RemoveMemberRoles:       removeMemberRoles,
 	})
@@ -279,11 +353,166 @@ func (r *RoleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan by rejecting member_roles
+// that would make the role inherit from itself.
+func (r *RoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan RoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A role that does not exist yet cannot be inherited by any other role.
+	if plan.ID.IsNull() || plan.ID.IsUnknown() {
+		return
+	}
+
+	memberRoles, memberRolesState, diags := listToStringSliceWithState(ctx, plan.MemberRoles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || memberRolesState != listValueStateKnown || len(memberRoles) == 0 {
+		return
+	}
+
+	roles, err := listAllRoles(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list roles to check member_roles for cycles, got error: %s", err))
+		return
+	}
+
+	roleID := plan.ID.ValueString()
+	cycle, names := roleMembershipCycle(roles, roleID, func([]string) []string { return memberRoles })
+	names[roleID] = plan.Name.ValueString()
+
+	if cycle != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("member_roles"),
+			"Role Inheritance Cycle",
+			fmt.Sprintf("member_roles would make the role inherit from itself: %s.", formatMembershipCycle(cycle, names)),
+		)
+	}
+}
+
+// roleMembershipCycle returns the cycle, if any, that the planned member roles
+// of roleID would create, with role names for display. planned maps the role's
+// current member roles to the planned ones.
+func roleMembershipCycle(roles []client.Role, roleID string, planned func(current []string) []string) ([]string, map[string]string) {
+	edges := make(map[string][]string, len(roles)+1)
+	names := make(map[string]string, len(roles)+1)
+	for _, role := range roles {
+		if role.DeletedAt != "" {
+			continue
+		}
+		edges[role.ID] = role.MemberRoles
+		names[role.ID] = role.Name
+	}
+
+	edges[roleID] = planned(slices.Clone(edges[roleID]))
+
+	return findMembershipCycle(roleID, edges), names
+}
+
+// UpgradeState implements resource.ResourceWithUpgradeState by converting
+// member_permissions from a list of names to a set of permission objects.
+func (r *RoleResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":          schema.StringAttribute{Computed: true},
+					"name":        schema.StringAttribute{Required: true},
+					"org_id":      schema.StringAttribute{Computed: true},
+					"description": schema.StringAttribute{Optional: true},
+					"member_permissions": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"member_roles": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"created": schema.StringAttribute{Computed: true},
+					"user_id": schema.StringAttribute{Computed: true},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior roleResourceModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				upgraded, diags := upgradeRoleResourceModelV0(ctx, prior)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, &upgraded)...)
+			},
+		},
+	}
+}
+
 // ImportState implements resource.ResourceWithImportState by importing a role by ID.
 func (r *RoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// listAllRoles pages through every role visible to the organization.
+func listAllRoles(ctx context.Context, c *client.Client) ([]client.Role, error) {
+	var roles []client.Role
+
+	startingAfter := ""
+	for {
+		listResp, err := c.ListRoles(ctx, &client.ListRolesOptions{
+			StartingAfter: startingAfter,
+			Limit:         rolePageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		roles = append(roles, listResp.Roles...)
+
+		// Exit loop if no more pages
+		if len(listResp.Roles) < rolePageSize {
+			break
+		}
+		startingAfter = listResp.Roles[len(listResp.Roles)-1].ID
+	}
+
+	return roles, nil
+}
+
+func upgradeRoleResourceModelV0(ctx context.Context, prior roleResourceModelV0) (RoleResourceModel, diag.Diagnostics) {
+	upgraded := RoleResourceModel{
+		ID:                prior.ID,
+		Name:              prior.Name,
+		OrgID:             prior.OrgID,
+		Description:       prior.Description,
+		MemberPermissions: types.SetNull(types.ObjectType{AttrTypes: roleMemberPermissionAttributeTypes}),
+		MemberRoles:       prior.MemberRoles,
+		Created:           prior.Created,
+		UserID:            prior.UserID,
+	}
+
+	permissions, permissionsState, diags := listToStringSliceWithState(ctx, prior.MemberPermissions)
+	if diags.HasError() || permissionsState != listValueStateKnown {
+		return upgraded, diags
+	}
+
+	memberPermissions, setDiags := roleMemberPermissionsToSet(ctx, roleMemberPermissionsFromStrings(permissions))
+	diags.Append(setDiags...)
+	upgraded.MemberPermissions = memberPermissions
+
+	return upgraded, diags
+}
+
 func listToStringSlice(ctx context.Context, values types.List) ([]string, diag.Diagnostics) {
 	result, _, diags := listToStringSliceWithState(ctx, values)
 	return result, diags
@@ -295,7 +524,7 @@ func buildRoleCreateRequest(ctx context.Context, data RoleResourceModel) (*clien
 		Description: data.Description.ValueString(),
 	}
 
-	memberPermissions, memberPermissionsState, diags := listToStringSliceWithState(ctx, data.MemberPermissions)
+	memberPermissions, memberPermissionsState, diags := roleMemberPermissionsFromSet(ctx, data.MemberPermissions)
 	if diags.HasError() {
 		return nil, diags
 	}
@@ -307,7 +536,7 @@ func buildRoleCreateRequest(ctx context.Context, data RoleResourceModel) (*clien
 	}
 
 	if memberPermissionsState == listValueStateKnown {
-		createReq.MemberPermissions = roleMemberPermissionsFromStrings(memberPermissions)
+		createReq.MemberPermissions = memberPermissions
 	}
 	if memberRolesState == listValueStateKnown {
 		createReq.MemberRoles = memberRoles
@@ -366,27 +595,40 @@ func populateRoleState(ctx context.Context, data *RoleResourceModel, role *clien
 		data.UserID = types.StringNull()
 	}
 
-	if role.MemberPermissions != nil {
-		memberPermissions, listDiags := listFromStringSlice(ctx, roleMemberPermissionStrings(role.MemberPermissions))
-		diags.Append(listDiags...)
+	// Keep a configured empty set or list as-is so `[]` does not read back as null.
+	if role.MemberPermissions != nil && len(role.MemberPermissions) == 0 && isKnownNonNull(data.MemberPermissions) {
+		data.MemberPermissions = types.SetValueMust(types.ObjectType{AttrTypes: roleMemberPermissionAttributeTypes}, []attr.Value{})
+	} else if role.MemberPermissions != nil {
+		memberPermissions, setDiags := roleMemberPermissionsToSet(ctx, role.MemberPermissions)
+		diags.Append(setDiags...)
 		if diags.HasError() {
 			return diags
 		}
 		data.MemberPermissions = memberPermissions
+	} else if data.MemberPermissions.IsUnknown() {
+		data.MemberPermissions = types.SetNull(types.ObjectType{AttrTypes: roleMemberPermissionAttributeTypes})
 	}
 
-	if role.MemberRoles != nil {
+	if role.MemberRoles != nil && len(role.MemberRoles) == 0 && isKnownNonNull(data.MemberRoles) {
+		data.MemberRoles = types.ListValueMust(types.StringType, []attr.Value{})
+	} else if role.MemberRoles != nil {
 		memberRoles, listDiags := listFromStringSlice(ctx, role.MemberRoles)
 		diags.Append(listDiags...)
 		if diags.HasError() {
 			return diags
 		}
 		data.MemberRoles = memberRoles
+	} else if data.MemberRoles.IsUnknown() {
+		data.MemberRoles = types.ListNull(types.StringType)
 	}
 
 	return diags
 }
 
+func isKnownNonNull(value attr.Value) bool {
+	return !value.IsNull() && !value.IsUnknown()
+}
+
 func computeStringSliceDiff(current []string, desired []string) ([]string, []string) {
 	currentSet := make(map[string]struct{}, len(current))
 	for _, value := range current {
@@ -453,3 +695,89 @@ func roleMemberPermissionStrings(memberPermissions []client.RoleMemberPermission
 
 	return permissions
 }
+
+// roleMemberPermissionsFromSet converts member_permissions to API permissions,
+// skipping elements whose permission is not known yet.
+func roleMemberPermissionsFromSet(ctx context.Context, values types.Set) ([]client.RoleMemberPermission, listValueState, diag.Diagnostics) {
+	if values.IsNull() {
+		return nil, listValueStateNull, nil
+	}
+	if values.IsUnknown() {
+		return nil, listValueStateUnknown, nil
+	}
+
+	var models []roleMemberPermissionModel
+	diags := values.ElementsAs(ctx, &models, false)
+	if diags.HasError() {
+		return nil, listValueStateKnown, diags
+	}
+
+	result := make([]client.RoleMemberPermission, 0, len(models))
+	for _, model := range models {
+		if model.Permission.IsNull() || model.Permission.IsUnknown() {
+			continue
+		}
+		result = append(result, client.RoleMemberPermission{
+			Permission:         model.Permission.ValueString(),
+			RestrictObjectType: model.RestrictObjectType.ValueString(),
+		})
+	}
+
+	return result, listValueStateKnown, diags
+}
+
+func roleMemberPermissionsToSet(ctx context.Context, memberPermissions []client.RoleMemberPermission) (types.Set, diag.Diagnostics) {
+	elementType := types.ObjectType{AttrTypes: roleMemberPermissionAttributeTypes}
+	if len(memberPermissions) == 0 {
+		return types.SetNull(elementType), nil
+	}
+
+	models := make([]roleMemberPermissionModel, 0, len(memberPermissions))
+	for _, memberPermission := range memberPermissions {
+		if memberPermission.Permission == "" {
+			continue
+		}
+		models = append(models, roleMemberPermissionModel{
+			Permission:         types.StringValue(memberPermission.Permission),
+			RestrictObjectType: stringOrNull(memberPermission.RestrictObjectType),
+		})
+	}
+
+	return types.SetValueFrom(ctx, elementType, models)
+}
+
+func diffRoleMemberPermissions(current, desired []client.RoleMemberPermission) ([]client.RoleMemberPermission, []client.RoleMemberPermission) {
+	currentSet := make(map[client.RoleMemberPermission]struct{}, len(current))
+	for _, value := range current {
+		currentSet[value] = struct{}{}
+	}
+
+	desiredSet := make(map[client.RoleMemberPermission]struct{}, len(desired))
+	for _, value := range desired {
+		desiredSet[value] = struct{}{}
+	}
+
+	var additions []client.RoleMemberPermission
+	for _, value := range desired {
+		if _, exists := currentSet[value]; !exists {
+			additions = append(additions, value)
+		}
+	}
+
+	var removals []client.RoleMemberPermission
+	for _, value := range current {
+		if _, exists := desiredSet[value]; !exists {
+			removals = append(removals, value)
+		}
+	}
+
+	return additions, removals
+}
+
+func diffRoleMemberPermissionsForDesiredState(current, desired []client.RoleMemberPermission, desiredState listValueState) ([]client.RoleMemberPermission, []client.RoleMemberPermission) {
+	if desiredState == listValueStateUnknown {
+		return nil, nil
+	}
+
+	return diffRoleMemberPermissions(current, desired)
+}
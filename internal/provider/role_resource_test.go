@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -51,10 +52,27 @@ func TestAccRoleResource(t *testing.T) {
 	})
 }
 
+func TestAccRoleResource_MemberRolesCycle(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleResourceConfigCycle(false),
+			},
+			{
+				Config:      testAccRoleResourceConfigCycle(true),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Role Inheritance Cycle`),
+			},
+		},
+	})
+}
+
 func testAccRoleResourceConfig(name, description string, memberPermissions []string) string {
-	quotedPermissions := make([]string, 0, len(memberPermissions))
+	permissionObjects := make([]string, 0, len(memberPermissions))
 	for _, permission := range memberPermissions {
-		quotedPermissions = append(quotedPermissions, fmt.Sprintf("%q", permission))
+		permissionObjects = append(permissionObjects, fmt.Sprintf("{ permission = %q }", permission))
 	}
 
 	return fmt.Sprintf(`
@@ -63,7 +81,7 @@ resource "braintrustdata_role" "test" {
   description = %[2]q
   member_permissions = [%[3]s]
 }
-`, name, description, strings.Join(quotedPermissions, ", "))
+`, name, description, strings.Join(permissionObjects, ", "))
 }
 
 func testAccRoleResourceConfigWithMemberRole() string {
@@ -71,14 +89,43 @@ func testAccRoleResourceConfigWithMemberRole() string {
 resource "braintrustdata_role" "member" {
   name        = "test-member-role"
   description = "Role used as member role"
-  member_permissions = ["read"]
+  member_permissions = [{ permission = "read" }]
 }
 
 resource "braintrustdata_role" "test" {
   name        = "test-role"
   description = "Updated Description"
-  member_permissions = ["delete"]
+  member_permissions = [{ permission = "delete" }]
   member_roles = [braintrustdata_role.member.id]
 }
 `
 }
+
+// testAccRoleResourceConfigCycle makes role b inherit from role a. With
+// closeCycle set, role b is looked up by name and added to role a's
+// member_roles, which avoids a Terraform dependency cycle.
+func testAccRoleResourceConfigCycle(closeCycle bool) string {
+	memberRoles := "[]"
+	lookup := ""
+	if closeCycle {
+		memberRoles = "[data.braintrustdata_role.b.id]"
+		lookup = `
+data "braintrustdata_role" "b" {
+  name = "test-role-cycle-b"
+}
+`
+	}
+
+	return fmt.Sprintf(`
+resource "braintrustdata_role" "a" {
+  name               = "test-role-cycle-a"
+  member_permissions = [{ permission = "read" }]
+  member_roles       = %s
+}
+
+resource "braintrustdata_role" "b" {
+  name         = "test-role-cycle-b"
+  member_roles = [braintrustdata_role.a.id]
+}
+%s`, memberRoles, lookup)
+}
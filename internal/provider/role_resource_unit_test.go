@@ -252,11 +252,13 @@ func TestBuildRoleCreateRequest(t *testing.T) {
 			data: RoleResourceModel{
 				Name:        types.StringValue("role-1"),
 				Description: types.StringValue("desc"),
-				MemberPermissions: types.ListValueMust(types.StringType, []attr.Value{
-					types.StringValue("read"),
-					types.StringNull(),
-					types.StringUnknown(),
-					types.StringValue("update"),
+				MemberPermissions: types.SetValueMust(roleMemberPermissionObjectType, []attr.Value{
+					roleMemberPermissionValue("read", ""),
+					roleMemberPermissionValue("update", "project"),
+					types.ObjectValueMust(roleMemberPermissionAttributeTypes, map[string]attr.Value{
+						"permission":           types.StringUnknown(),
+						"restrict_object_type": types.StringNull(),
+					}),
 				}),
 				MemberRoles: types.ListValueMust(types.StringType, []attr.Value{
 					types.StringValue("role-a"),
@@ -267,7 +269,7 @@ func TestBuildRoleCreateRequest(t *testing.T) {
 				Description: "desc",
 				MemberPermissions: []client.RoleMemberPermission{
 					{Permission: "read"},
-					{Permission: "update"},
+					{Permission: "update", RestrictObjectType: "project"},
 				},
 				MemberRoles: []string{"role-a"},
 			},
@@ -277,7 +279,7 @@ func TestBuildRoleCreateRequest(t *testing.T) {
 			data: RoleResourceModel{
 				Name:              types.StringValue("role-2"),
 				Description:       types.StringValue("desc"),
-				MemberPermissions: types.ListUnknown(types.StringType),
+				MemberPermissions: types.SetUnknown(roleMemberPermissionObjectType),
 				MemberRoles:       types.ListUnknown(types.StringType),
 			},
 			want: &client.CreateRoleRequest{
@@ -290,7 +292,7 @@ func TestBuildRoleCreateRequest(t *testing.T) {
 			data: RoleResourceModel{
 				Name:              types.StringValue("role-3"),
 				Description:       types.StringValue("desc"),
-				MemberPermissions: types.ListValueMust(types.StringType, []attr.Value{}),
+				MemberPermissions: types.SetValueMust(roleMemberPermissionObjectType, []attr.Value{}),
 				MemberRoles:       types.ListValueMust(types.StringType, []attr.Value{}),
 			},
 			want: &client.CreateRoleRequest{
@@ -306,7 +308,7 @@ func TestBuildRoleCreateRequest(t *testing.T) {
 				Name:        types.StringValue("role-4"),
 				Description: types.StringValue("desc"),
 				// Null list means "unset" and should be omitted from payload.
-				MemberPermissions: types.ListNull(types.StringType),
+				MemberPermissions: types.SetNull(roleMemberPermissionObjectType),
 				// Known list with only null/unknown elements is known-empty after filtering.
 				MemberRoles: types.ListValueMust(types.StringType, []attr.Value{
 					types.StringNull(),
@@ -401,8 +403,8 @@ func TestPopulateRoleStatePreservesExistingMembershipWhenResponseOmitsFields(t *
 
 	ctx := context.Background()
 	data := RoleResourceModel{
-		MemberPermissions: types.ListValueMust(types.StringType, []attr.Value{
-			types.StringValue("perm.keep"),
+		MemberPermissions: types.SetValueMust(roleMemberPermissionObjectType, []attr.Value{
+			roleMemberPermissionValue("read", ""),
 		}),
 		MemberRoles: types.ListValueMust(types.StringType, []attr.Value{
 			types.StringValue("role-keep"),
@@ -425,12 +427,13 @@ func TestPopulateRoleStatePreservesExistingMembershipWhenResponseOmitsFields(t *
 		t.Fatalf("populateRoleState() unexpected diagnostics: %v", diags)
 	}
 
-	gotPermissions, permDiags := listToStringSlice(ctx, data.MemberPermissions)
+	gotPermissions, _, permDiags := roleMemberPermissionsFromSet(ctx, data.MemberPermissions)
 	if permDiags.HasError() {
-		t.Fatalf("listToStringSlice(member_permissions) unexpected diagnostics: %v", permDiags)
+		t.Fatalf("roleMemberPermissionsFromSet(member_permissions) unexpected diagnostics: %v", permDiags)
 	}
-	if !reflect.DeepEqual(gotPermissions, []string{"perm.keep"}) {
-		t.Fatalf("member_permissions changed unexpectedly: got=%v want=%v", gotPermissions, []string{"perm.keep"})
+	wantPermissions := []client.RoleMemberPermission{{Permission: "read"}}
+	if !reflect.DeepEqual(gotPermissions, wantPermissions) {
+		t.Fatalf("member_permissions changed unexpectedly: got=%v want=%v", gotPermissions, wantPermissions)
 	}
 
 	gotRoles, roleDiags := listToStringSlice(ctx, data.MemberRoles)
@@ -447,8 +450,8 @@ func TestPopulateRoleStateOverwritesMembershipWhenResponseIncludesFields(t *test
 
 	ctx := context.Background()
 	data := RoleResourceModel{
-		MemberPermissions: types.ListValueMust(types.StringType, []attr.Value{
-			types.StringValue("perm.old"),
+		MemberPermissions: types.SetValueMust(roleMemberPermissionObjectType, []attr.Value{
+			roleMemberPermissionValue("delete", ""),
 		}),
 		MemberRoles: types.ListValueMust(types.StringType, []attr.Value{
 			types.StringValue("role-old"),
@@ -462,8 +465,8 @@ func TestPopulateRoleStateOverwritesMembershipWhenResponseIncludesFields(t *test
 		OrgID:       "org-id",
 		Created:     "created-at",
 		MemberPermissions: []client.RoleMemberPermission{
-			{Permission: "perm.new.a"},
-			{Permission: "perm.new.b"},
+			{Permission: "read"},
+			{Permission: "update", RestrictObjectType: "dataset"},
 		},
 		MemberRoles: []string{"role-new"},
 	}
@@ -473,12 +476,16 @@ func TestPopulateRoleStateOverwritesMembershipWhenResponseIncludesFields(t *test
 		t.Fatalf("populateRoleState() unexpected diagnostics: %v", diags)
 	}
 
-	gotPermissions, permDiags := listToStringSlice(ctx, data.MemberPermissions)
+	gotPermissions, _, permDiags := roleMemberPermissionsFromSet(ctx, data.MemberPermissions)
 	if permDiags.HasError() {
-		t.Fatalf("listToStringSlice(member_permissions) unexpected diagnostics: %v", permDiags)
+		t.Fatalf("roleMemberPermissionsFromSet(member_permissions) unexpected diagnostics: %v", permDiags)
+	}
+	wantPermissions := []client.RoleMemberPermission{
+		{Permission: "read"},
+		{Permission: "update", RestrictObjectType: "dataset"},
 	}
-	if !reflect.DeepEqual(gotPermissions, []string{"perm.new.a", "perm.new.b"}) {
-		t.Fatalf("member_permissions mismatch: got=%v want=%v", gotPermissions, []string{"perm.new.a", "perm.new.b"})
+	if !reflect.DeepEqual(gotPermissions, wantPermissions) {
+		t.Fatalf("member_permissions mismatch: got=%v want=%v", gotPermissions, wantPermissions)
 	}
 
 	gotRoles, roleDiags := listToStringSlice(ctx, data.MemberRoles)
@@ -489,3 +496,184 @@ func TestPopulateRoleStateOverwritesMembershipWhenResponseIncludesFields(t *test
 		t.Fatalf("member_roles mismatch: got=%v want=%v", gotRoles, []string{"role-new"})
 	}
 }
+
+func TestPopulateRoleStateResolvesUnknownMembershipWhenResponseOmitsFields(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	data := RoleResourceModel{
+		MemberPermissions: types.SetUnknown(roleMemberPermissionObjectType),
+		MemberRoles:       types.ListUnknown(types.StringType),
+	}
+
+	diags := populateRoleState(ctx, &data, &client.Role{ID: "role-id", Name: "role-name"})
+	if diags.HasError() {
+		t.Fatalf("populateRoleState() unexpected diagnostics: %v", diags)
+	}
+
+	if !data.MemberPermissions.IsNull() {
+		t.Fatalf("member_permissions should be null, got=%v", data.MemberPermissions)
+	}
+	if !data.MemberRoles.IsNull() {
+		t.Fatalf("member_roles should be null, got=%v", data.MemberRoles)
+	}
+}
+
+func TestDiffRoleMemberPermissions(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		current []client.RoleMemberPermission
+		desired []client.RoleMemberPermission
+		wantAdd []client.RoleMemberPermission
+		wantDel []client.RoleMemberPermission
+	}{
+		{
+			name:    "no_changes",
+			current: []client.RoleMemberPermission{{Permission: "read"}},
+			desired: []client.RoleMemberPermission{{Permission: "read"}},
+		},
+		{
+			name:    "restriction_change_is_remove_and_add",
+			current: []client.RoleMemberPermission{{Permission: "read"}},
+			desired: []client.RoleMemberPermission{{Permission: "read", RestrictObjectType: "project"}},
+			wantAdd: []client.RoleMemberPermission{{Permission: "read", RestrictObjectType: "project"}},
+			wantDel: []client.RoleMemberPermission{{Permission: "read"}},
+		},
+		{
+			name:    "removes_all_when_desired_empty",
+			current: []client.RoleMemberPermission{{Permission: "read"}, {Permission: "update"}},
+			desired: nil,
+			wantDel: []client.RoleMemberPermission{{Permission: "read"}, {Permission: "update"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotAdd, gotDel := diffRoleMemberPermissions(tc.current, tc.desired)
+			if !reflect.DeepEqual(gotAdd, tc.wantAdd) {
+				t.Fatalf("diffRoleMemberPermissions() add mismatch: got=%v want=%v", gotAdd, tc.wantAdd)
+			}
+			if !reflect.DeepEqual(gotDel, tc.wantDel) {
+				t.Fatalf("diffRoleMemberPermissions() remove mismatch: got=%v want=%v", gotDel, tc.wantDel)
+			}
+		})
+	}
+}
+
+func TestDiffRoleMemberPermissionsForDesiredStateSkipsUnknown(t *testing.T) {
+	t.Parallel()
+
+	gotAdd, gotDel := diffRoleMemberPermissionsForDesiredState(
+		[]client.RoleMemberPermission{{Permission: "read"}},
+		nil,
+		listValueStateUnknown,
+	)
+	if gotAdd != nil || gotDel != nil {
+		t.Fatalf("diffRoleMemberPermissionsForDesiredState() should skip unknown: add=%v remove=%v", gotAdd, gotDel)
+	}
+}
+
+func TestUpgradeRoleResourceModelV0(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := []struct {
+		prior    roleResourceModelV0
+		name     string
+		want     []client.RoleMemberPermission
+		wantNull bool
+	}{
+		{
+			name: "permission_names_become_objects",
+			prior: roleResourceModelV0{
+				ID: types.StringValue("role-id"),
+				MemberPermissions: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("read"),
+					types.StringValue("update"),
+				}),
+				MemberRoles: types.ListNull(types.StringType),
+			},
+			want: []client.RoleMemberPermission{{Permission: "read"}, {Permission: "update"}},
+		},
+		{
+			name: "null_permissions_stay_null",
+			prior: roleResourceModelV0{
+				ID:                types.StringValue("role-id"),
+				MemberPermissions: types.ListNull(types.StringType),
+				MemberRoles:       types.ListNull(types.StringType),
+			},
+			wantNull: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := upgradeRoleResourceModelV0(ctx, tc.prior)
+			if diags.HasError() {
+				t.Fatalf("upgradeRoleResourceModelV0() unexpected diagnostics: %v", diags)
+			}
+			if got.ID != tc.prior.ID {
+				t.Fatalf("id mismatch: got=%v want=%v", got.ID, tc.prior.ID)
+			}
+			if got.MemberPermissions.IsNull() != tc.wantNull {
+				t.Fatalf("member_permissions null mismatch: got=%v want=%v", got.MemberPermissions.IsNull(), tc.wantNull)
+			}
+
+			gotPermissions, _, permDiags := roleMemberPermissionsFromSet(ctx, got.MemberPermissions)
+			if permDiags.HasError() {
+				t.Fatalf("roleMemberPermissionsFromSet() unexpected diagnostics: %v", permDiags)
+			}
+			if !tc.wantNull && !reflect.DeepEqual(gotPermissions, tc.want) {
+				t.Fatalf("member_permissions mismatch: got=%v want=%v", gotPermissions, tc.want)
+			}
+		})
+	}
+}
+
+var roleMemberPermissionObjectType = types.ObjectType{AttrTypes: roleMemberPermissionAttributeTypes}
+
+func roleMemberPermissionValue(permission, restrictObjectType string) attr.Value {
+	return types.ObjectValueMust(roleMemberPermissionAttributeTypes, map[string]attr.Value{
+		"permission":           types.StringValue(permission),
+		"restrict_object_type": stringOrNull(restrictObjectType),
+	})
+}
+
+func TestRoleMembershipCycle(t *testing.T) {
+	t.Parallel()
+
+	roles := []client.Role{
+		{ID: "role-a", Name: "A", MemberRoles: []string{"role-b"}},
+		{ID: "role-b", Name: "B"},
+		{ID: "role-c", Name: "C", MemberRoles: []string{"role-a"}, DeletedAt: "2024-01-01T00:00:00Z"},
+	}
+	setMembers := func(memberRoles ...string) func([]string) []string {
+		return func([]string) []string { return memberRoles }
+	}
+
+	cycle, names := roleMembershipCycle(roles, "role-b", setMembers("role-a"))
+	if want := []string{"role-b", "role-a", "role-b"}; !reflect.DeepEqual(cycle, want) {
+		t.Fatalf("got cycle %v, want %v", cycle, want)
+	}
+	if names["role-a"] != "A" {
+		t.Fatalf("expected role names to be returned, got %v", names)
+	}
+
+	if cycle, _ := roleMembershipCycle(roles, "role-a", setMembers("role-b", "role-c")); cycle != nil {
+		t.Fatalf("expected deleted roles to be ignored, got cycle %v", cycle)
+	}
+
+	// Replacing the member roles drops the edge that closed the cycle.
+	if cycle, _ := roleMembershipCycle(roles, "role-a", setMembers()); cycle != nil {
+		t.Fatalf("expected no cycle, got %v", cycle)
+	}
+}
@@ -32,13 +32,13 @@ func testAccRolesDataSourceConfigWithRoleNameFilter() string {
 resource "braintrustdata_role" "other" {
   name               = "test-roles-ds-other"
   description        = "Role for roles data source filter test"
-  member_permissions = ["update"]
+  member_permissions = [{ permission = "update" }]
 }
 
 resource "braintrustdata_role" "target" {
   name               = "test-roles-ds-filtered"
   description        = "Target role for roles data source filter test"
-  member_permissions = ["read"]
+  member_permissions = [{ permission = "read" }]
 }
 
 data "braintrustdata_roles" "test" {
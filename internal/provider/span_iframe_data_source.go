@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &SpanIframeDataSource{}
+
+var (
+	errSpanIframeNotFoundByName       = errors.New("span iframe not found by name")
+	errMultipleSpanIframesFoundByName = errors.New("multiple span iframes found by name")
+)
+
+// NewSpanIframeDataSource creates a new span iframe data source instance.
+func NewSpanIframeDataSource() datasource.DataSource {
+	return &SpanIframeDataSource{}
+}
+
+// SpanIframeDataSource defines the data source implementation.
+type SpanIframeDataSource struct {
+	client *client.Client
+}
+
+// SpanIframeDataSourceModel describes the data source data model.
+type SpanIframeDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	ProjectID   types.String `tfsdk:"project_id"`
+	ProjectName types.String `tfsdk:"project_name"`
+	OrgName     types.String `tfsdk:"org_name"`
+	URL         types.String `tfsdk:"url"`
+	Description types.String `tfsdk:"description"`
+	UserID      types.String `tfsdk:"user_id"`
+	Created     types.String `tfsdk:"created"`
+	PostMessage types.Bool   `tfsdk:"post_message"`
+}
+
+// Metadata implements datasource.DataSource.
+func (d *SpanIframeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_span_iframe"
+}
+
+// Schema implements datasource.DataSource.
+func (d *SpanIframeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads a Braintrust span iframe by `id` or by API-native searchable attributes (`name`, optionally `project_id`, `project_name`, `org_name`).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the span iframe. Specify either `id` or `name`.",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The span iframe name. Can be used as a searchable attribute when `id` is not provided.",
+			},
+			"project_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Optional project ID filter applied during searchable lookups.",
+			},
+			"project_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional project name filter applied during searchable lookups.",
+			},
+			"org_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional organization name filter applied during searchable lookups.",
+			},
+			"url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The URL embedded in the trace viewer.",
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The span iframe description.",
+			},
+			"post_message": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether span data is sent to the iframe with `postMessage` instead of query parameters.",
+			},
+			"user_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the user who created the span iframe.",
+			},
+			"created": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The timestamp when the span iframe was created.",
+			},
+		},
+	}
+}
+
+// Configure implements datasource.DataSource.
+func (d *SpanIframeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SpanIframeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SpanIframeDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasID := !data.ID.IsNull() && data.ID.ValueString() != ""
+	hasName := !data.Name.IsNull() && data.Name.ValueString() != ""
+	hasProjectID := !data.ProjectID.IsNull() && data.ProjectID.ValueString() != ""
+	hasProjectName := !data.ProjectName.IsNull() && data.ProjectName.ValueString() != ""
+	hasOrgName := !data.OrgName.IsNull() && data.OrgName.ValueString() != ""
+
+	if !hasID && !hasName {
+		resp.Diagnostics.AddError(
+			"Missing Required Attribute",
+			"Must specify either 'id' or 'name' to look up the span iframe.",
+		)
+		return
+	}
+
+	if hasID && (hasName || hasProjectID || hasProjectName || hasOrgName) {
+		resp.Diagnostics.AddError(
+			"Conflicting Attributes",
+			"Cannot combine 'id' with searchable attributes ('name', 'project_id', 'project_name', 'org_name').",
+		)
+		return
+	}
+
+	var spanIframe *client.SpanIframe
+	if hasID {
+		fetchedSpanIframe, err := d.client.GetSpanIframe(ctx, data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Span Iframe",
+				fmt.Sprintf("Could not read span iframe ID %s: %s", data.ID.ValueString(), err.Error()),
+			)
+			return
+		}
+		spanIframe = fetchedSpanIframe
+	} else {
+		listOpts := &client.ListSpanIframesOptions{
+			SpanIframeName: data.Name.ValueString(),
+			Limit:          2,
+		}
+		if hasProjectID {
+			listOpts.ProjectID = data.ProjectID.ValueString()
+		}
+		if hasProjectName {
+			listOpts.ProjectName = data.ProjectName.ValueString()
+		}
+		if hasOrgName {
+			listOpts.OrgName = data.OrgName.ValueString()
+		}
+
+		listResp, err := d.client.ListSpanIframes(ctx, listOpts)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Listing Span Iframes",
+				fmt.Sprintf("Could not list span iframes using the provided searchable attributes: %s", err.Error()),
+			)
+			return
+		}
+
+		selectedSpanIframe, err := selectSingleSpanIframeByName(listResp.SpanIframes, data.Name.ValueString())
+		if errors.Is(err, errSpanIframeNotFoundByName) {
+			resp.Diagnostics.AddError(
+				"Span Iframe Not Found",
+				fmt.Sprintf("No span iframe found with name: %s", data.Name.ValueString()),
+			)
+			return
+		}
+		if errors.Is(err, errMultipleSpanIframesFoundByName) {
+			resp.Diagnostics.AddError(
+				"Multiple Span Iframes Found",
+				"Searchable attributes matched multiple span iframes. Refine the query or use 'id' for deterministic lookup.",
+			)
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Listing Span Iframes",
+				fmt.Sprintf("Could not resolve span iframe using the provided searchable attributes: %s", err.Error()),
+			)
+			return
+		}
+
+		spanIframe = selectedSpanIframe
+	}
+
+	populateSpanIframeDataSourceModel(&data, spanIframe)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func selectSingleSpanIframeByName(spanIframes []client.SpanIframe, name string) (*client.SpanIframe, error) {
+	var selected *client.SpanIframe
+
+	for i := range spanIframes {
+		spanIframe := &spanIframes[i]
+		if spanIframe.Name != name {
+			continue
+		}
+		if selected != nil {
+			return nil, fmt.Errorf("%w: %s", errMultipleSpanIframesFoundByName, name)
+		}
+		selected = spanIframe
+	}
+
+	if selected == nil {
+		return nil, fmt.Errorf("%w: %s", errSpanIframeNotFoundByName, name)
+	}
+
+	return selected, nil
+}
+
+func populateSpanIframeDataSourceModel(data *SpanIframeDataSourceModel, spanIframe *client.SpanIframe) {
+	var resourceModel SpanIframeResourceModel
+	setSpanIframeResourceModel(&resourceModel, spanIframe)
+
+	data.ID = resourceModel.ID
+	data.Name = resourceModel.Name
+	data.ProjectID = resourceModel.ProjectID
+	data.URL = resourceModel.URL
+	data.Description = resourceModel.Description
+	data.PostMessage = resourceModel.PostMessage
+	data.UserID = resourceModel.UserID
+	data.Created = resourceModel.Created
+}
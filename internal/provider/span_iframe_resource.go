@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &SpanIframeResource{}
+var _ resource.ResourceWithImportState = &SpanIframeResource{}
+
+// NewSpanIframeResource creates a new span iframe resource instance.
+func NewSpanIframeResource() resource.Resource {
+	return &SpanIframeResource{}
+}
+
+// SpanIframeResource defines the resource implementation.
+type SpanIframeResource struct {
+	client *client.Client
+}
+
+// SpanIframeResourceModel describes the resource data model.
+type SpanIframeResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	ProjectID   types.String `tfsdk:"project_id"`
+	Name        types.String `tfsdk:"name"`
+	URL         types.String `tfsdk:"url"`
+	Description types.String `tfsdk:"description"`
+	UserID      types.String `tfsdk:"user_id"`
+	Created     types.String `tfsdk:"created"`
+	PostMessage types.Bool   `tfsdk:"post_message"`
+}
+
+// Metadata implements resource.Resource.
+func (r *SpanIframeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_span_iframe"
+}
+
+// Schema implements resource.Resource.
+func (r *SpanIframeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Braintrust span iframe. Span iframes render spans of a project with a custom page embedded in the trace viewer.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the span iframe.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The project ID that owns the span iframe.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The span iframe name.",
+			},
+			"url": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The URL embedded in the trace viewer. Span data is passed to the page as query parameters unless `post_message` is set.",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The span iframe description.",
+			},
+			"post_message": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether span data is sent to the iframe with `postMessage` instead of query parameters. Defaults to false.",
+			},
+			"user_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the user who created the span iframe.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The timestamp when the span iframe was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure implements resource.Resource.
+func (r *SpanIframeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// Create implements resource.Resource.
+func (r *SpanIframeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SpanIframeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spanIframe, err := r.client.CreateSpanIframe(ctx, buildCreateSpanIframeRequest(data))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to create span iframe, got error: %s", err),
+		)
+		return
+	}
+
+	setSpanIframeResourceModel(&data, spanIframe)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read implements resource.Resource.
+func (r *SpanIframeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SpanIframeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spanIframe, err := r.client.GetSpanIframe(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read span iframe, got error: %s", err),
+		)
+		return
+	}
+
+	if spanIframe.DeletedAt != "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setSpanIframeResourceModel(&data, spanIframe)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource.
+func (r *SpanIframeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SpanIframeResourceModel
+	var state SpanIframeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq := buildUpdateSpanIframeRequest(plan, state)
+	if hasSpanIframeUpdateChanges(updateReq) {
+		if _, err := r.client.UpdateSpanIframe(ctx, state.ID.ValueString(), updateReq); err != nil {
+			if client.IsNotFound(err) {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to update span iframe, got error: %s", err),
+			)
+			return
+		}
+	}
+
+	spanIframe, err := r.client.GetSpanIframe(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read span iframe after update, got error: %s", err),
+		)
+		return
+	}
+
+	setSpanIframeResourceModel(&plan, spanIframe)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete implements resource.Resource.
+func (r *SpanIframeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SpanIframeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteSpanIframe(ctx, data.ID.ValueString()); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to delete span iframe, got error: %s", err),
+		)
+	}
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (r *SpanIframeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func buildCreateSpanIframeRequest(model SpanIframeResourceModel) *client.CreateSpanIframeRequest {
+	req := &client.CreateSpanIframeRequest{
+		ProjectID: model.ProjectID.ValueString(),
+		Name:      model.Name.ValueString(),
+		URL:       model.URL.ValueString(),
+	}
+	if !model.Description.IsNull() && !model.Description.IsUnknown() {
+		req.Description = model.Description.ValueString()
+	}
+	if !model.PostMessage.IsNull() && !model.PostMessage.IsUnknown() {
+		v := model.PostMessage.ValueBool()
+		req.PostMessage = &v
+	}
+
+	return req
+}
+
+func buildUpdateSpanIframeRequest(plan, state SpanIframeResourceModel) *client.UpdateSpanIframeRequest {
+	req := &client.UpdateSpanIframeRequest{}
+
+	if !plan.Name.IsUnknown() && !plan.Name.Equal(state.Name) {
+		v := plan.Name.ValueString()
+		req.Name = &v
+	}
+	if !plan.URL.IsUnknown() && !plan.URL.Equal(state.URL) {
+		v := plan.URL.ValueString()
+		req.URL = &v
+	}
+
+	req.Description = changedStringPointer(plan.Description, state.Description)
+
+	if !plan.PostMessage.IsUnknown() && !plan.PostMessage.IsNull() && !plan.PostMessage.Equal(state.PostMessage) {
+		v := plan.PostMessage.ValueBool()
+		req.PostMessage = &v
+	}
+
+	return req
+}
+
+func hasSpanIframeUpdateChanges(req *client.UpdateSpanIframeRequest) bool {
+	return req.Name != nil || req.URL != nil || req.Description != nil || req.PostMessage != nil
+}
+
+func setSpanIframeResourceModel(model *SpanIframeResourceModel, spanIframe *client.SpanIframe) {
+	model.ID = stringOrNull(spanIframe.ID)
+	model.ProjectID = stringOrNull(spanIframe.ProjectID)
+	model.Name = stringOrNull(spanIframe.Name)
+	model.URL = stringOrNull(spanIframe.URL)
+	model.Description = stringOrNull(spanIframe.Description)
+	model.UserID = stringOrNull(spanIframe.UserID)
+	model.Created = stringOrNull(spanIframe.Created)
+	model.PostMessage = types.BoolValue(spanIframe.PostMessage != nil && *spanIframe.PostMessage)
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSpanIframeResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpanIframeResourceConfig("test-span-iframe", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("braintrustdata_span_iframe.test", "name", "test-span-iframe"),
+					resource.TestCheckResourceAttr("braintrustdata_span_iframe.test", "url", "https://example.com/braintrust-viewer"),
+					resource.TestCheckResourceAttr("braintrustdata_span_iframe.test", "post_message", "false"),
+					resource.TestCheckResourceAttrSet("braintrustdata_span_iframe.test", "id"),
+					resource.TestCheckResourceAttrSet("braintrustdata_span_iframe.test", "created"),
+				),
+			},
+			{
+				Config: testAccSpanIframeResourceConfig("test-span-iframe-updated", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("braintrustdata_span_iframe.test", "name", "test-span-iframe-updated"),
+					resource.TestCheckResourceAttr("braintrustdata_span_iframe.test", "post_message", "true"),
+				),
+			},
+			{
+				ResourceName:      "braintrustdata_span_iframe.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSpanIframeDataSources(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpanIframeResourceConfig("test-span-iframe-lookup", false) + `
+data "braintrustdata_span_iframe" "by_name" {
+  name       = braintrustdata_span_iframe.test.name
+  project_id = braintrustdata_project.test.id
+}
+
+data "braintrustdata_span_iframes" "all" {
+  project_id = braintrustdata_project.test.id
+  depends_on = [braintrustdata_span_iframe.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.braintrustdata_span_iframe.by_name", "id", "braintrustdata_span_iframe.test", "id"),
+					resource.TestCheckResourceAttr("data.braintrustdata_span_iframe.by_name", "url", "https://example.com/braintrust-viewer"),
+					resource.TestCheckResourceAttr("data.braintrustdata_span_iframes.all", "span_iframes.#", "1"),
+					resource.TestCheckResourceAttrPair("data.braintrustdata_span_iframes.all", "ids.0", "braintrustdata_span_iframe.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSpanIframeResourceConfig(name string, postMessage bool) string {
+	return fmt.Sprintf(`
+resource "braintrustdata_project" "test" {
+  name = "test-project-for-span-iframe-resource"
+}
+
+resource "braintrustdata_span_iframe" "test" {
+  project_id   = braintrustdata_project.test.id
+  name         = %[1]q
+  description  = "Custom trace viewer"
+  url          = "https://example.com/braintrust-viewer"
+  post_message = %[2]t
+}
+`, name, postMessage)
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testSpanIframeModel() SpanIframeResourceModel {
+	return SpanIframeResourceModel{
+		ProjectID:   types.StringValue("project-123"),
+		Name:        types.StringValue("trace-viewer"),
+		URL:         types.StringValue("https://example.com/viewer"),
+		Description: types.StringNull(),
+		PostMessage: types.BoolValue(false),
+	}
+}
+
+func TestBuildCreateSpanIframeRequest(t *testing.T) {
+	t.Parallel()
+
+	model := testSpanIframeModel()
+	model.Description = types.StringValue("Custom trace viewer")
+
+	postMessage := false
+	want := &client.CreateSpanIframeRequest{
+		ProjectID:   "project-123",
+		Name:        "trace-viewer",
+		URL:         "https://example.com/viewer",
+		Description: "Custom trace viewer",
+		PostMessage: &postMessage,
+	}
+
+	req := buildCreateSpanIframeRequest(model)
+	if !reflect.DeepEqual(req, want) {
+		t.Fatalf("request mismatch: got=%#v want=%#v", req, want)
+	}
+}
+
+func TestBuildUpdateSpanIframeRequest(t *testing.T) {
+	t.Parallel()
+
+	state := testSpanIframeModel()
+	state.Description = types.StringValue("Old description")
+
+	testCases := []struct {
+		mutate          func(*SpanIframeResourceModel)
+		name            string
+		wantName        bool
+		wantURL         bool
+		wantDescription bool
+		wantPostMessage bool
+	}{
+		{
+			name:   "no_changes",
+			mutate: func(*SpanIframeResourceModel) {},
+		},
+		{
+			name: "name_only",
+			mutate: func(m *SpanIframeResourceModel) {
+				m.Name = types.StringValue("trace-viewer-renamed")
+			},
+			wantName: true,
+		},
+		{
+			name: "url_only",
+			mutate: func(m *SpanIframeResourceModel) {
+				m.URL = types.StringValue("https://example.com/other")
+			},
+			wantURL: true,
+		},
+		{
+			name: "null_description_clears",
+			mutate: func(m *SpanIframeResourceModel) {
+				m.Description = types.StringNull()
+			},
+			wantDescription: true,
+		},
+		{
+			name: "post_message_enabled",
+			mutate: func(m *SpanIframeResourceModel) {
+				m.PostMessage = types.BoolValue(true)
+			},
+			wantPostMessage: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			plan := state
+			tc.mutate(&plan)
+
+			req := buildUpdateSpanIframeRequest(plan, state)
+			if (req.Name != nil) != tc.wantName {
+				t.Fatalf("name presence mismatch: got=%v want=%v", req.Name != nil, tc.wantName)
+			}
+			if (req.URL != nil) != tc.wantURL {
+				t.Fatalf("url presence mismatch: got=%v want=%v", req.URL != nil, tc.wantURL)
+			}
+			if (req.Description != nil) != tc.wantDescription {
+				t.Fatalf("description presence mismatch: got=%v want=%v", req.Description != nil, tc.wantDescription)
+			}
+			if (req.PostMessage != nil) != tc.wantPostMessage {
+				t.Fatalf("post_message presence mismatch: got=%v want=%v", req.PostMessage != nil, tc.wantPostMessage)
+			}
+			if tc.wantDescription && *req.Description != "" {
+				t.Fatalf("expected description to be cleared, got %q", *req.Description)
+			}
+			if hasSpanIframeUpdateChanges(req) != (tc.wantName || tc.wantURL || tc.wantDescription || tc.wantPostMessage) {
+				t.Fatalf("hasSpanIframeUpdateChanges() mismatch for %+v", req)
+			}
+		})
+	}
+}
+
+func TestSetSpanIframeResourceModel(t *testing.T) {
+	t.Parallel()
+
+	postMessage := true
+
+	var model SpanIframeResourceModel
+	setSpanIframeResourceModel(&model, &client.SpanIframe{
+		ID:          "iframe-123",
+		ProjectID:   "project-123",
+		Name:        "trace-viewer",
+		URL:         "https://example.com/viewer",
+		UserID:      "user-123",
+		Created:     "2026-01-01T00:00:00Z",
+		PostMessage: &postMessage,
+	})
+
+	if model.ID.ValueString() != "iframe-123" {
+		t.Fatalf("expected id iframe-123, got %s", model.ID)
+	}
+	if !model.Description.IsNull() {
+		t.Fatalf("expected description to be null, got %s", model.Description)
+	}
+	if !model.PostMessage.ValueBool() {
+		t.Fatalf("expected post_message true, got %s", model.PostMessage)
+	}
+
+	setSpanIframeResourceModel(&model, &client.SpanIframe{ID: "iframe-123"})
+	if model.PostMessage.IsNull() || model.PostMessage.ValueBool() {
+		t.Fatalf("expected post_message to default to false, got %s", model.PostMessage)
+	}
+}
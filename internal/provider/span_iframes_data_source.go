@@ -0,0 +1,265 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &SpanIframesDataSource{}
+
+// NewSpanIframesDataSource creates a new span iframes data source instance.
+func NewSpanIframesDataSource() datasource.DataSource {
+	return &SpanIframesDataSource{}
+}
+
+// SpanIframesDataSource defines the data source implementation.
+type SpanIframesDataSource struct {
+	client *client.Client
+}
+
+// SpanIframesDataSourceModel describes the data source data model.
+type SpanIframesDataSourceModel struct {
+	FilterIDs      types.List                        `tfsdk:"filter_ids"`
+	OrgName        types.String                      `tfsdk:"org_name"`
+	ProjectID      types.String                      `tfsdk:"project_id"`
+	ProjectName    types.String                      `tfsdk:"project_name"`
+	SpanIframeName types.String                      `tfsdk:"span_iframe_name"`
+	StartingAfter  types.String                      `tfsdk:"starting_after"`
+	EndingBefore   types.String                      `tfsdk:"ending_before"`
+	SpanIframes    []SpanIframesDataSourceSpanIframe `tfsdk:"span_iframes"`
+	IDs            []string                          `tfsdk:"ids"`
+	Limit          types.Int64                       `tfsdk:"limit"`
+}
+
+// SpanIframesDataSourceSpanIframe represents a single span iframe in the list.
+type SpanIframesDataSourceSpanIframe struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	ProjectID   types.String `tfsdk:"project_id"`
+	URL         types.String `tfsdk:"url"`
+	Description types.String `tfsdk:"description"`
+	UserID      types.String `tfsdk:"user_id"`
+	Created     types.String `tfsdk:"created"`
+	PostMessage types.Bool   `tfsdk:"post_message"`
+}
+
+// Metadata implements datasource.DataSource.
+func (d *SpanIframesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_span_iframes"
+}
+
+// Schema implements datasource.DataSource.
+func (d *SpanIframesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists Braintrust span iframes using API-native filters.",
+		Attributes: map[string]schema.Attribute{
+			"filter_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Optional list of span iframe IDs to filter by. Maps to repeated `ids` query parameters.",
+			},
+			"org_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional organization name filter.",
+			},
+			"project_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional project ID filter.",
+			},
+			"project_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional project name filter.",
+			},
+			"span_iframe_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional exact span iframe name filter.",
+			},
+			"limit": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Optional max number of span iframes to return.",
+			},
+			"starting_after": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional pagination cursor to fetch span iframes after this ID.",
+			},
+			"ending_before": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional pagination cursor to fetch span iframes before this ID.",
+			},
+			"ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "List of returned span iframe IDs.",
+			},
+			"span_iframes": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of span iframes.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the span iframe.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the span iframe.",
+						},
+						"project_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The project ID that the span iframe belongs to.",
+						},
+						"url": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The URL embedded in the trace viewer.",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The span iframe description.",
+						},
+						"post_message": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether span data is sent to the iframe with `postMessage` instead of query parameters.",
+						},
+						"user_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the user who created the span iframe.",
+						},
+						"created": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The timestamp when the span iframe was created.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure implements datasource.DataSource.
+func (d *SpanIframesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SpanIframesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SpanIframesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listOpts, filterDiags := buildListSpanIframesOptions(ctx, data)
+	resp.Diagnostics.Append(filterDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listResp, err := d.client.ListSpanIframes(ctx, listOpts)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Span Iframes",
+			fmt.Sprintf("Could not list span iframes: %s", err.Error()),
+		)
+		return
+	}
+
+	data.SpanIframes = make([]SpanIframesDataSourceSpanIframe, 0, len(listResp.SpanIframes))
+	data.IDs = make([]string, 0, len(listResp.SpanIframes))
+
+	for i := range listResp.SpanIframes {
+		spanIframe := &listResp.SpanIframes[i]
+
+		var resourceModel SpanIframeResourceModel
+		setSpanIframeResourceModel(&resourceModel, spanIframe)
+
+		data.SpanIframes = append(data.SpanIframes, SpanIframesDataSourceSpanIframe{
+			ID:          resourceModel.ID,
+			Name:        resourceModel.Name,
+			ProjectID:   resourceModel.ProjectID,
+			URL:         resourceModel.URL,
+			Description: resourceModel.Description,
+			PostMessage: resourceModel.PostMessage,
+			UserID:      resourceModel.UserID,
+			Created:     resourceModel.Created,
+		})
+		data.IDs = append(data.IDs, spanIframe.ID)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func buildListSpanIframesOptions(ctx context.Context, data SpanIframesDataSourceModel) (*client.ListSpanIframesOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	hasStartingAfter := !data.StartingAfter.IsNull() && data.StartingAfter.ValueString() != ""
+	hasEndingBefore := !data.EndingBefore.IsNull() && data.EndingBefore.ValueString() != ""
+
+	if hasStartingAfter && hasEndingBefore {
+		diags.AddError("Conflicting Attributes", "Cannot specify both 'starting_after' and 'ending_before'.")
+		return nil, diags
+	}
+
+	listOpts := &client.ListSpanIframesOptions{}
+
+	if !data.FilterIDs.IsNull() {
+		var ids []string
+		diags.Append(data.FilterIDs.ElementsAs(ctx, &ids, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		listOpts.IDs = ids
+	}
+	if !data.OrgName.IsNull() && data.OrgName.ValueString() != "" {
+		listOpts.OrgName = data.OrgName.ValueString()
+	}
+	if !data.ProjectID.IsNull() && data.ProjectID.ValueString() != "" {
+		listOpts.ProjectID = data.ProjectID.ValueString()
+	}
+	if !data.ProjectName.IsNull() && data.ProjectName.ValueString() != "" {
+		listOpts.ProjectName = data.ProjectName.ValueString()
+	}
+	if !data.SpanIframeName.IsNull() && data.SpanIframeName.ValueString() != "" {
+		listOpts.SpanIframeName = data.SpanIframeName.ValueString()
+	}
+	if !data.Limit.IsNull() {
+		limit := data.Limit.ValueInt64()
+		if limit < 1 {
+			diags.AddError("Invalid Limit", "'limit' must be greater than or equal to 1.")
+			return nil, diags
+		}
+
+		maxInt := int64(^uint(0) >> 1)
+		if limit > maxInt {
+			diags.AddError("Invalid Limit", "'limit' exceeds supported platform integer size.")
+			return nil, diags
+		}
+
+		listOpts.Limit = int(limit)
+	}
+	if hasStartingAfter {
+		listOpts.StartingAfter = data.StartingAfter.ValueString()
+	}
+	if hasEndingBefore {
+		listOpts.EndingBefore = data.EndingBefore.ValueString()
+	}
+
+	return listOpts, diags
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSelectSingleSpanIframeByName(t *testing.T) {
+	t.Parallel()
+
+	spanIframes := []client.SpanIframe{
+		{ID: "iframe-1", Name: "trace-viewer"},
+		{ID: "iframe-2", Name: "debugger"},
+		{ID: "iframe-3", Name: "debugger"},
+	}
+
+	selected, err := selectSingleSpanIframeByName(spanIframes, "trace-viewer")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if selected.ID != "iframe-1" {
+		t.Fatalf("expected iframe-1, got %s", selected.ID)
+	}
+
+	if _, err := selectSingleSpanIframeByName(spanIframes, "missing"); !errors.Is(err, errSpanIframeNotFoundByName) {
+		t.Fatalf("expected errSpanIframeNotFoundByName, got %v", err)
+	}
+	if _, err := selectSingleSpanIframeByName(spanIframes, "debugger"); !errors.Is(err, errMultipleSpanIframesFoundByName) {
+		t.Fatalf("expected errMultipleSpanIframesFoundByName, got %v", err)
+	}
+}
+
+func TestBuildListSpanIframesOptions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := []struct {
+		want    *client.ListSpanIframesOptions
+		name    string
+		data    SpanIframesDataSourceModel
+		wantErr bool
+	}{
+		{
+			name: "all_filters",
+			data: SpanIframesDataSourceModel{
+				FilterIDs: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("iframe-1"),
+				}),
+				OrgName:        types.StringValue("org"),
+				ProjectID:      types.StringValue("project-123"),
+				ProjectName:    types.StringValue("project"),
+				SpanIframeName: types.StringValue("trace-viewer"),
+				StartingAfter:  types.StringNull(),
+				EndingBefore:   types.StringValue("cursor"),
+				Limit:          types.Int64Value(10),
+			},
+			want: &client.ListSpanIframesOptions{
+				IDs:            []string{"iframe-1"},
+				OrgName:        "org",
+				ProjectID:      "project-123",
+				ProjectName:    "project",
+				SpanIframeName: "trace-viewer",
+				EndingBefore:   "cursor",
+				Limit:          10,
+			},
+		},
+		{
+			name: "conflicting_cursors",
+			data: SpanIframesDataSourceModel{
+				FilterIDs:     types.ListNull(types.StringType),
+				StartingAfter: types.StringValue("a"),
+				EndingBefore:  types.StringValue("b"),
+				Limit:         types.Int64Null(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid_limit",
+			data: SpanIframesDataSourceModel{
+				FilterIDs: types.ListNull(types.StringType),
+				Limit:     types.Int64Value(0),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts, diags := buildListSpanIframesOptions(ctx, tc.data)
+			if diags.HasError() != tc.wantErr {
+				t.Fatalf("diagnostics mismatch: hasErr=%v diags=%v", diags.HasError(), diags)
+			}
+			if tc.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(opts, tc.want) {
+				t.Fatalf("options mismatch: got=%+v want=%+v", *opts, *tc.want)
+			}
+		})
+	}
+}
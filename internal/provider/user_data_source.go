@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -203,3 +204,50 @@ func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// resolveUserIDsByEmail looks up organization members by email. It returns a
+// map from each requested email to its user ID, plus the emails that did not
+// match any member. Email comparison is case-insensitive.
+func resolveUserIDsByEmail(ctx context.Context, c *client.Client, emails []string) (map[string]string, []string, error) {
+	resolved := make(map[string]string, len(emails))
+	if len(emails) == 0 {
+		return resolved, nil, nil
+	}
+
+	listResp, err := c.ListUsers(ctx, &client.ListUsersOptions{
+		Emails: emails,
+		Limit:  len(emails),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return matchUserIDsByEmail(listResp.Users, emails)
+}
+
+func matchUserIDsByEmail(users []client.User, emails []string) (map[string]string, []string, error) {
+	userIDsByEmail := make(map[string]string, len(users))
+	for _, user := range users {
+		if user.Email == "" {
+			continue
+		}
+		key := strings.ToLower(user.Email)
+		if existingID, ok := userIDsByEmail[key]; ok && existingID != user.ID {
+			return nil, nil, fmt.Errorf("multiple users found with email %s", user.Email)
+		}
+		userIDsByEmail[key] = user.ID
+	}
+
+	resolved := make(map[string]string, len(emails))
+	var missing []string
+	for _, email := range emails {
+		userID, ok := userIDsByEmail[strings.ToLower(email)]
+		if !ok {
+			missing = append(missing, email)
+			continue
+		}
+		resolved[email] = userID
+	}
+
+	return resolved, missing, nil
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/braintrustdata/terraform-provider-braintrustdata/internal/client"
+)
+
+func TestMatchUserIDsByEmail(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		wantResolved map[string]string
+		users        []client.User
+		emails       []string
+		wantMissing  []string
+		wantErr      bool
+	}{
+		"matches case-insensitively and keeps requested casing": {
+			users: []client.User{
+				{ID: "user-1", Email: "alice@example.com"},
+			},
+			emails:       []string{"Alice@Example.com"},
+			wantResolved: map[string]string{"Alice@Example.com": "user-1"},
+		},
+		"reports emails without a matching member": {
+			users: []client.User{
+				{ID: "user-1", Email: "alice@example.com"},
+			},
+			emails:       []string{"alice@example.com", "bob@example.com"},
+			wantResolved: map[string]string{"alice@example.com": "user-1"},
+			wantMissing:  []string{"bob@example.com"},
+		},
+		"errors when an email matches multiple users": {
+			users: []client.User{
+				{ID: "user-1", Email: "alice@example.com"},
+				{ID: "user-2", Email: "ALICE@example.com"},
+			},
+			emails:  []string{"alice@example.com"},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resolved, missing, err := matchUserIDsByEmail(tc.users, tc.emails)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(resolved, tc.wantResolved) {
+				t.Fatalf("resolved mismatch: got=%v want=%v", resolved, tc.wantResolved)
+			}
+			if !reflect.DeepEqual(missing, tc.wantMissing) {
+				t.Fatalf("missing mismatch: got=%v want=%v", missing, tc.wantMissing)
+			}
+		})
+	}
+}
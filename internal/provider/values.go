@@ -0,0 +1,15 @@
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// changedStringPointer returns the planned value of an optional string when
+// it differs from state, or nil when it is unchanged or unknown. A value
+// removed from the configuration is sent as an empty string, which clears it
+// remotely.
+func changedStringPointer(plan, state types.String) *string {
+	if plan.IsUnknown() || plan.Equal(state) {
+		return nil
+	}
+	v := plan.ValueString()
+	return &v
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestChangedStringPointer(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		want  *string
+		plan  types.String
+		state types.String
+	}{
+		"unchanged": {
+			plan:  types.StringValue("a"),
+			state: types.StringValue("a"),
+		},
+		"unknown": {
+			plan:  types.StringUnknown(),
+			state: types.StringValue("a"),
+		},
+		"changed": {
+			plan:  types.StringValue("b"),
+			state: types.StringValue("a"),
+			want:  stringPtr("b"),
+		},
+		"removed": {
+			plan:  types.StringNull(),
+			state: types.StringValue("a"),
+			want:  stringPtr(""),
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := changedStringPointer(tc.plan, tc.state)
+			if (got == nil) != (tc.want == nil) || (got != nil && *got != *tc.want) {
+				t.Fatalf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}